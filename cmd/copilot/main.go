@@ -24,7 +24,7 @@ func main() {
 	cmd := buildRootCmd()
 	if err := cmd.Execute(); err != nil {
 		log.Errorln(err.Error())
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
 
@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package acm provides a client to make API requests to AWS Certificate Manager.
+package acm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+)
+
+type api interface {
+	DescribeCertificate(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+}
+
+// ACM wraps an AWS Certificate Manager client.
+type ACM struct {
+	client api
+}
+
+// New returns an ACM struct configured against the input session.
+func New(s *session.Session) *ACM {
+	return &ACM{
+		client: acm.New(s),
+	}
+}
+
+// ValidateCertAliases returns an error if any of the aliases is not covered by at least one of the certificates.
+func (a *ACM) ValidateCertAliases(aliases []string, certs []string) error {
+	var domains []string
+	for _, cert := range certs {
+		certDomains, err := a.certDomains(cert)
+		if err != nil {
+			return err
+		}
+		domains = append(domains, certDomains...)
+	}
+	for _, alias := range aliases {
+		if !coveredByDomains(alias, domains) {
+			return &ErrAliasNotCovered{
+				Alias: alias,
+				Certs: certs,
+			}
+		}
+	}
+	return nil
+}
+
+func (a *ACM) certDomains(certARN string) ([]string, error) {
+	resp, err := a.client.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(certARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe certificate %s: %w", certARN, err)
+	}
+	if resp.Certificate == nil {
+		return nil, fmt.Errorf("certificate %s not found", certARN)
+	}
+	domains := []string{aws.StringValue(resp.Certificate.DomainName)}
+	domains = append(domains, aws.StringValueSlice(resp.Certificate.SubjectAlternativeNames)...)
+	return domains, nil
+}
+
+// coveredByDomains returns true if the alias matches one of the domains.
+// A wildcard domain such as "*.example.com" only covers a single label, for example "api.example.com".
+func coveredByDomains(alias string, domains []string) bool {
+	for _, domain := range domains {
+		if strings.EqualFold(alias, domain) {
+			return true
+		}
+		if !strings.HasPrefix(domain, "*.") {
+			continue
+		}
+		labels := strings.SplitN(alias, ".", 2)
+		if len(labels) == 2 && labels[0] != "" && strings.EqualFold(labels[1], strings.TrimPrefix(domain, "*.")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package acm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACM_ValidateCertAliases(t *testing.T) {
+	const mockCertARN = "arn:aws:acm:us-west-2:123456789012:certificate/mockCert"
+	testCases := map[string]struct {
+		aliases     []string
+		setupMocks  func(m *mocks.Mockapi)
+		wantedError error
+	}{
+		"errors if failed to describe the certificate": {
+			aliases: []string{"example.com"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+					CertificateArn: aws.String(mockCertARN),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe certificate %s: some error", mockCertARN),
+		},
+		"errors if an alias is not covered": {
+			aliases: []string{"example.com", "v1.api.example.com"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						DomainName:              aws.String("example.com"),
+						SubjectAlternativeNames: aws.StringSlice([]string{"*.example.com"}),
+					},
+				}, nil)
+			},
+			wantedError: fmt.Errorf("alias v1.api.example.com is not covered by any of the certificates %s", mockCertARN),
+		},
+		"success with exact and wildcard matches": {
+			aliases: []string{"example.com", "API.example.com"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						DomainName:              aws.String("example.com"),
+						SubjectAlternativeNames: aws.StringSlice([]string{"*.example.com"}),
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := ACM{
+				client: m,
+			}
+
+			// WHEN
+			err := client.ValidateCertAliases(tc.aliases, []string{mockCertARN})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
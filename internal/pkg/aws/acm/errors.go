@@ -0,0 +1,19 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package acm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrAliasNotCovered occurs when an alias is not covered by any of the imported certificates.
+type ErrAliasNotCovered struct {
+	Alias string
+	Certs []string
+}
+
+func (e *ErrAliasNotCovered) Error() string {
+	return fmt.Sprintf("alias %s is not covered by any of the certificates %s", e.Alias, strings.Join(e.Certs, ", "))
+}
@@ -60,8 +60,26 @@ func (a *AppRunner) DescribeService(svcARN string) (*Service, error) {
 	if err != nil {
 		return nil, fmt.Errorf("describe service %s: %w", svcARN, err)
 	}
+	var imageID, port string
+	var runtimeEnvVars map[string]*string
+	source := resp.Service.SourceConfiguration
+	switch {
+	case source.ImageRepository != nil:
+		imageID = aws.StringValue(source.ImageRepository.ImageIdentifier)
+		if conf := source.ImageRepository.ImageConfiguration; conf != nil {
+			port = aws.StringValue(conf.Port)
+			runtimeEnvVars = conf.RuntimeEnvironmentVariables
+		}
+	case source.CodeRepository != nil:
+		// Services built from source don't have an image, refer to the repository instead.
+		imageID = aws.StringValue(source.CodeRepository.RepositoryUrl)
+		if conf := source.CodeRepository.CodeConfiguration; conf != nil && conf.CodeConfigurationValues != nil {
+			port = aws.StringValue(conf.CodeConfigurationValues.Port)
+			runtimeEnvVars = conf.CodeConfigurationValues.RuntimeEnvironmentVariables
+		}
+	}
 	var envVars []*EnvironmentVariable
-	for k, v := range resp.Service.SourceConfiguration.ImageRepository.ImageConfiguration.RuntimeEnvironmentVariables {
+	for k, v := range runtimeEnvVars {
 		envVars = append(envVars, &EnvironmentVariable{
 			Name:  k,
 			Value: aws.StringValue(v),
@@ -80,8 +98,8 @@ func (a *AppRunner) DescribeService(svcARN string) (*Service, error) {
 		EnvironmentVariables: envVars,
 		CPU:                  *resp.Service.InstanceConfiguration.Cpu,
 		Memory:               *resp.Service.InstanceConfiguration.Memory,
-		ImageID:              *resp.Service.SourceConfiguration.ImageRepository.ImageIdentifier,
-		Port:                 *resp.Service.SourceConfiguration.ImageRepository.ImageConfiguration.Port,
+		ImageID:              imageID,
+		Port:                 port,
 	}, nil
 }
 
@@ -83,6 +83,60 @@ func TestAppRunner_DescribeService(t *testing.T) {
 				ImageID: "111111111111.dkr.ecr.us-east-1.amazonaws.com/testapp/testsvc:8cdef9a",
 			},
 		},
+		"success for a service built from source": {
+			serviceArn: "mock-svc-arn",
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(&apprunner.DescribeServiceInput{
+					ServiceArn: aws.String("mock-svc-arn"),
+				}).Return(&apprunner.DescribeServiceOutput{
+					Service: &apprunner.Service{
+						ServiceArn:  aws.String("111111111111.apprunner.us-east-1.amazonaws.com/service/testsvc/test-svc-id"),
+						ServiceId:   aws.String("test-svc-id"),
+						ServiceName: aws.String("testapp-testenv-testsvc"),
+						ServiceUrl:  aws.String("tumkjmvjif.public.us-east-1.apprunner.aws.dev"),
+						Status:      aws.String("RUNNING"),
+						CreatedAt:   &mockTime,
+						UpdatedAt:   &mockTime,
+						InstanceConfiguration: &apprunner.InstanceConfiguration{
+							Cpu:    aws.String("1024"),
+							Memory: aws.String("2048"),
+						},
+						SourceConfiguration: &apprunner.SourceConfiguration{
+							CodeRepository: &apprunner.CodeRepository{
+								RepositoryUrl: aws.String("https://github.com/user/repo"),
+								CodeConfiguration: &apprunner.CodeConfiguration{
+									CodeConfigurationValues: &apprunner.CodeConfigurationValues{
+										RuntimeEnvironmentVariables: aws.StringMap(map[string]string{
+											"COPILOT_APPLICATION_NAME": "testapp",
+										}),
+										Port: aws.String("8080"),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantSvc: Service{
+				ServiceARN:  "111111111111.apprunner.us-east-1.amazonaws.com/service/testsvc/test-svc-id",
+				Name:        "testapp-testenv-testsvc",
+				ID:          "test-svc-id",
+				Status:      "RUNNING",
+				ServiceURL:  "tumkjmvjif.public.us-east-1.apprunner.aws.dev",
+				DateCreated: mockTime,
+				DateUpdated: mockTime,
+				EnvironmentVariables: []*EnvironmentVariable{
+					{
+						Name:  "COPILOT_APPLICATION_NAME",
+						Value: "testapp",
+					},
+				},
+				CPU:     "1024",
+				Memory:  "2048",
+				Port:    "8080",
+				ImageID: "https://github.com/user/repo",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
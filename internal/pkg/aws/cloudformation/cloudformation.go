@@ -31,6 +31,11 @@ var waiters = []request.WaiterOption{
 	request.WithWaiterMaxAttempts(1080),                                   // Wait for at most 90 mins for any cfn action.
 }
 
+var (
+	driftDetectionPollInterval = 3 * time.Second // How long to wait in between polls for the status of a drift detection.
+	driftDetectionMaxAttempts  = 200             // Wait for at most 10 mins for a drift detection.
+)
+
 // CloudFormation represents a client to make requests to AWS CloudFormation.
 type CloudFormation struct {
 	client
@@ -168,6 +173,17 @@ func (c *CloudFormation) DeleteAndWaitWithRoleARN(stackName, roleARN string) err
 	})
 }
 
+// CancelUpdate cancels the in-progress update of a stack, which rolls the stack back to its previous configuration.
+func (c *CloudFormation) CancelUpdate(stackName string) error {
+	_, err := c.client.CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return fmt.Errorf("cancel update of stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
 // Describe returns a description of an existing stack.
 // If the stack does not exist, returns ErrStackNotFound.
 func (c *CloudFormation) Describe(name string) (*StackDescription, error) {
@@ -294,6 +310,66 @@ func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	return resources, nil
 }
 
+// DetectDrift runs drift detection on a stack and waits for it to complete.
+// It returns the resources of the stack that were modified or deleted outside of CloudFormation.
+// If the detection fails for some resources, the drift of the other resources is still returned.
+// Nested stacks are not included, drift has to be detected on each of them separately.
+func (c *CloudFormation) DetectDrift(stackName string) ([]*StackResourceDrift, error) {
+	out, err := c.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("detect drift for stack %s: %w", stackName, err)
+	}
+	if err := c.waitForDriftDetection(stackName, aws.StringValue(out.StackDriftDetectionId)); err != nil {
+		return nil, err
+	}
+
+	var nextToken *string
+	var drifts []*StackResourceDrift
+	for {
+		out, err := c.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{
+				cloudformation.StackResourceDriftStatusModified,
+				cloudformation.StackResourceDriftStatusDeleted,
+			}),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts for stack %s: %w", stackName, err)
+		}
+		for _, drift := range out.StackResourceDrifts {
+			if drift == nil {
+				continue
+			}
+			d := StackResourceDrift(*drift)
+			drifts = append(drifts, &d)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, nil
+}
+
+func (c *CloudFormation) waitForDriftDetection(stackName, detectionID string) error {
+	for attempt := 0; attempt < driftDetectionMaxAttempts; attempt++ {
+		out, err := c.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return fmt.Errorf("describe drift detection status for stack %s: %w", stackName, err)
+		}
+		if aws.StringValue(out.DetectionStatus) != cloudformation.StackDriftDetectionStatusDetectionInProgress {
+			return nil
+		}
+		time.Sleep(driftDetectionPollInterval)
+	}
+	return fmt.Errorf("drift detection for stack %s did not complete after %s", stackName, time.Duration(driftDetectionMaxAttempts)*driftDetectionPollInterval)
+}
+
 func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEvent, error) {
 	var nextToken *string
 	var events []StackEvent
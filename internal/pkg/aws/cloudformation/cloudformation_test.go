@@ -554,6 +554,48 @@ func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_CancelUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"wraps the error": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CancelUpdateStack(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("cancel update of stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+		"cancels the update of the stack": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(nil, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.CancelUpdate(mockStack.Name)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
 func TestCloudFormation_Delete(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -1193,6 +1235,128 @@ func TestStackDescriber_StackResources(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DetectDrift(t *testing.T) {
+	mockDrift := &cloudformation.StackResourceDrift{
+		LogicalResourceId:        aws.String("EnvironmentSecurityGroup"),
+		ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+		StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+	}
+	testCases := map[string]struct {
+		mockCf func(m *mocks.Mockclient)
+
+		wantedDrifts []*StackResourceDrift
+		wantedErr    string
+	}{
+		"error if drift detection cannot be started": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "detect drift for stack id: some error",
+		},
+		"error if the status of the drift detection cannot be retrieved": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("detection"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe drift detection status for stack id: some error",
+		},
+		"error if the drift detection does not complete in time": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("detection"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+				}, nil).Times(3)
+			},
+			wantedErr: "drift detection for stack id did not complete after 0s",
+		},
+		"waits for the detection to complete and returns the drifted resources": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String("id"),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("detection"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+						StackDriftDetectionId: aws.String("detection"),
+					}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					}, nil),
+				)
+				filters := aws.StringSlice([]string{
+					cloudformation.StackResourceDriftStatusModified,
+					cloudformation.StackResourceDriftStatusDeleted,
+				})
+				m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+					StackName:                       aws.String("id"),
+					StackResourceDriftStatusFilters: filters,
+				}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+					NextToken:                       aws.String("next"),
+					StackName:                       aws.String("id"),
+					StackResourceDriftStatusFilters: filters,
+				}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+					StackResourceDrifts: []*cloudformation.StackResourceDrift{mockDrift},
+				}, nil)
+			},
+			wantedDrifts: []*StackResourceDrift{
+				{
+					LogicalResourceId:        aws.String("EnvironmentSecurityGroup"),
+					ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+					StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+				},
+			},
+		},
+		"wraps error from describing resource drifts": {
+			mockCf: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("detection"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe resource drifts for stack id: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCf := mocks.NewMockclient(ctrl)
+			tc.mockCf(mockCf)
+			driftDetectionPollInterval = 0
+			driftDetectionMaxAttempts = 3
+			c := CloudFormation{
+				client: mockCf,
+			}
+
+			// WHEN
+			drifts, err := c.DetectDrift(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, drifts)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_ListStacksWithTags(t *testing.T) {
 	mockAppTag := cloudformation.Tag{
 		Key:   aws.String("copilot-application"),
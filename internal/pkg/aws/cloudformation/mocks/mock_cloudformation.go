@@ -138,6 +138,21 @@ func (m *Mockclient) EXPECT() *MockclientMockRecorder {
 	return m.recorder
 }
 
+// CancelUpdateStack mocks base method.
+func (m *Mockclient) CancelUpdateStack(arg0 *cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpdateStack", arg0)
+	ret0, _ := ret[0].(*cloudformation.CancelUpdateStackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUpdateStack indicates an expected call of CancelUpdateStack.
+func (mr *MockclientMockRecorder) CancelUpdateStack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpdateStack", reflect.TypeOf((*Mockclient)(nil).CancelUpdateStack), arg0)
+}
+
 // CreateChangeSet mocks base method.
 func (m *Mockclient) CreateChangeSet(arg0 *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +213,21 @@ func (mr *MockclientMockRecorder) DescribeChangeSet(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeChangeSet", reflect.TypeOf((*Mockclient)(nil).DescribeChangeSet), arg0)
 }
 
+// DescribeStackDriftDetectionStatus mocks base method.
+func (m *Mockclient) DescribeStackDriftDetectionStatus(arg0 *cloudformation.DescribeStackDriftDetectionStatusInput) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStackDriftDetectionStatus", arg0)
+	ret0, _ := ret[0].(*cloudformation.DescribeStackDriftDetectionStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStackDriftDetectionStatus indicates an expected call of DescribeStackDriftDetectionStatus.
+func (mr *MockclientMockRecorder) DescribeStackDriftDetectionStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackDriftDetectionStatus", reflect.TypeOf((*Mockclient)(nil).DescribeStackDriftDetectionStatus), arg0)
+}
+
 // DescribeStackEvents mocks base method.
 func (m *Mockclient) DescribeStackEvents(arg0 *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +243,21 @@ func (mr *MockclientMockRecorder) DescribeStackEvents(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackEvents", reflect.TypeOf((*Mockclient)(nil).DescribeStackEvents), arg0)
 }
 
+// DescribeStackResourceDrifts mocks base method.
+func (m *Mockclient) DescribeStackResourceDrifts(arg0 *cloudformation.DescribeStackResourceDriftsInput) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStackResourceDrifts", arg0)
+	ret0, _ := ret[0].(*cloudformation.DescribeStackResourceDriftsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStackResourceDrifts indicates an expected call of DescribeStackResourceDrifts.
+func (mr *MockclientMockRecorder) DescribeStackResourceDrifts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackResourceDrifts", reflect.TypeOf((*Mockclient)(nil).DescribeStackResourceDrifts), arg0)
+}
+
 // DescribeStackResources mocks base method.
 func (m *Mockclient) DescribeStackResources(input *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +288,21 @@ func (mr *MockclientMockRecorder) DescribeStacks(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStacks", reflect.TypeOf((*Mockclient)(nil).DescribeStacks), arg0)
 }
 
+// DetectStackDrift mocks base method.
+func (m *Mockclient) DetectStackDrift(arg0 *cloudformation.DetectStackDriftInput) (*cloudformation.DetectStackDriftOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectStackDrift", arg0)
+	ret0, _ := ret[0].(*cloudformation.DetectStackDriftOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectStackDrift indicates an expected call of DetectStackDrift.
+func (mr *MockclientMockRecorder) DetectStackDrift(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectStackDrift", reflect.TypeOf((*Mockclient)(nil).DetectStackDrift), arg0)
+}
+
 // ExecuteChangeSet mocks base method.
 func (m *Mockclient) ExecuteChangeSet(arg0 *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
 	m.ctrl.T.Helper()
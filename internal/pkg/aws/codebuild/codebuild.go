@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codebuild provides a client to make API requests to AWS CodeBuild.
+package codebuild
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+const (
+	// BuildStatusInProgress is the status of a build that is still running.
+	BuildStatusInProgress = codebuild.StatusTypeInProgress
+	// BuildStatusSucceeded is the status of a build that completed successfully.
+	BuildStatusSucceeded = codebuild.StatusTypeSucceeded
+)
+
+type api interface {
+	CreateProject(*codebuild.CreateProjectInput) (*codebuild.CreateProjectOutput, error)
+	DeleteProject(*codebuild.DeleteProjectInput) (*codebuild.DeleteProjectOutput, error)
+	StartBuild(*codebuild.StartBuildInput) (*codebuild.StartBuildOutput, error)
+	BatchGetBuilds(*codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error)
+}
+
+// CodeBuild wraps an AWS CodeBuild client.
+type CodeBuild struct {
+	client api
+}
+
+// New returns a CodeBuild configured against the input session.
+func New(s *session.Session) *CodeBuild {
+	return &CodeBuild{
+		client: codebuild.New(s),
+	}
+}
+
+// CreateProjectInput holds the configuration of a project that builds a zip archive stored in S3.
+type CreateProjectInput struct {
+	Name           string
+	ServiceRoleARN string
+	SourceLocation string // The bucket and key of the zip archive, such as "bucket/path/to/source.zip".
+	Buildspec      string
+	Image          string
+	ComputeType    string
+	Privileged     bool // Required to run the docker daemon in the build container.
+	Tags           map[string]string
+}
+
+// CreateProject creates a project with no artifacts that runs the buildspec against the source archive.
+func (c *CodeBuild) CreateProject(in *CreateProjectInput) error {
+	var tags []*codebuild.Tag
+	for _, k := range sortedKeys(in.Tags) {
+		tags = append(tags, &codebuild.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(in.Tags[k]),
+		})
+	}
+	_, err := c.client.CreateProject(&codebuild.CreateProjectInput{
+		Name:        aws.String(in.Name),
+		ServiceRole: aws.String(in.ServiceRoleARN),
+		Source: &codebuild.ProjectSource{
+			Type:      aws.String(codebuild.SourceTypeS3),
+			Location:  aws.String(in.SourceLocation),
+			Buildspec: aws.String(in.Buildspec),
+		},
+		Artifacts: &codebuild.ProjectArtifacts{
+			Type: aws.String(codebuild.ArtifactsTypeNoArtifacts),
+		},
+		Environment: &codebuild.ProjectEnvironment{
+			Type:           aws.String(codebuild.EnvironmentTypeLinuxContainer),
+			Image:          aws.String(in.Image),
+			ComputeType:    aws.String(in.ComputeType),
+			PrivilegedMode: aws.Bool(in.Privileged),
+		},
+		Tags: tags,
+	})
+	if err != nil {
+		return fmt.Errorf("create project %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+// DeleteProject deletes a project.
+func (c *CodeBuild) DeleteProject(name string) error {
+	if _, err := c.client.DeleteProject(&codebuild.DeleteProjectInput{
+		Name: aws.String(name),
+	}); err != nil {
+		return fmt.Errorf("delete project %s: %w", name, err)
+	}
+	return nil
+}
+
+// StartBuild starts a build of a project and returns its ID.
+func (c *CodeBuild) StartBuild(project string) (string, error) {
+	out, err := c.client.StartBuild(&codebuild.StartBuildInput{
+		ProjectName: aws.String(project),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start build of project %s: %w", project, err)
+	}
+	return aws.StringValue(out.Build.Id), nil
+}
+
+// Build holds the status of a build.
+type Build struct {
+	ID                string
+	Status            string
+	Phase             string
+	LogGroup          string            // Empty until the build starts writing logs.
+	LogStream         string            // Empty until the build starts writing logs.
+	ExportedVariables map[string]string // The variables exported by the buildspec once the build completes.
+}
+
+// Build returns the status of a build.
+func (c *CodeBuild) Build(id string) (*Build, error) {
+	out, err := c.client.BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+		Ids: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get build %s: %w", id, err)
+	}
+	if len(out.Builds) == 0 {
+		return nil, fmt.Errorf("build %s not found", id)
+	}
+	b := out.Builds[0]
+	build := &Build{
+		ID:                aws.StringValue(b.Id),
+		Status:            aws.StringValue(b.BuildStatus),
+		Phase:             aws.StringValue(b.CurrentPhase),
+		ExportedVariables: make(map[string]string),
+	}
+	if b.Logs != nil {
+		build.LogGroup = aws.StringValue(b.Logs.GroupName)
+		build.LogStream = aws.StringValue(b.Logs.StreamName)
+	}
+	for _, v := range b.ExportedEnvironmentVariables {
+		build.ExportedVariables[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+	}
+	return build, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
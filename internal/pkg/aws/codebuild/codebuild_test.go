@@ -0,0 +1,213 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codebuild
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBuild_CreateProject(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateProject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("create project phonetool-test-api: some error"),
+		},
+		"creates a privileged project that builds the source archive": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateProject(&codebuild.CreateProjectInput{
+					Name:        aws.String("phonetool-test-api"),
+					ServiceRole: aws.String("arn:aws:iam::123456789012:role/phonetool-test-ImageBuilderRole"),
+					Source: &codebuild.ProjectSource{
+						Type:      aws.String("S3"),
+						Location:  aws.String("bucket/source.zip"),
+						Buildspec: aws.String("version: 0.2"),
+					},
+					Artifacts: &codebuild.ProjectArtifacts{
+						Type: aws.String("NO_ARTIFACTS"),
+					},
+					Environment: &codebuild.ProjectEnvironment{
+						Type:           aws.String("LINUX_CONTAINER"),
+						Image:          aws.String("aws/codebuild/standard:5.0"),
+						ComputeType:    aws.String("BUILD_GENERAL1_SMALL"),
+						PrivilegedMode: aws.Bool(true),
+					},
+					Tags: []*codebuild.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+						{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+					},
+				}).Return(&codebuild.CreateProjectOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cb := CodeBuild{client: m}
+
+			// WHEN
+			err := cb.CreateProject(&CreateProjectInput{
+				Name:           "phonetool-test-api",
+				ServiceRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-ImageBuilderRole",
+				SourceLocation: "bucket/source.zip",
+				Buildspec:      "version: 0.2",
+				Image:          "aws/codebuild/standard:5.0",
+				ComputeType:    "BUILD_GENERAL1_SMALL",
+				Privileged:     true,
+				Tags: map[string]string{
+					"copilot-environment": "test",
+					"copilot-application": "phonetool",
+				},
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCodeBuild_StartBuild(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedID    string
+		wantedError error
+	}{
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start build of project phonetool-test-api: some error"),
+		},
+		"returns the id of the build": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(&codebuild.StartBuildInput{
+					ProjectName: aws.String("phonetool-test-api"),
+				}).Return(&codebuild.StartBuildOutput{
+					Build: &codebuild.Build{Id: aws.String("phonetool-test-api:1")},
+				}, nil)
+			},
+			wantedID: "phonetool-test-api:1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cb := CodeBuild{client: m}
+
+			// WHEN
+			id, err := cb.StartBuild("phonetool-test-api")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedID, id)
+			}
+		})
+	}
+}
+
+func TestCodeBuild_Build(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted      *Build
+		wantedError error
+	}{
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get build phonetool-test-api:1: some error"),
+		},
+		"errors if the build does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&codebuild.BatchGetBuildsOutput{}, nil)
+			},
+			wantedError: errors.New("build phonetool-test-api:1 not found"),
+		},
+		"returns the status, logs and exported variables of the build": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+					Ids: aws.StringSlice([]string{"phonetool-test-api:1"}),
+				}).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							Id:           aws.String("phonetool-test-api:1"),
+							BuildStatus:  aws.String("SUCCEEDED"),
+							CurrentPhase: aws.String("COMPLETED"),
+							Logs: &codebuild.LogsLocation{
+								GroupName:  aws.String("/aws/codebuild/phonetool-test-api"),
+								StreamName: aws.String("1"),
+							},
+							ExportedEnvironmentVariables: []*codebuild.ExportedEnvironmentVariable{
+								{Name: aws.String("IMAGE_DIGEST"), Value: aws.String("sha256:abc")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Build{
+				ID:        "phonetool-test-api:1",
+				Status:    "SUCCEEDED",
+				Phase:     "COMPLETED",
+				LogGroup:  "/aws/codebuild/phonetool-test-api",
+				LogStream: "1",
+				ExportedVariables: map[string]string{
+					"IMAGE_DIGEST": "sha256:abc",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cb := CodeBuild{client: m}
+
+			// WHEN
+			build, err := cb.Build("phonetool-test-api:1")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, build)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/codebuild/codebuild.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	codebuild "github.com/aws/aws-sdk-go/service/codebuild"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// BatchGetBuilds mocks base method.
+func (m *Mockapi) BatchGetBuilds(arg0 *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetBuilds", arg0)
+	ret0, _ := ret[0].(*codebuild.BatchGetBuildsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetBuilds indicates an expected call of BatchGetBuilds.
+func (mr *MockapiMockRecorder) BatchGetBuilds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetBuilds", reflect.TypeOf((*Mockapi)(nil).BatchGetBuilds), arg0)
+}
+
+// CreateProject mocks base method.
+func (m *Mockapi) CreateProject(arg0 *codebuild.CreateProjectInput) (*codebuild.CreateProjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0)
+	ret0, _ := ret[0].(*codebuild.CreateProjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockapiMockRecorder) CreateProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*Mockapi)(nil).CreateProject), arg0)
+}
+
+// DeleteProject mocks base method.
+func (m *Mockapi) DeleteProject(arg0 *codebuild.DeleteProjectInput) (*codebuild.DeleteProjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0)
+	ret0, _ := ret[0].(*codebuild.DeleteProjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockapiMockRecorder) DeleteProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*Mockapi)(nil).DeleteProject), arg0)
+}
+
+// StartBuild mocks base method.
+func (m *Mockapi) StartBuild(arg0 *codebuild.StartBuildInput) (*codebuild.StartBuildOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBuild", arg0)
+	ret0, _ := ret[0].(*codebuild.StartBuildOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBuild indicates an expected call of StartBuild.
+func (mr *MockapiMockRecorder) StartBuild(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBuild", reflect.TypeOf((*Mockapi)(nil).StartBuild), arg0)
+}
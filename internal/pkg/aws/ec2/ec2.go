@@ -36,6 +36,7 @@ type api interface {
 	DescribeVpcAttribute(input *ec2.DescribeVpcAttributeInput) (*ec2.DescribeVpcAttributeOutput, error)
 	DescribeNetworkInterfaces(input *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error)
 	DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error)
+	DescribeInternetGateways(input *ec2.DescribeInternetGatewaysInput) (*ec2.DescribeInternetGatewaysOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -194,6 +195,73 @@ func (c *EC2) HasDNSSupport(vpcID string) (bool, error) {
 	return aws.BoolValue(resp.EnableDnsSupport.Value), nil
 }
 
+// VPCCIDR returns the primary IPv4 CIDR block of the VPC.
+func (c *EC2) VPCCIDR(vpcID string) (string, error) {
+	resp, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe VPC %s: %w", vpcID, err)
+	}
+	if len(resp.Vpcs) == 0 {
+		return "", fmt.Errorf("VPC %s not found", vpcID)
+	}
+	return aws.StringValue(resp.Vpcs[0].CidrBlock), nil
+}
+
+// HasInternetGateway returns if an internet gateway is attached to the VPC.
+func (c *EC2) HasInternetGateway(vpcID string) (bool, error) {
+	resp, err := c.client.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "attachment.vpc-id",
+				Values: []string{vpcID},
+			},
+		}),
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe internet gateways for VPC %s: %w", vpcID, err)
+	}
+	return len(resp.InternetGateways) > 0, nil
+}
+
+// RouteTableIDs returns the IDs of the route tables used by the subnets in a VPC.
+// Subnets without an explicit route table association use the main route table of the VPC.
+func (c *EC2) RouteTableIDs(vpcID string, subnetIDs []string) ([]string, error) {
+	routeTables, err := c.routeTables(Filter{
+		Name:   "vpc-id",
+		Values: []string{vpcID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var mainRouteTableID string
+	routeTableForSubnet := make(map[string]string)
+	for _, routeTable := range routeTables {
+		for _, association := range routeTable.Associations {
+			if aws.BoolValue(association.Main) {
+				mainRouteTableID = aws.StringValue(routeTable.RouteTableId)
+				continue
+			}
+			routeTableForSubnet[aws.StringValue(association.SubnetId)] = aws.StringValue(routeTable.RouteTableId)
+		}
+	}
+	var ids []string
+	seen := make(map[string]bool)
+	for _, subnetID := range subnetIDs {
+		id, ok := routeTableForSubnet[subnetID]
+		if !ok {
+			id = mainRouteTableID
+		}
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 // VPCSubnets are all subnets within a VPC.
 type VPCSubnets struct {
 	Public  []Subnet
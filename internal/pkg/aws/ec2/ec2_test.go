@@ -564,3 +564,202 @@ func TestEC2_HasDNSSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_VPCCIDR(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedCIDR  string
+	}{
+		"fail to describe the VPC": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe VPC mockVPCID: some error"),
+		},
+		"fail if the VPC does not exist": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil)
+			},
+			wantedError: fmt.Errorf("VPC mockVPCID not found"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					VpcIds: aws.StringSlice([]string{"mockVPCID"}),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId:     aws.String("mockVPCID"),
+							CidrBlock: aws.String("10.1.0.0/16"),
+						},
+					},
+				}, nil)
+			},
+			wantedCIDR: "10.1.0.0/16",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			cidr, err := ec2Client.VPCCIDR("mockVPCID")
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCIDR, cidr)
+			}
+		})
+	}
+}
+
+func TestEC2_HasInternetGateway(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIGW   bool
+	}{
+		"fail to describe internet gateways": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInternetGateways(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe internet gateways for VPC mockVPCID: some error"),
+		},
+		"no internet gateway attached": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("attachment.vpc-id"),
+							Values: aws.StringSlice([]string{"mockVPCID"}),
+						},
+					},
+				}).Return(&ec2.DescribeInternetGatewaysOutput{}, nil)
+			},
+		},
+		"internet gateway attached": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInternetGateways(gomock.Any()).Return(&ec2.DescribeInternetGatewaysOutput{
+					InternetGateways: []*ec2.InternetGateway{
+						{
+							InternetGatewayId: aws.String("igw-1234"),
+						},
+					},
+				}, nil)
+			},
+			wantedIGW: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			hasIGW, err := ec2Client.HasInternetGateway("mockVPCID")
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIGW, hasIGW)
+			}
+		})
+	}
+}
+
+func TestEC2_RouteTableIDs(t *testing.T) {
+	testCases := map[string]struct {
+		subnetIDs     []string
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIDs   []string
+	}{
+		"fail to describe route tables": {
+			subnetIDs: []string{"subnet-1"},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRouteTables(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe route tables: some error"),
+		},
+		"returns explicit and main route tables without duplicates": {
+			subnetIDs: []string{"subnet-1", "subnet-2", "subnet-3", "subnet-4"},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("vpc-id"),
+							Values: aws.StringSlice([]string{"mockVPCID"}),
+						},
+					},
+				}).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []*ec2.RouteTable{
+						{
+							RouteTableId: aws.String("rtb-main"),
+							Associations: []*ec2.RouteTableAssociation{
+								{
+									Main: aws.Bool(true),
+								},
+							},
+						},
+						{
+							RouteTableId: aws.String("rtb-private"),
+							Associations: []*ec2.RouteTableAssociation{
+								{
+									SubnetId: aws.String("subnet-1"),
+								},
+								{
+									SubnetId: aws.String("subnet-2"),
+								},
+							},
+						},
+						{
+							RouteTableId: aws.String("rtb-other"),
+							Associations: []*ec2.RouteTableAssociation{
+								{
+									SubnetId: aws.String("subnet-3"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedIDs: []string{"rtb-private", "rtb-other", "rtb-main"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ids, err := ec2Client.RouteTableIDs("mockVPCID", tc.subnetIDs)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIDs, ids)
+			}
+		})
+	}
+}
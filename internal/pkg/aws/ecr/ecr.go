@@ -8,7 +8,9 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -84,6 +86,29 @@ func (c ECR) RepositoryURI(name string) (string, error) {
 	return *repo.RepositoryUri, nil
 }
 
+// RepositoryNames returns the names of the repositories in the registry that start with the prefix.
+func (c ECR) RepositoryNames(prefix string) ([]string, error) {
+	var names []string
+	var token *string
+	for {
+		resp, err := c.client.DescribeRepositories(&ecr.DescribeRepositoriesInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ecr describe repositories: %w", err)
+		}
+		for _, repo := range resp.Repositories {
+			if name := aws.StringValue(repo.RepositoryName); strings.HasPrefix(name, prefix) {
+				names = append(names, name)
+			}
+		}
+		if resp.NextToken == nil {
+			return names, nil
+		}
+		token = resp.NextToken
+	}
+}
+
 // Image houses metadata for ECR repository images.
 type Image struct {
 	Digest string
@@ -127,6 +152,54 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 	return images, nil
 }
 
+// ImageDetail contains the tags, push time and scan findings of an image in a repository.
+type ImageDetail struct {
+	Digest      string           `json:"digest"`
+	Tags        []string         `json:"tags"`
+	PushedAt    time.Time        `json:"pushedAt"`
+	SizeInBytes int64            `json:"sizeInBytes"`
+	ScanStatus  string           `json:"scanStatus,omitempty"` // Status of the latest scan, such as "COMPLETE". Empty if the image was never scanned.
+	Findings    map[string]int64 `json:"findings,omitempty"`   // Number of findings of the latest scan by severity, such as "CRITICAL".
+}
+
+// ImageDetails calls the ECR DescribeImages API and returns the details of the images
+// in the input ECR repository name, most recently pushed first.
+func (c ECR) ImageDetails(repoName string) ([]ImageDetail, error) {
+	var images []ImageDetail
+	in := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+	}
+	for {
+		resp, err := c.client.DescribeImages(in)
+		if err != nil {
+			return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
+		}
+		for _, detail := range resp.ImageDetails {
+			image := ImageDetail{
+				Digest:      aws.StringValue(detail.ImageDigest),
+				Tags:        aws.StringValueSlice(detail.ImageTags),
+				PushedAt:    aws.TimeValue(detail.ImagePushedAt),
+				SizeInBytes: aws.Int64Value(detail.ImageSizeInBytes),
+			}
+			if detail.ImageScanStatus != nil {
+				image.ScanStatus = aws.StringValue(detail.ImageScanStatus.Status)
+			}
+			if detail.ImageScanFindingsSummary != nil {
+				image.Findings = aws.Int64ValueMap(detail.ImageScanFindingsSummary.FindingSeverityCounts)
+			}
+			images = append(images, image)
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	sort.SliceStable(images, func(i, j int) bool {
+		return images[i].PushedAt.After(images[j].PushedAt)
+	})
+	return images, nil
+}
+
 // DeleteImages calls the ECR BatchDeleteImage API with the input image list and repository name.
 func (c ECR) DeleteImages(images []Image, repoName string) error {
 	if len(images) == 0 {
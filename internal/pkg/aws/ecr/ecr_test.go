@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -141,6 +142,68 @@ func TestRepositoryURI(t *testing.T) {
 	}
 }
 
+func TestRepositoryNames(t *testing.T) {
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantNames []string
+		wantErr   error
+	}{
+		"should return wrapped error given error returned from DescribeRepositories": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("ecr describe repositories: some error"),
+		},
+		"should return the names of the repositories with the prefix in all pages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeRepositories(&ecr.DescribeRepositoriesInput{}).Return(&ecr.DescribeRepositoriesOutput{
+						Repositories: []*ecr.Repository{
+							{RepositoryName: aws.String("phonetool/frontend")},
+							{RepositoryName: aws.String("phonetool/frontend/nginx")},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeRepositories(&ecr.DescribeRepositoriesInput{
+						NextToken: aws.String("token"),
+					}).Return(&ecr.DescribeRepositoriesOutput{
+						Repositories: []*ecr.Repository{
+							{RepositoryName: aws.String("phonetool/backend/envoy")},
+							{RepositoryName: aws.String("phonetool/frontend/envoy")},
+						},
+					}, nil),
+				)
+			},
+			wantNames: []string{"phonetool/frontend/nginx", "phonetool/frontend/envoy"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotNames, gotErr := client.RepositoryNames("phonetool/frontend/")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantNames, gotNames)
+		})
+	}
+}
+
 func TestURIFromARN(t *testing.T) {
 
 	testCases := map[string]struct {
@@ -264,6 +327,103 @@ func TestListImages(t *testing.T) {
 	}
 }
 
+func TestImageDetails(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockError := errors.New("mockError")
+	mockNextToken := "next"
+	olderPush := time.Date(2021, 8, 25, 10, 0, 0, 0, time.UTC)
+	newerPush := time.Date(2021, 8, 26, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantImages []ImageDetail
+		wantError  error
+	}{
+		"should wrap error returned by ECR DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo %s describe images: %w", mockRepoName, mockError),
+		},
+		"should return the images of all pages most recently pushed first": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest:      aws.String("sha256:old"),
+							ImagePushedAt:    aws.Time(olderPush),
+							ImageSizeInBytes: aws.Int64(1024),
+						},
+					},
+					NextToken: &mockNextToken,
+				}, nil)
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+					NextToken:      &mockNextToken,
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest:      aws.String("sha256:new"),
+							ImageTags:        aws.StringSlice([]string{"latest", "v2"}),
+							ImagePushedAt:    aws.Time(newerPush),
+							ImageSizeInBytes: aws.Int64(2048),
+							ImageScanStatus: &ecr.ImageScanStatus{
+								Status: aws.String("COMPLETE"),
+							},
+							ImageScanFindingsSummary: &ecr.ImageScanFindingsSummary{
+								FindingSeverityCounts: aws.Int64Map(map[string]int64{
+									"HIGH": 2,
+								}),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantImages: []ImageDetail{
+				{
+					Digest:      "sha256:new",
+					Tags:        []string{"latest", "v2"},
+					PushedAt:    newerPush,
+					SizeInBytes: 2048,
+					ScanStatus:  "COMPLETE",
+					Findings: map[string]int64{
+						"HIGH": 2,
+					},
+				},
+				{
+					Digest:      "sha256:old",
+					Tags:        []string{},
+					PushedAt:    olderPush,
+					SizeInBytes: 1024,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotImages, gotError := client.ImageDetails(mockRepoName)
+
+			require.Equal(t, tc.wantImages, gotImages)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
 func TestDeleteImages(t *testing.T) {
 	mockRepoName := "mockRepoName"
 	mockError := errors.New("mockError")
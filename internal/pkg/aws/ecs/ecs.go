@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -17,7 +18,11 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 )
 
-const clusterStatusActive = "ACTIVE"
+const (
+	clusterStatusActive         = "ACTIVE"
+	capacityProviderFargateSpot = "FARGATE_SPOT"
+	defaultPlatformVersion      = "1.4.0"
+)
 
 type api interface {
 	DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
@@ -49,6 +54,11 @@ type RunTaskInput struct {
 	SecurityGroups []string
 	TaskFamilyName string
 	StartedBy      string
+
+	DisablePublicIP bool              // Don't assign public IPs to the tasks, for example when they run in private subnets.
+	Spot            bool              // Run the tasks with the FARGATE_SPOT capacity provider instead of the Fargate launch type.
+	PlatformVersion string            // Optional. The Fargate platform version of the tasks. Defaults to 1.4.0.
+	Tags            map[string]string // Optional. Tags added to the tasks on top of the tags of the task definition.
 }
 
 // ExecuteCommandInput holds the fields needed to execute commands in a running container.
@@ -120,6 +130,11 @@ func (e *ECS) RunningTasks(cluster string) ([]*Task, error) {
 	return e.listTasks(cluster, withRunningTasks())
 }
 
+// StoppedTasks calls ECS API and returns the recently stopped ECS tasks in the cluster.
+func (e *ECS) StoppedTasks(cluster string) ([]*Task, error) {
+	return e.listTasks(cluster, withStoppedTasks())
+}
+
 type listTasksOpts func(*ecs.ListTasksInput)
 
 func withService(svcName string) listTasksOpts {
@@ -248,23 +263,42 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
-	resp, err := e.client.RunTask(&ecs.RunTaskInput{
+	assignPublicIP := ecs.AssignPublicIpEnabled
+	if input.DisablePublicIP {
+		assignPublicIP = ecs.AssignPublicIpDisabled
+	}
+	platformVersion := defaultPlatformVersion
+	if input.PlatformVersion != "" {
+		platformVersion = input.PlatformVersion
+	}
+	in := &ecs.RunTaskInput{
 		Cluster:        aws.String(input.Cluster),
 		Count:          aws.Int64(int64(input.Count)),
-		LaunchType:     aws.String(ecs.LaunchTypeFargate),
 		StartedBy:      aws.String(input.StartedBy),
 		TaskDefinition: aws.String(input.TaskFamilyName),
 		NetworkConfiguration: &ecs.NetworkConfiguration{
 			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
-				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+				AssignPublicIp: aws.String(assignPublicIP),
 				Subnets:        aws.StringSlice(input.Subnets),
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
 		},
 		EnableExecuteCommand: aws.Bool(true),
-		PlatformVersion:      aws.String("1.4.0"),
+		PlatformVersion:      aws.String(platformVersion),
 		PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
-	})
+		Tags:                 runTaskTags(input.Tags),
+	}
+	if input.Spot {
+		in.CapacityProviderStrategy = []*ecs.CapacityProviderStrategyItem{
+			{
+				CapacityProvider: aws.String(capacityProviderFargateSpot),
+				Weight:           aws.Int64(1),
+			},
+		}
+	} else {
+		in.LaunchType = aws.String(ecs.LaunchTypeFargate)
+	}
+	resp, err := e.client.RunTask(in)
 	if err != nil {
 		return nil, fmt.Errorf("run task(s) %s: %w", input.TaskFamilyName, err)
 	}
@@ -296,6 +330,26 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 	return tasks, nil
 }
 
+// runTaskTags returns the tags of a RunTask request sorted by key, or nil if there are none.
+func runTaskTags(tags map[string]string) []*ecs.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]*ecs.Tag, len(keys))
+	for i, k := range keys {
+		out[i] = &ecs.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		}
+	}
+	return out
+}
+
 // DescribeTasks returns the tasks with the taskARNs in the cluster.
 func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error) {
 	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
@@ -489,6 +489,76 @@ func TestECS_StoppedServiceTaskss(t *testing.T) {
 	}
 }
 
+func TestECS_StoppedTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list running tasks: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn"}),
+					Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:    aws.String("mockTaskArn"),
+							LastStatus: aws.String("STOPPED"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn:    aws.String("mockTaskArn"),
+					LastStatus: aws.String("STOPPED"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotTasks, gotErr := service.StoppedTasks("mockCluster")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
+
 func TestECS_StopTasks(t *testing.T) {
 	mockTasks := []string{"mockTask1", "mockTask2"}
 	mockError := errors.New("some error")
@@ -709,6 +779,10 @@ func TestECS_RunTask(t *testing.T) {
 		securityGroups []string
 		taskFamilyName string
 		startedBy      string
+
+		spot            bool
+		platformVersion string
+		tags            map[string]string
 	}
 
 	runTaskInput := input{
@@ -782,6 +856,119 @@ func TestECS_RunTask(t *testing.T) {
 				},
 			},
 		},
+		"run task on Fargate Spot with a platform version": {
+			input: input{
+				cluster:         "my-cluster",
+				count:           3,
+				subnets:         []string{"subnet-1", "subnet-2"},
+				securityGroups:  []string{"sg-1", "sg-2"},
+				taskFamilyName:  "my-task",
+				startedBy:       "task",
+				spot:            true,
+				platformVersion: "1.0.0",
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster: aws.String("my-cluster"),
+					Count:   aws.Int64(3),
+					CapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+						{
+							CapacityProvider: aws.String("FARGATE_SPOT"),
+							Weight:           aws.Int64(1),
+						},
+					},
+					StartedBy:      aws.String("task"),
+					TaskDefinition: aws.String("my-task"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+						},
+					},
+					EnableExecuteCommand: aws.Bool(true),
+					PlatformVersion:      aws.String("1.0.0"),
+					PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: ecsTasks,
+				}, nil)
+				m.EXPECT().WaitUntilTasksRunning(&describeTasksInput).Times(1)
+				m.EXPECT().DescribeTasks(&describeTasksInput).Return(&ecs.DescribeTasksOutput{
+					Tasks: ecsTasks,
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn: aws.String("task-1"),
+				},
+				{
+					TaskArn: aws.String("task-2"),
+				},
+				{
+					TaskArn: aws.String("task-3"),
+				},
+			},
+		},
+		"run task with additional tags": {
+			input: input{
+				cluster:        "my-cluster",
+				count:          3,
+				subnets:        []string{"subnet-1", "subnet-2"},
+				securityGroups: []string{"sg-1", "sg-2"},
+				taskFamilyName: "my-task",
+				startedBy:      "task",
+				tags: map[string]string{
+					"copilot-started-by": "arn:aws:sts::123456789012:assumed-role/Admin/alice@example.com",
+					"team":               "payments",
+				},
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster:        aws.String("my-cluster"),
+					Count:          aws.Int64(3),
+					LaunchType:     aws.String(ecs.LaunchTypeFargate),
+					StartedBy:      aws.String("task"),
+					TaskDefinition: aws.String("my-task"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+						},
+					},
+					EnableExecuteCommand: aws.Bool(true),
+					PlatformVersion:      aws.String("1.4.0"),
+					PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+					Tags: []*ecs.Tag{
+						{
+							Key:   aws.String("copilot-started-by"),
+							Value: aws.String("arn:aws:sts::123456789012:assumed-role/Admin/alice@example.com"),
+						},
+						{
+							Key:   aws.String("team"),
+							Value: aws.String("payments"),
+						},
+					},
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: ecsTasks,
+				}, nil)
+				m.EXPECT().WaitUntilTasksRunning(&describeTasksInput).Times(1)
+				m.EXPECT().DescribeTasks(&describeTasksInput).Return(&ecs.DescribeTasksOutput{
+					Tasks: ecsTasks,
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn: aws.String("task-1"),
+				},
+				{
+					TaskArn: aws.String("task-2"),
+				},
+				{
+					TaskArn: aws.String("task-3"),
+				},
+			},
+		},
 		"run task failed": {
 			input: runTaskInput,
 
@@ -899,12 +1086,15 @@ func TestECS_RunTask(t *testing.T) {
 			}
 
 			tasks, err := ecs.RunTask(RunTaskInput{
-				Count:          tc.count,
-				Cluster:        tc.cluster,
-				TaskFamilyName: tc.taskFamilyName,
-				Subnets:        tc.subnets,
-				SecurityGroups: tc.securityGroups,
-				StartedBy:      tc.startedBy,
+				Count:           tc.count,
+				Cluster:         tc.cluster,
+				TaskFamilyName:  tc.taskFamilyName,
+				Subnets:         tc.subnets,
+				SecurityGroups:  tc.securityGroups,
+				StartedBy:       tc.startedBy,
+				Spot:            tc.spot,
+				PlatformVersion: tc.platformVersion,
+				Tags:            tc.tags,
 			})
 
 			if tc.wantedError != nil {
@@ -70,6 +70,29 @@ func (s *Service) ServiceStatus() ServiceStatus {
 	}
 }
 
+// ServiceEvent contains information of an ECS service event.
+type ServiceEvent struct {
+	ID        string    `json:"id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// LatestEvents returns at most the n most recent events of the service, from newest to oldest.
+func (s *Service) LatestEvents(n int) []ServiceEvent {
+	var events []ServiceEvent
+	for _, event := range s.Events {
+		if len(events) == n {
+			break
+		}
+		events = append(events, ServiceEvent{
+			ID:        aws.StringValue(event.Id),
+			Message:   aws.StringValue(event.Message),
+			CreatedAt: aws.TimeValue(event.CreatedAt),
+		})
+	}
+	return events
+}
+
 // TargetGroups returns the ARNs of target groups attached to the service.
 func (s *Service) TargetGroups() []string {
 	var targetGroupARNs []string
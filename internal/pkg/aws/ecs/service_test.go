@@ -30,6 +30,44 @@ func TestService_TargetGroups(t *testing.T) {
 	})
 }
 
+func TestService_LatestEvents(t *testing.T) {
+	t.Run("should return at most n events from newest to oldest", func(t *testing.T) {
+		s := Service{
+			Events: []*ecs.ServiceEvent{
+				{
+					Id:        aws.String("event-3"),
+					Message:   aws.String("(service my-svc) has reached a steady state."),
+					CreatedAt: aws.Time(time.Unix(1630000300, 0)),
+				},
+				{
+					Id:        aws.String("event-2"),
+					Message:   aws.String("(service my-svc) has started 1 tasks: (task 1234)."),
+					CreatedAt: aws.Time(time.Unix(1630000200, 0)),
+				},
+				{
+					Id:        aws.String("event-1"),
+					Message:   aws.String("(service my-svc) has stopped 1 running tasks: (task 5678)."),
+					CreatedAt: aws.Time(time.Unix(1630000100, 0)),
+				},
+			},
+		}
+		got := s.LatestEvents(2)
+		expected := []ServiceEvent{
+			{
+				ID:        "event-3",
+				Message:   "(service my-svc) has reached a steady state.",
+				CreatedAt: time.Unix(1630000300, 0),
+			},
+			{
+				ID:        "event-2",
+				Message:   "(service my-svc) has started 1 tasks: (task 1234).",
+				CreatedAt: time.Unix(1630000200, 0),
+			},
+		}
+		require.Equal(t, expected, got)
+	})
+}
+
 func TestService_ServiceStatus(t *testing.T) {
 	t.Run("should include active and primary deployments in status", func(t *testing.T) {
 		inService := Service{
@@ -79,11 +79,18 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		stoppedReason = aws.StringValue(t.StoppedReason)
 	}
 	var images []Image
+	var containers []ContainerStatus
 	for _, container := range t.Containers {
 		images = append(images, Image{
 			ID:     aws.StringValue(container.Image),
 			Digest: imageDigestValue(aws.StringValue(container.ImageDigest)),
 		})
+		containers = append(containers, ContainerStatus{
+			Name:       aws.StringValue(container.Name),
+			LastStatus: aws.StringValue(container.LastStatus),
+			ExitCode:   container.ExitCode,
+			Reason:     aws.StringValue(container.Reason),
+		})
 	}
 	return &TaskStatus{
 		Health:           aws.StringValue(t.HealthStatus),
@@ -95,6 +102,7 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		StoppedReason:    stoppedReason,
 		CapacityProvider: aws.StringValue(t.CapacityProviderName),
 		TaskDefinition:   aws.StringValue(t.TaskDefinitionArn),
+		Containers:       containers,
 	}, nil
 }
 
@@ -155,15 +163,24 @@ func (t *Task) attachmentENI() (*ecs.Attachment, error) {
 
 // TaskStatus contains the status info of a task.
 type TaskStatus struct {
-	Health           string    `json:"health"`
-	ID               string    `json:"id"`
-	Images           []Image   `json:"images"`
-	LastStatus       string    `json:"lastStatus"`
-	StartedAt        time.Time `json:"startedAt"`
-	StoppedAt        time.Time `json:"stoppedAt"`
-	StoppedReason    string    `json:"stoppedReason"`
-	CapacityProvider string    `json:"capacityProvider"`
-	TaskDefinition   string    `json:"taskDefinitionARN"`
+	Health           string            `json:"health"`
+	ID               string            `json:"id"`
+	Images           []Image           `json:"images"`
+	LastStatus       string            `json:"lastStatus"`
+	StartedAt        time.Time         `json:"startedAt"`
+	StoppedAt        time.Time         `json:"stoppedAt"`
+	StoppedReason    string            `json:"stoppedReason"`
+	CapacityProvider string            `json:"capacityProvider"`
+	TaskDefinition   string            `json:"taskDefinitionARN"`
+	Containers       []ContainerStatus `json:"containers"`
+}
+
+// ContainerStatus contains the status info of a container in a task.
+type ContainerStatus struct {
+	Name       string `json:"name"`
+	LastStatus string `json:"lastStatus"`
+	ExitCode   *int64 `json:"exitCode"` // ExitCode is nil if the container hasn't exited.
+	Reason     string `json:"reason"`
 }
 
 // TaskDefinition wraps up ECS TaskDefinition struct.
@@ -274,6 +291,16 @@ func TaskDefinitionVersion(taskDefARN string) (int, error) {
 	return version, nil
 }
 
+// TaskDefinitionFamily takes a task definition ARN and returns its family.
+// For example, given "arn:aws:ecs:us-east-1:568623488001:task-definition/some-task-def:6", it returns "some-task-def".
+func TaskDefinitionFamily(taskDefARN string) (string, error) {
+	name, err := taskDefinitionName(taskDefARN)
+	if err != nil {
+		return "", err
+	}
+	return strings.Split(name, ":")[0], nil
+}
+
 func shortTaskID(id string) string {
 	if len(id) >= shortTaskIDLength {
 		return id[:shortTaskIDLength]
@@ -56,6 +56,7 @@ func TestTask_TaskStatus(t *testing.T) {
 					},
 				},
 				LastStatus: "UNKNOWN",
+				Containers: []ContainerStatus{{}},
 			},
 		},
 		"success with a running task": {
@@ -81,14 +82,19 @@ func TestTask_TaskStatus(t *testing.T) {
 				},
 				LastStatus: "UNKNOWN",
 				StartedAt:  startTime,
+				Containers: []ContainerStatus{{}},
 			},
 		},
 		"success with a stopped task": {
 			taskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
 			containers: []*ecs.Container{
 				{
+					Name:        aws.String("nginx"),
 					Image:       aws.String("mockImageArn"),
 					ImageDigest: aws.String("sha256:" + mockImageDigest),
+					LastStatus:  aws.String("STOPPED"),
+					ExitCode:    aws.Int64(137),
+					Reason:      aws.String("OutOfMemoryError: Container killed due to memory usage"),
 				},
 			},
 			health:        aws.String("HEALTHY"),
@@ -110,6 +116,14 @@ func TestTask_TaskStatus(t *testing.T) {
 				StartedAt:     startTime,
 				StoppedAt:     stopTime,
 				StoppedReason: "some reason",
+				Containers: []ContainerStatus{
+					{
+						Name:       "nginx",
+						LastStatus: "STOPPED",
+						ExitCode:   aws.Int64(137),
+						Reason:     "OutOfMemoryError: Container killed due to memory usage",
+					},
+				},
 			},
 		},
 	}
@@ -700,3 +714,33 @@ func Test_TaskDefinitionVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_TaskDefinitionFamily(t *testing.T) {
+	testCases := map[string]struct {
+		inARN string
+
+		wanted      string
+		wantedError error
+	}{
+		"success": {
+			inARN:  "arn:aws:ecs:us-east-1:568623488001:task-definition/copilot-db-migrate:6",
+			wanted: "copilot-db-migrate",
+		},
+		"unable to parse": {
+			inARN:       "random not ARN",
+			wantedError: errors.New("parse ECS task definition ARN: arn: invalid prefix"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := TaskDefinitionFamily(tc.inARN)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -53,6 +53,7 @@ func TestIdentity_Get(t *testing.T) {
 				}, nil)
 			},
 			wantIdentity: Caller{
+				ARN:         mockARN,
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws:iam::%s:root", mockAccount),
 				UserID:      mockUserID,
@@ -67,6 +68,7 @@ func TestIdentity_Get(t *testing.T) {
 				}, nil)
 			},
 			wantIdentity: Caller{
+				ARN:         mockChinaARN,
 				Account:     mockAccount,
 				RootUserARN: fmt.Sprintf("arn:aws-cn:iam::%s:root", mockAccount),
 				UserID:      mockUserID,
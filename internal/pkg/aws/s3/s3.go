@@ -33,6 +33,7 @@ type s3ManagerAPI interface {
 type s3API interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 	HeadBucket (input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
 }
 
@@ -104,6 +105,17 @@ func (s *S3) ZipAndUpload(bucket, key string, files ...NamedBinary) (string, err
 	return resp.Location, nil
 }
 
+// DeleteObject deletes the object under the key in the bucket.
+func (s *S3) DeleteObject(bucket, key string) error {
+	if _, err := s.s3Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		return fmt.Errorf("delete %s from bucket %s: %w", key, bucket, err)
+	}
+	return nil
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
@@ -388,6 +388,53 @@ func TestS3_EmptyBucket(t *testing.T) {
 	}
 }
 
+func TestS3_DeleteObject(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantErr error
+	}{
+		"should delete the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObject(&s3.DeleteObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3.DeleteObjectOutput{}, nil)
+			},
+		},
+		"should return error if the object cannot be deleted": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("delete mockKey from bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			gotErr := service.DeleteObject("mockBucket", "mockKey")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestS3_ParseURL(t *testing.T) {
 	testCases := map[string]struct {
 		inURL string
@@ -6,10 +6,12 @@ package secretsmanager
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -17,6 +19,11 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	DescribeSecret(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
+	PutSecretValue(*secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
+	TagResource(*secretsmanager.TagResourceInput) (*secretsmanager.TagResourceOutput, error)
+	GetSecretValue(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+	ListSecrets(*secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -40,6 +47,14 @@ func New() (*SecretsManager, error) {
 	}, nil
 }
 
+// NewWithSession returns a SecretsManager configured against the input session.
+func NewWithSession(s *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(s),
+		sessionRegion:  aws.StringValue(s.Config.Region),
+	}
+}
+
 var secretTags = func() []*secretsmanager.Tag {
 	timestamp := time.Now().UTC().Format(time.UnixDate)
 	return []*secretsmanager.Tag{
@@ -75,7 +90,135 @@ func (s *SecretsManager) CreateSecret(secretName, secretString string) (string,
 	return aws.StringValue(resp.ARN), nil
 }
 
+// PutSecretInput contains fields needed to create or update a secret.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	Overwrite bool
+	Tags      map[string]string
+}
+
+// PutSecretOutput contains the ARN of the secret and whether an existing secret was overwritten.
+type PutSecretOutput struct {
+	ARN         string
+	Overwritten bool
+}
+
+// PutSecret tries to create the secret with the tags, and overwrites it if the secret exists and that `Overwrite` is true.
+// ErrSecretAlreadyExists is returned if the secret exists and `Overwrite` is false.
+func (s *SecretsManager) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	tags := convertTags(in.Tags)
+	created, err := s.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+		Tags:         tags,
+	})
+	if err == nil {
+		return &PutSecretOutput{
+			ARN: aws.StringValue(created.ARN),
+		}, nil
+	}
+	if !isErrCode(err, secretsmanager.ErrCodeResourceExistsException) {
+		return nil, fmt.Errorf("create secret %s: %w", in.Name, err)
+	}
+	if !in.Overwrite {
+		return nil, &ErrSecretAlreadyExists{
+			secretName: in.Name,
+			parentErr:  err,
+		}
+	}
+	updated, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update secret %s: %w", in.Name, err)
+	}
+	if _, err := s.secretsManager.TagResource(&secretsmanager.TagResourceInput{
+		SecretId: aws.String(in.Name),
+		Tags:     tags,
+	}); err != nil {
+		return nil, fmt.Errorf("add tags to secret %s: %w", in.Name, err)
+	}
+	return &PutSecretOutput{
+		ARN:         aws.StringValue(updated.ARN),
+		Overwritten: true,
+	}, nil
+}
+
+// ListSecrets returns the names of the secrets whose name starts with the prefix.
+func (s *SecretsManager) ListSecrets(prefix string) ([]string, error) {
+	var names []string
+	var nextToken *string
+	for {
+		out, err := s.secretsManager.ListSecrets(&secretsmanager.ListSecretsInput{
+			Filters: []*secretsmanager.Filter{
+				{
+					Key:    aws.String(secretsmanager.FilterNameStringTypeName),
+					Values: aws.StringSlice([]string{prefix}),
+				},
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list secrets with prefix %s: %w", prefix, err)
+		}
+		for _, secret := range out.SecretList {
+			names = append(names, aws.StringValue(secret.Name))
+		}
+		if out.NextToken == nil {
+			return names, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+// SecretValue returns the value of the secret.
+// ErrSecretNotFound is returned if the secret does not exist.
+func (s *SecretsManager) SecretValue(secretName string) (string, error) {
+	out, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		if isErrCode(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			return "", &ErrSecretNotFound{secretName: secretName}
+		}
+		return "", fmt.Errorf("get value of secret %s: %w", secretName, err)
+	}
+	return aws.StringValue(out.SecretString), nil
+}
+
+// SecretExists returns true if the secret exists.
+// The secretID can be either the name or the ARN of the secret.
+func (s *SecretsManager) SecretExists(secretID string) (bool, error) {
+	if _, err := s.secretsManager.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(secretID),
+	}); err != nil {
+		if isErrCode(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			return false, nil
+		}
+		return false, fmt.Errorf("describe secret %s: %w", secretID, err)
+	}
+	return true, nil
+}
+
+// UpdateSecret overwrites the value of an existing secret.
+// ErrSecretNotFound is returned if the secret does not exist.
+func (s *SecretsManager) UpdateSecret(secretName, secretString string) error {
+	if _, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(secretName),
+		SecretString: aws.String(secretString),
+	}); err != nil {
+		if isErrCode(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			return &ErrSecretNotFound{secretName: secretName}
+		}
+		return fmt.Errorf("update secret %s: %w", secretName, err)
+	}
+	return nil
+}
+
 // DeleteSecret force removes the secret from SecretsManager.
+// ErrSecretNotFound is returned if the secret does not exist.
 func (s *SecretsManager) DeleteSecret(secretName string) error {
 	_, err := s.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
 		SecretId:                   aws.String(secretName),
@@ -83,11 +226,39 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	})
 
 	if err != nil {
+		if isErrCode(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			return &ErrSecretNotFound{secretName: secretName}
+		}
 		return fmt.Errorf("delete secret %s from secrets manager: %+v", secretName, err)
 	}
 	return nil
 }
 
+func isErrCode(err error, code string) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == code
+	}
+	return false
+}
+
+func convertTags(inTags map[string]string) []*secretsmanager.Tag {
+	// Sort the map so that the unit test won't be flaky.
+	keys := make([]string, 0, len(inTags))
+	for k := range inTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var tags []*secretsmanager.Tag
+	for _, key := range keys {
+		tags = append(tags, &secretsmanager.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(inTags[key]),
+		})
+	}
+	return tags
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -97,3 +268,12 @@ type ErrSecretAlreadyExists struct {
 func (err *ErrSecretAlreadyExists) Error() string {
 	return fmt.Sprintf("secret %s already exists", err.secretName)
 }
+
+// ErrSecretNotFound occurs if the secret does not exist.
+type ErrSecretNotFound struct {
+	secretName string
+}
+
+func (err *ErrSecretNotFound) Error() string {
+	return fmt.Sprintf("secret %s not found", err.secretName)
+}
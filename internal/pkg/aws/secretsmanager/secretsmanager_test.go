@@ -105,3 +105,302 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_PutSecret(t *testing.T) {
+	const (
+		mockName = "/copilot/myapp/myenv/secrets/db-password"
+		mockARN  = "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/myapp/myenv/secrets/db-password-AbCdEf"
+	)
+	mockTags := []*secretsmanager.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("myapp"),
+		},
+		{
+			Key:   aws.String("copilot-environment"),
+			Value: aws.String("myenv"),
+		},
+	}
+	mockExistsErr := awserr.New(secretsmanager.ErrCodeResourceExistsException, "", nil)
+	testCases := map[string]struct {
+		inOverwrite bool
+		callMock    func(m *mocks.Mockapi)
+
+		wanted        *PutSecretOutput
+		expectedError error
+	}{
+		"creates the secret with tags": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(&secretsmanager.CreateSecretInput{
+					Name:         aws.String(mockName),
+					SecretString: aws.String("password"),
+					Tags:         mockTags,
+				}).Return(&secretsmanager.CreateSecretOutput{ARN: aws.String(mockARN)}, nil)
+			},
+			wanted: &PutSecretOutput{
+				ARN: mockARN,
+			},
+		},
+		"returns ErrSecretAlreadyExists if overwrite is false": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockExistsErr)
+			},
+			expectedError: &ErrSecretAlreadyExists{
+				secretName: mockName,
+				parentErr:  mockExistsErr,
+			},
+		},
+		"overwrites the secret and its tags if overwrite is true": {
+			inOverwrite: true,
+			callMock: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockExistsErr),
+					m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+						SecretId:     aws.String(mockName),
+						SecretString: aws.String("password"),
+					}).Return(&secretsmanager.PutSecretValueOutput{ARN: aws.String(mockARN)}, nil),
+					m.EXPECT().TagResource(&secretsmanager.TagResourceInput{
+						SecretId: aws.String(mockName),
+						Tags:     mockTags,
+					}).Return(&secretsmanager.TagResourceOutput{}, nil),
+				)
+			},
+			wanted: &PutSecretOutput{
+				ARN:         mockARN,
+				Overwritten: true,
+			},
+		},
+		"wraps error from PutSecretValue": {
+			inOverwrite: true,
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockExistsErr)
+				m.EXPECT().PutSecretValue(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: fmt.Errorf("update secret %s: some error", mockName),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			got, err := sm.PutSecret(PutSecretInput{
+				Name:      mockName,
+				Value:     "password",
+				Overwrite: tc.inOverwrite,
+				Tags: map[string]string{
+					"copilot-environment": "myenv",
+					"copilot-application": "myapp",
+				},
+			})
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSecretsManager_ListSecrets(t *testing.T) {
+	const mockPrefix = "/copilot/myapp/myenv/secrets/"
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSecretsManager := mocks.NewMockapi(ctrl)
+	sm := SecretsManager{
+		secretsManager: mockSecretsManager,
+	}
+	filters := []*secretsmanager.Filter{
+		{
+			Key:    aws.String("name"),
+			Values: aws.StringSlice([]string{mockPrefix}),
+		},
+	}
+	gomock.InOrder(
+		mockSecretsManager.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+			Filters: filters,
+		}).Return(&secretsmanager.ListSecretsOutput{
+			SecretList: []*secretsmanager.SecretListEntry{
+				{Name: aws.String(mockPrefix + "db-password")},
+			},
+			NextToken: aws.String("token"),
+		}, nil),
+		mockSecretsManager.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+			Filters:   filters,
+			NextToken: aws.String("token"),
+		}).Return(&secretsmanager.ListSecretsOutput{
+			SecretList: []*secretsmanager.SecretListEntry{
+				{Name: aws.String(mockPrefix + "api-key")},
+			},
+		}, nil),
+	)
+
+	got, err := sm.ListSecrets(mockPrefix)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{mockPrefix + "db-password", mockPrefix + "api-key"}, got)
+}
+
+func TestSecretsManager_SecretValue(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wanted        string
+		expectedError error
+	}{
+		"returns ErrSecretNotFound if the secret does not exist": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil))
+			},
+			expectedError: &ErrSecretNotFound{secretName: mockName},
+		},
+		"returns the secret string": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockName),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("password"),
+				}, nil)
+			},
+			wanted: "password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			got, err := sm.SecretValue(mockName)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSecretsManager_SecretExists(t *testing.T) {
+	const mockARN = "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-password-AbCdEf"
+	testCases := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wanted        bool
+		expectedError error
+	}{
+		"returns false if the secret does not exist": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil))
+			},
+			wanted: false,
+		},
+		"wraps other errors": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: fmt.Errorf("describe secret %s: some error", mockARN),
+		},
+		"returns true if the secret exists": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(&secretsmanager.DescribeSecretInput{
+					SecretId: aws.String(mockARN),
+				}).Return(&secretsmanager.DescribeSecretOutput{}, nil)
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			got, err := sm.SecretExists(mockARN)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSecretsManager_UpdateSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		expectedError error
+	}{
+		"returns ErrSecretNotFound if the secret does not exist": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().PutSecretValue(gomock.Any()).Return(nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil))
+			},
+			expectedError: &ErrSecretNotFound{secretName: mockName},
+		},
+		"updates the secret": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+					SecretId:     aws.String(mockName),
+					SecretString: aws.String("new password"),
+				}).Return(&secretsmanager.PutSecretValueOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			err := sm.UpdateSecret(mockName, "new password")
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
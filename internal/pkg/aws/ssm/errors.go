@@ -10,3 +10,12 @@ type ErrParameterAlreadyExists struct {
 func (e *ErrParameterAlreadyExists) Error() string {
 	return fmt.Sprintf("parameter %s already exists", e.name)
 }
+
+// ErrParameterNotFound occurs when the parameter with name does not exist.
+type ErrParameterNotFound struct {
+	name string
+}
+
+func (e *ErrParameterNotFound) Error() string {
+	return fmt.Sprintf("parameter %s not found", e.name)
+}
@@ -49,6 +49,51 @@ func (mr *MockapiMockRecorder) AddTagsToResource(input interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToResource", reflect.TypeOf((*Mockapi)(nil).AddTagsToResource), input)
 }
 
+// DeleteParameter mocks base method.
+func (m *Mockapi) DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParameter", input)
+	ret0, _ := ret[0].(*ssm.DeleteParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteParameter indicates an expected call of DeleteParameter.
+func (mr *MockapiMockRecorder) DeleteParameter(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParameter", reflect.TypeOf((*Mockapi)(nil).DeleteParameter), input)
+}
+
+// GetParameter mocks base method.
+func (m *Mockapi) GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameter", input)
+	ret0, _ := ret[0].(*ssm.GetParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameter indicates an expected call of GetParameter.
+func (mr *MockapiMockRecorder) GetParameter(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameter", reflect.TypeOf((*Mockapi)(nil).GetParameter), input)
+}
+
+// GetParametersByPath mocks base method.
+func (m *Mockapi) GetParametersByPath(input *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParametersByPath", input)
+	ret0, _ := ret[0].(*ssm.GetParametersByPathOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParametersByPath indicates an expected call of GetParametersByPath.
+func (mr *MockapiMockRecorder) GetParametersByPath(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersByPath", reflect.TypeOf((*Mockapi)(nil).GetParametersByPath), input)
+}
+
 // PutParameter mocks base method.
 func (m *Mockapi) PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
 	m.ctrl.T.Helper()
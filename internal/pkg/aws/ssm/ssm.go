@@ -19,6 +19,9 @@ import (
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	GetParametersByPath(input *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
 }
 
 // SSM wraps an AWS SSM client.
@@ -115,6 +118,104 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return (*PutSecretOutput)(output), nil
 }
 
+// ListSecrets returns the names of the parameters directly under the path.
+// The values of the parameters are not decrypted.
+func (s *SSM) ListSecrets(path string) ([]string, error) {
+	var names []string
+	var nextToken *string
+	for {
+		out, err := s.client.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(path),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get parameters by path %s: %w", path, err)
+		}
+		for _, param := range out.Parameters {
+			names = append(names, aws.StringValue(param.Name))
+		}
+		if out.NextToken == nil {
+			return names, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+// SecretValue returns the decrypted value of the secret.
+// ErrParameterNotFound is returned if the secret does not exist.
+func (s *SSM) SecretValue(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		if isParameterNotFound(err) {
+			return "", &ErrParameterNotFound{name}
+		}
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// ParameterExists returns true if the parameter exists.
+// The name can be either the name or the ARN of the parameter.
+func (s *SSM) ParameterExists(name string) (bool, error) {
+	if _, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(name),
+	}); err != nil {
+		if isParameterNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// UpdateSecret overwrites the value of an existing secret.
+// ErrParameterNotFound is returned if the secret does not exist.
+func (s *SSM) UpdateSecret(name, value string) error {
+	// Check that the parameter exists first, otherwise overwriting it would create a parameter without tags.
+	if _, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(name),
+	}); err != nil {
+		if isParameterNotFound(err) {
+			return &ErrParameterNotFound{name}
+		}
+		return fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	if _, err := s.client.PutParameter(&ssm.PutParameterInput{
+		DataType:  aws.String("text"),
+		Type:      aws.String("SecureString"),
+		Name:      aws.String(name),
+		Value:     aws.String(value),
+		Overwrite: aws.Bool(true),
+	}); err != nil {
+		return fmt.Errorf("update parameter %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeleteSecret removes the secret.
+// ErrParameterNotFound is returned if the secret does not exist.
+func (s *SSM) DeleteSecret(name string) error {
+	if _, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	}); err != nil {
+		if isParameterNotFound(err) {
+			return &ErrParameterNotFound{name}
+		}
+		return fmt.Errorf("delete parameter %s: %w", name, err)
+	}
+	return nil
+}
+
+func isParameterNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ssm.ErrCodeParameterNotFound
+	}
+	return false
+}
+
 func convertTags(inTags map[string]string) []*ssm.Tag {
 	// Sort the map so that the unit test won't be flaky.
 	keys := make([]string, 0, len(inTags))
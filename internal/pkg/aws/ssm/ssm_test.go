@@ -361,3 +361,284 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_ListSecrets(t *testing.T) {
+	const mockPath = "/copilot/myapp/myenv/secrets/"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wanted      []string
+		wantedError error
+	}{
+		"wraps error from GetParametersByPath": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParametersByPath(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get parameters by path /copilot/myapp/myenv/secrets/: some error"),
+		},
+		"returns names from all pages": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetParametersByPath(&ssm.GetParametersByPathInput{
+						Path: aws.String(mockPath),
+					}).Return(&ssm.GetParametersByPathOutput{
+						Parameters: []*ssm.Parameter{
+							{Name: aws.String(mockPath + "db-password")},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().GetParametersByPath(&ssm.GetParametersByPathInput{
+						Path:      aws.String(mockPath),
+						NextToken: aws.String("token"),
+					}).Return(&ssm.GetParametersByPathOutput{
+						Parameters: []*ssm.Parameter{
+							{Name: aws.String(mockPath + "api-key")},
+						},
+					}, nil),
+				)
+			},
+			wanted: []string{mockPath + "db-password", mockPath + "api-key"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.ListSecrets(mockPath)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSSM_SecretValue(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wanted      string
+		wantedError error
+	}{
+		"returns ErrParameterNotFound if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{mockName},
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get parameter %s: some error", mockName),
+		},
+		"returns the decrypted value": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String(mockName),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("super secure password"),
+					},
+				}, nil)
+			},
+			wanted: "super secure password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.SecretValue(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSSM_ParameterExists(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wanted      bool
+		wantedError error
+	}{
+		"returns false if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wanted: false,
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get parameter %s: some error", mockName),
+		},
+		"returns true without decrypting the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name: aws.String(mockName),
+				}).Return(&ssm.GetParameterOutput{}, nil)
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.ParameterExists(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSSM_UpdateSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"returns ErrParameterNotFound if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name: aws.String(mockName),
+				}).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{mockName},
+		},
+		"wraps error from PutParameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(&ssm.GetParameterOutput{}, nil)
+				m.EXPECT().PutParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("update parameter %s: some error", mockName),
+		},
+		"overwrites the existing parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(&ssm.GetParameterOutput{}, nil)
+				m.EXPECT().PutParameter(&ssm.PutParameterInput{
+					DataType:  aws.String("text"),
+					Type:      aws.String("SecureString"),
+					Name:      aws.String(mockName),
+					Value:     aws.String("new password"),
+					Overwrite: aws.Bool(true),
+				}).Return(&ssm.PutParameterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			err := client.UpdateSecret(mockName, "new password")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"returns ErrParameterNotFound if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{mockName},
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("delete parameter %s: some error", mockName),
+		},
+		"deletes the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String(mockName),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			err := client.DeleteSecret(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
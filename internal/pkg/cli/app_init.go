@@ -36,6 +36,45 @@ type initAppVars struct {
 	name         string
 	domainName   string
 	resourceTags map[string]string
+	imageRepositoryVars
+}
+
+// imageRepositoryVars holds the flag values for the settings of the ECR repositories of an application.
+type imageRepositoryVars struct {
+	scanOnPush         bool
+	immutableTags      bool
+	maxImageCount      int
+	untaggedExpiryDays int
+}
+
+func (v imageRepositoryVars) validate() error {
+	if v.maxImageCount < 0 {
+		return fmt.Errorf("--%s cannot be negative", imageMaxCountFlag)
+	}
+	if v.untaggedExpiryDays < 0 {
+		return fmt.Errorf("--%s cannot be negative", imageUntaggedExpiryDaysFlag)
+	}
+	return nil
+}
+
+// imageRepositoryConfig returns the settings of the ECR repositories, or nil if none is set.
+func (v imageRepositoryVars) imageRepositoryConfig() *config.ImageRepositoryConfig {
+	if v == (imageRepositoryVars{}) {
+		return nil
+	}
+	return &config.ImageRepositoryConfig{
+		ScanOnPush:         v.scanOnPush,
+		ImmutableTags:      v.immutableTags,
+		MaxImageCount:      v.maxImageCount,
+		UntaggedExpiryDays: v.untaggedExpiryDays,
+	}
+}
+
+func addImageRepositoryFlags(cmd *cobra.Command, vars *imageRepositoryVars) {
+	cmd.Flags().BoolVar(&vars.scanOnPush, imageScanOnPushFlag, false, imageScanOnPushFlagDescription)
+	cmd.Flags().BoolVar(&vars.immutableTags, imageImmutableTagsFlag, false, imageImmutableTagsFlagDescription)
+	cmd.Flags().IntVar(&vars.maxImageCount, imageMaxCountFlag, 0, imageMaxCountFlagDescription)
+	cmd.Flags().IntVar(&vars.untaggedExpiryDays, imageUntaggedExpiryDaysFlag, 0, imageUntaggedExpiryDaysFlagDescription)
 }
 
 type initAppOpts struct {
@@ -99,7 +138,7 @@ func (o *initAppOpts) Validate() error {
 		}
 		o.cachedHostedZoneID = id
 	}
-	return nil
+	return o.imageRepositoryVars.validate()
 }
 
 // Ask prompts the user for any required arguments that they didn't provide.
@@ -182,6 +221,7 @@ func (o *initAppOpts) Execute() error {
 		DomainHostedZoneID: hostedZoneID,
 		AdditionalTags:     o.resourceTags,
 		Version:            deploy.LatestAppTemplateVersion,
+		ImageRepository:    o.imageRepositoryConfig(),
 	})
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtAppInitFailed, color.HighlightUserInput(o.name)))
@@ -195,6 +235,7 @@ func (o *initAppOpts) Execute() error {
 		Domain:             o.domainName,
 		DomainHostedZoneID: hostedZoneID,
 		Tags:               o.resourceTags,
+		ImageRepository:    o.imageRepositoryConfig(),
 	})
 }
 
@@ -278,7 +319,9 @@ An application is a collection of containerized services that operate together.`
   Create a new application with an existing domain name in Amazon Route53.
   /code $ copilot app init --domain example.com
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application that scans images on push and keeps the 10 most recent images of each service.
+  /code $ copilot app init --image-scan-on-push --image-max-count 10`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -308,5 +351,6 @@ An application is a collection of containerized services that operate together.`
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	addImageRepositoryFlags(cmd, &vars.imageRepositoryVars)
 	return cmd
 }
@@ -23,6 +23,7 @@ func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName      string
 		inDomainName   string
+		inMaxCount     int
 		mockRoute53Svc func(m *mocks.MockdomainHostedZoneGetter)
 		mockStore      func(m *mocks.Mockstore)
 
@@ -125,6 +126,12 @@ func TestInitAppOpts_Validate(t *testing.T) {
 			mockStore:   func(m *mocks.Mockstore) {},
 			wantedError: "",
 		},
+		"errors if the maximum number of images is negative": {
+			inMaxCount:     -1,
+			mockRoute53Svc: func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+			wantedError:    "--image-max-count cannot be negative",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -142,6 +149,9 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				initAppVars: initAppVars{
 					name:       tc.inAppName,
 					domainName: tc.inDomainName,
+					imageRepositoryVars: imageRepositoryVars{
+						maxImageCount: tc.inMaxCount,
+					},
 				},
 			}
 
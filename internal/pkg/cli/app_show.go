@@ -6,6 +6,7 @@ package cli
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -24,9 +26,21 @@ const (
 	appShowNameHelpPrompt = "An application is a collection of related services."
 )
 
+// Formats of the workload graph.
+const (
+	graphFormatTree    = "tree"
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+var graphFormats = []string{graphFormatTree, graphFormatDOT, graphFormatMermaid}
+
 type showAppVars struct {
 	name             string
 	shouldOutputJSON bool
+	graph            bool
+	graphFormat      string
+	envName          string
 }
 
 type showAppOpts struct {
@@ -37,6 +51,9 @@ type showAppOpts struct {
 	sel              appSelector
 	pipelineSvc      pipelineGetter
 	newVersionGetter func(string) (versionGetter, error)
+
+	graphDescriber     workloadGraphDescriber
+	initGraphDescriber func() error
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
@@ -48,7 +65,11 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
-	return &showAppOpts{
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &showAppOpts{
 		showAppVars: vars,
 		store:       store,
 		w:           log.OutputWriter,
@@ -61,7 +82,45 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 			}
 			return d, nil
 		},
-	}, nil
+	}
+	opts.initGraphDescriber = func() error {
+		wls, err := store.ListWorkloads(opts.name)
+		if err != nil {
+			return fmt.Errorf("list workloads in application %s: %w", opts.name, err)
+		}
+		var deployed []string
+		if opts.envName != "" {
+			svcs, err := deployStore.ListDeployedServices(opts.name, opts.envName)
+			if err != nil {
+				return fmt.Errorf("list services deployed to environment %s: %w", opts.envName, err)
+			}
+			jobs, err := deployStore.ListDeployedJobs(opts.name, opts.envName)
+			if err != nil {
+				return fmt.Errorf("list jobs deployed to environment %s: %w", opts.envName, err)
+			}
+			deployed = append(svcs, jobs...)
+		}
+		cfg := describe.NewGraphDescriberConfig{
+			App:               opts.name,
+			Env:               opts.envName,
+			Workloads:         wls,
+			DeployedWorkloads: deployed,
+			ConfigStore:       store,
+		}
+		// The manifests are only available if the command is run from the workspace of the application.
+		if ws, err := workspace.New(); err == nil {
+			if summary, err := ws.Summary(); err == nil && summary.Application == opts.name {
+				cfg.Workspace = ws
+			}
+		}
+		d, err := describe.NewGraphDescriber(cfg)
+		if err != nil {
+			return fmt.Errorf("create graph describer for application %s: %w", opts.name, err)
+		}
+		opts.graphDescriber = d
+		return nil
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
@@ -72,7 +131,23 @@ func (o *showAppOpts) Validate() error {
 			return fmt.Errorf("get application %s: %w", o.name, err)
 		}
 	}
-
+	if !o.graph {
+		if o.envName != "" {
+			return fmt.Errorf("--%s must be used with --%s", envFlag, graphFlag)
+		}
+		return nil
+	}
+	if !contains(o.graphFormat, graphFormats) {
+		return fmt.Errorf("invalid graph format %s: must be one of %s", o.graphFormat, strings.Join(graphFormats, ", "))
+	}
+	if o.shouldOutputJSON && o.graphFormat != graphFormatTree {
+		return fmt.Errorf("cannot specify both --%s and --%s", jsonFlag, graphFormatFlag)
+	}
+	if o.name != "" && o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.name, o.envName); err != nil {
+			return fmt.Errorf("get environment %s: %w", o.envName, err)
+		}
+	}
 	return nil
 }
 
@@ -85,8 +160,11 @@ func (o *showAppOpts) Ask() error {
 	return nil
 }
 
-// Execute writes the application's description.
+// Execute writes the application's description, or the graph of its workloads if --graph is set.
 func (o *showAppOpts) Execute() error {
+	if o.graph {
+		return o.showGraph()
+	}
 	description, err := o.description()
 	if err != nil {
 		return err
@@ -103,6 +181,33 @@ func (o *showAppOpts) Execute() error {
 	return nil
 }
 
+func (o *showAppOpts) showGraph() error {
+	if err := o.initGraphDescriber(); err != nil {
+		return err
+	}
+	graph, err := o.graphDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe workload graph of application %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := graph.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	switch o.graphFormat {
+	case graphFormatDOT:
+		fmt.Fprint(o.w, graph.DOT())
+	case graphFormatMermaid:
+		fmt.Fprint(o.w, graph.Mermaid())
+	default:
+		fmt.Fprint(o.w, graph.HumanString())
+	}
+	return nil
+}
+
 func (o *showAppOpts) description() (*describe.App, error) {
 	app, err := o.store.GetApplication(o.name)
 	if err != nil {
@@ -180,7 +285,11 @@ func buildAppShowCmd() *cobra.Command {
 		Long:  "Shows configuration, environments and services for an application.",
 		Example: `
   Shows info about the application "my-app"
-  /code $ copilot app show -n my-app`,
+  /code $ copilot app show -n my-app
+  Shows which services of "my-app" call or subscribe to which, and their addons, as a Mermaid flowchart.
+  /code $ copilot app show -n my-app --graph --format mermaid
+  Renders the workload graph of the services deployed in the "test" environment with Graphviz.
+  /code $ copilot app show -n my-app --graph --env test --format dot | dot -Tsvg > graph.svg`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowAppOpts(vars)
 			if err != nil {
@@ -202,5 +311,8 @@ func buildAppShowCmd() *cobra.Command {
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.graph, graphFlag, false, graphFlagDescription)
+	cmd.Flags().StringVar(&vars.graphFormat, graphFormatFlag, graphFormatTree, graphFormatFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", graphEnvFlagDescription)
 	return cmd
 }
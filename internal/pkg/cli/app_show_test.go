@@ -13,25 +13,66 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 type showAppMocks struct {
-	storeSvc      *mocks.Mockstore
-	sel           *mocks.MockappSelector
-	pipelineSvc   *mocks.MockpipelineGetter
-	versionGetter *mocks.MockversionGetter
+	storeSvc       *mocks.Mockstore
+	sel            *mocks.MockappSelector
+	pipelineSvc    *mocks.MockpipelineGetter
+	versionGetter  *mocks.MockversionGetter
+	graphDescriber *mocks.MockworkloadGraphDescriber
 }
 
 func TestShowAppOpts_Validate(t *testing.T) {
 	testError := errors.New("some error")
 	testCases := map[string]struct {
-		inAppName  string
-		setupMocks func(mocks showAppMocks)
+		inAppName     string
+		inGraph       bool
+		inGraphFormat string
+		inEnvName     string
+		inJSON        bool
+		setupMocks    func(mocks showAppMocks)
 
 		wantedError error
 	}{
+		"error if --env is used without --graph": {
+			inEnvName:  "test",
+			setupMocks: func(m showAppMocks) {},
+
+			wantedError: errors.New("--env must be used with --graph"),
+		},
+		"error if the graph format is invalid": {
+			inGraph:       true,
+			inGraphFormat: "svg",
+			setupMocks:    func(m showAppMocks) {},
+
+			wantedError: errors.New("invalid graph format svg: must be one of tree, dot, mermaid"),
+		},
+		"error if --json is used with a graph format": {
+			inGraph:       true,
+			inGraphFormat: graphFormatDOT,
+			inJSON:        true,
+			setupMocks:    func(m showAppMocks) {},
+
+			wantedError: errors.New("cannot specify both --json and --format"),
+		},
+		"error if the environment does not exist": {
+			inAppName:     "my-app",
+			inGraph:       true,
+			inGraphFormat: graphFormatTree,
+			inEnvName:     "test",
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "test").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get environment test: %w", testError),
+		},
 		"valid app name": {
 			inAppName: "my-app",
 
@@ -67,7 +108,11 @@ func TestShowAppOpts_Validate(t *testing.T) {
 
 			opts := &showAppOpts{
 				showAppVars: showAppVars{
-					name: tc.inAppName,
+					name:             tc.inAppName,
+					graph:            tc.inGraph,
+					graphFormat:      tc.inGraphFormat,
+					envName:          tc.inEnvName,
+					shouldOutputJSON: tc.inJSON,
 				},
 				store: mockStoreReader,
 			}
@@ -483,3 +528,112 @@ Pipelines
 		})
 	}
 }
+
+func TestShowAppOpts_ExecuteGraph(t *testing.T) {
+	graph := &describe.WorkloadGraph{
+		App: "my-app",
+		Workloads: []*describe.WorkloadNode{
+			{Name: "frontend", Type: "Load Balanced Web Service"},
+			{
+				Name: "orders",
+				Type: "Backend Service",
+				Addons: []*describe.WorkloadAddon{
+					{Name: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+				},
+			},
+		},
+		Edges: []*describe.WorkloadEdge{
+			{From: "frontend", To: "orders", Kind: describe.EdgeKindCalls},
+		},
+	}
+	testCases := map[string]struct {
+		inGraphFormat string
+		inJSON        bool
+		setupMocks    func(mocks showAppMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns error if fail to describe the graph": {
+			inGraphFormat: graphFormatTree,
+			setupMocks: func(m showAppMocks) {
+				m.graphDescriber.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe workload graph of application my-app: some error"),
+		},
+		"writes the graph as a text tree": {
+			inGraphFormat: graphFormatTree,
+			setupMocks: func(m showAppMocks) {
+				m.graphDescriber.EXPECT().Describe().Return(graph, nil)
+			},
+
+			wantedContent: `frontend (Load Balanced Web Service)
+└── calls orders
+orders (Backend Service)
+└── owns OrdersTable (AWS::DynamoDB::Table)
+`,
+		},
+		"writes the graph as a mermaid flowchart": {
+			inGraphFormat: graphFormatMermaid,
+			setupMocks: func(m showAppMocks) {
+				m.graphDescriber.EXPECT().Describe().Return(graph, nil)
+			},
+
+			wantedContent: `graph LR
+  n_frontend["frontend<br/>Load Balanced Web Service"]
+  n_orders["orders<br/>Backend Service"]
+  n_orders_OrdersTable[("OrdersTable<br/>AWS::DynamoDB::Table")]
+  n_frontend -->|calls| n_orders
+  n_orders -.->|owns| n_orders_OrdersTable
+`,
+		},
+		"writes the graph in JSON": {
+			inGraphFormat: graphFormatTree,
+			inJSON:        true,
+			setupMocks: func(m showAppMocks) {
+				m.graphDescriber.EXPECT().Describe().Return(graph, nil)
+			},
+
+			wantedContent: `{"application":"my-app","workloads":[{"name":"frontend","type":"Load Balanced Web Service","addons":null},{"name":"orders","type":"Backend Service","addons":[{"name":"OrdersTable","type":"AWS::DynamoDB::Table"}]}],"edges":[{"from":"frontend","to":"orders","kind":"calls"}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			m := showAppMocks{
+				graphDescriber: mocks.NewMockworkloadGraphDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &showAppOpts{
+				showAppVars: showAppVars{
+					name:             "my-app",
+					graph:            true,
+					graphFormat:      tc.inGraphFormat,
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:                  b,
+				graphDescriber:     m.graphDescriber,
+				initGraphDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -27,6 +27,10 @@ const (
 	fmtAppUpgradeFailed   = "Failed to upgrade application %s's template to version %s.\n"
 	fmtAppUpgradeComplete = "Upgraded application %s's template to version %s.\n"
 
+	fmtAppUpdateImageRepositoryStart    = "Updating the image repository settings of application %s."
+	fmtAppUpdateImageRepositoryFailed   = "Failed to update the image repository settings of application %s.\n"
+	fmtAppUpdateImageRepositoryComplete = "Updated the image repository settings of application %s.\n"
+
 	appUpgradeNamePrompt     = "Which application would you like to upgrade?"
 	appUpgradeNameHelpPrompt = "An application is a collection of related services."
 )
@@ -34,6 +38,8 @@ const (
 // appUpgradeVars holds flag values.
 type appUpgradeVars struct {
 	name string
+	imageRepositoryVars
+	changedImageRepositoryFlags []string // Names of the image repository flags set by the user.
 }
 
 // appUpgradeOpts represents the app upgrade command and holds the necessary data
@@ -83,7 +89,7 @@ func (o *appUpgradeOpts) Validate() error {
 			return fmt.Errorf("get application %s: %w", o.name, err)
 		}
 	}
-	return nil
+	return o.imageRepositoryVars.validate()
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -96,18 +102,25 @@ func (o *appUpgradeOpts) Ask() error {
 
 // Execute updates the cloudformation stack as well as the stackset of an application to the latest version.
 // If any stack is busy updating, it spins and waits until the stack can be updated.
+// If the image repository settings are changed, the application is redeployed even if it is on the latest version.
 func (o *appUpgradeOpts) Execute() error {
 	version, err := o.versionGetter.Version()
 	if err != nil {
 		return fmt.Errorf("get template version of application %s: %v", o.name, err)
 	}
 	if !shouldUpgradeApp(o.name, version) {
-		return nil
+		if len(o.changedImageRepositoryFlags) == 0 {
+			return nil
+		}
+		return o.updateImageRepository(version)
 	}
 	app, err := o.store.GetApplication(o.name)
 	if err != nil {
 		return fmt.Errorf("get application %s: %w", o.name, err)
 	}
+	if len(o.changedImageRepositoryFlags) != 0 {
+		app.ImageRepository = o.updatedImageRepositoryConfig(app.ImageRepository)
+	}
 	o.prog.Start(fmt.Sprintf(fmtAppUpgradeStart, color.HighlightUserInput(o.name), color.Emphasize(version), color.Emphasize(deploy.LatestAppTemplateVersion)))
 	defer func() {
 		if err != nil {
@@ -123,6 +136,49 @@ func (o *appUpgradeOpts) Execute() error {
 	return nil
 }
 
+func (o *appUpgradeOpts) updateImageRepository(version string) (err error) {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	app.ImageRepository = o.updatedImageRepositoryConfig(app.ImageRepository)
+	o.prog.Start(fmt.Sprintf(fmtAppUpdateImageRepositoryStart, color.HighlightUserInput(o.name)))
+	defer func() {
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtAppUpdateImageRepositoryFailed, color.HighlightUserInput(o.name)))
+			return
+		}
+		o.prog.Stop(log.Ssuccessf(fmtAppUpdateImageRepositoryComplete, color.HighlightUserInput(o.name)))
+	}()
+	// Redeploy the application at its current version so that the repositories pick up the new settings.
+	return o.upgradeApplication(app, version, version)
+}
+
+// updatedImageRepositoryConfig returns the image repository settings of the app with only the flags set by the user applied,
+// so that the settings that aren't passed again are preserved.
+func (o *appUpgradeOpts) updatedImageRepositoryConfig(current *config.ImageRepositoryConfig) *config.ImageRepositoryConfig {
+	var updated config.ImageRepositoryConfig
+	if current != nil {
+		updated = *current
+	}
+	for _, flag := range o.changedImageRepositoryFlags {
+		switch flag {
+		case imageScanOnPushFlag:
+			updated.ScanOnPush = o.scanOnPush
+		case imageImmutableTagsFlag:
+			updated.ImmutableTags = o.immutableTags
+		case imageMaxCountFlag:
+			updated.MaxImageCount = o.maxImageCount
+		case imageUntaggedExpiryDaysFlag:
+			updated.UntaggedExpiryDays = o.untaggedExpiryDays
+		}
+	}
+	if updated == (config.ImageRepositoryConfig{}) {
+		return nil
+	}
+	return &updated
+}
+
 func (o *appUpgradeOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -170,6 +226,7 @@ func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion
 		DomainName:         app.Domain,
 		DomainHostedZoneID: app.DomainHostedZoneID,
 		Version:            toVersion,
+		ImageRepository:    app.ImageRepository,
 	}); err != nil {
 		return fmt.Errorf("upgrade application %s from version %s to version %s: %v", app.Name, fromVersion, toVersion, err)
 	}
@@ -198,15 +255,26 @@ func buildAppUpgradeCmd() *cobra.Command {
 		Short: "Upgrades the template of an application to the latest version.",
 		Example: `
     Upgrade the application "my-app" to the latest version
-    /code $ copilot app upgrade -n my-app`,
+    /code $ copilot app upgrade -n my-app
+    Scan the images of the application "my-app" on push and make their tags immutable
+    /code $ copilot app upgrade -n my-app --image-scan-on-push --image-immutable-tags`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			for _, flag := range []string{imageScanOnPushFlag, imageImmutableTagsFlag, imageMaxCountFlag, imageUntaggedExpiryDaysFlag} {
+				if cmd.Flags().Changed(flag) {
+					vars.changedImageRepositoryFlags = append(vars.changedImageRepositoryFlags, flag)
+				}
+			}
 			opts, err := newAppUpgradeOpts(vars)
 			if err != nil {
 				return err
 			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
 			return opts.Execute()
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	addImageRepositoryFlags(cmd, &vars.imageRepositoryVars)
 	return cmd
 }
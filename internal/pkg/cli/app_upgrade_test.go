@@ -343,6 +343,109 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should redeploy the application on the latest version if the image repository settings change": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+
+				imageRepository := &config.ImageRepositoryConfig{
+					ScanOnPush:    true,
+					MaxImageCount: 10,
+				}
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name:            "phonetool",
+					ImageRepository: imageRepository,
+				}).Return(nil)
+
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeApplication(&deploy.CreateAppInput{
+					Name:            "phonetool",
+					AccountID:       "1234",
+					Version:         deploy.LatestAppTemplateVersion,
+					ImageRepository: imageRepository,
+				}).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name: "phonetool",
+						imageRepositoryVars: imageRepositoryVars{
+							scanOnPush:    true,
+							maxImageCount: 10,
+						},
+						changedImageRepositoryFlags: []string{imageScanOnPushFlag, imageMaxCountFlag},
+					},
+					versionGetter: mockVersionGetter,
+					identity:      mockIdentity,
+					store:         mockStore,
+					prog:          mockProg,
+					upgrader:      mockUpgrader,
+				}
+			},
+		},
+		"should preserve the image repository settings that are not passed again": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+
+				wantedImageRepository := &config.ImageRepositoryConfig{
+					ScanOnPush:         true,
+					ImmutableTags:      true,
+					UntaggedExpiryDays: 7,
+				}
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					ImageRepository: &config.ImageRepositoryConfig{
+						ImmutableTags:      true,
+						MaxImageCount:      10,
+						UntaggedExpiryDays: 7,
+					},
+				}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name:            "phonetool",
+					ImageRepository: wantedImageRepository,
+				}).Return(nil)
+
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeApplication(&deploy.CreateAppInput{
+					Name:            "phonetool",
+					AccountID:       "1234",
+					Version:         deploy.LatestAppTemplateVersion,
+					ImageRepository: wantedImageRepository,
+				}).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name: "phonetool",
+						imageRepositoryVars: imageRepositoryVars{
+							scanOnPush: true,
+						},
+						changedImageRepositoryFlags: []string{imageScanOnPushFlag, imageMaxCountFlag},
+					},
+					versionGetter: mockVersionGetter,
+					identity:      mockIdentity,
+					store:         mockStore,
+					prog:          mockProg,
+					upgrader:      mockUpgrader,
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
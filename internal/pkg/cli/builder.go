@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+)
+
+// envContainerBuilder is the environment variable that selects the tool that builds and pushes container images.
+const envContainerBuilder = "COPILOT_BUILDER"
+
+// Container builders that can be selected with COPILOT_BUILDER.
+const (
+	dockerBuilder    = "docker"
+	podmanBuilder    = "podman"
+	buildxBuilder    = "buildx"
+	codeBuildBuilder = "codebuild"
+)
+
+var containerBuilders = []string{dockerBuilder, podmanBuilder, buildxBuilder, codeBuildBuilder}
+
+const fmtImageBuilderRoleName = "%s-ImageBuilderRole"
+
+// Platform of the images built with the "codebuild" builder.
+const (
+	codeBuildOS   = "linux"
+	codeBuildArch = "amd64"
+)
+
+// containerBuilderConfig holds the fields required to build images remotely in an environment.
+type containerBuilderConfig struct {
+	app           *config.Application
+	env           *config.Environment
+	envSession    *session.Session // Session of the environment manager role.
+	bucketSession *session.Session // Session with access to the artifact bucket of the application.
+	appCFN        appResourcesGetter
+}
+
+// newContainerBuilder returns the builder selected with the COPILOT_BUILDER environment variable.
+// The "codebuild" builder builds and pushes images with AWS CodeBuild in the environment.
+func newContainerBuilder(cfg containerBuilderConfig) (repository.ContainerLoginBuildPusher, error) {
+	if os.Getenv(envContainerBuilder) != codeBuildBuilder {
+		return newLocalContainerBuilder()
+	}
+	resources, err := cfg.appCFN.GetAppResourcesByRegion(cfg.app, cfg.env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s resources from region %s: %w", cfg.app.Name, cfg.env.Region, err)
+	}
+	if resources.S3Bucket == "" {
+		return nil, fmt.Errorf("cannot find the S3 artifact bucket in %s region", cfg.env.Region)
+	}
+	roleARN, err := imageBuilderRoleARN(cfg.env)
+	if err != nil {
+		return nil, err
+	}
+	return repository.NewCodeBuild(repository.CodeBuildConfig{
+		App:            cfg.app.Name,
+		Env:            cfg.env.Name,
+		EnvSession:     cfg.envSession,
+		ArtifactBucket: resources.S3Bucket,
+		BucketSession:  cfg.bucketSession,
+		RoleARN:        roleARN,
+		Out:            log.DiagnosticWriter,
+	}), nil
+}
+
+// newLocalContainerBuilder returns the builder selected with the COPILOT_BUILDER environment variable
+// if it builds images on this machine.
+func newLocalContainerBuilder() (repository.ContainerLoginBuildPusher, error) {
+	switch builder := os.Getenv(envContainerBuilder); builder {
+	case "", dockerBuilder:
+		return exec.NewDockerCommand(), nil
+	case podmanBuilder:
+		return exec.NewPodmanCommand(), nil
+	case buildxBuilder:
+		return exec.NewBuildxCommand(), nil
+	case codeBuildBuilder:
+		return nil, fmt.Errorf("%s %s can only build the images of services and jobs deployed to an environment", envContainerBuilder, builder)
+	default:
+		return nil, fmt.Errorf("%s %q must be one of %s", envContainerBuilder, builder, english.WordSeries(containerBuilders, "or"))
+	}
+}
+
+// newDockerEngine returns the engine used to check whether images can be built with the builder selected
+// with the COPILOT_BUILDER environment variable, and to detect the platform of the images it builds.
+func newDockerEngine() dockerEngine {
+	switch os.Getenv(envContainerBuilder) {
+	case podmanBuilder:
+		return exec.NewPodmanCommand()
+	case codeBuildBuilder:
+		return codeBuildEngine{}
+	default:
+		return exec.NewDockerCommand()
+	}
+}
+
+// codeBuildEngine is the engine of images built remotely with AWS CodeBuild, which doesn't require docker locally.
+type codeBuildEngine struct{}
+
+// CheckDockerEngineRunning returns nil as CodeBuild projects are always able to build images.
+func (codeBuildEngine) CheckDockerEngineRunning() error {
+	return nil
+}
+
+// GetPlatform returns the platform of the CodeBuild image that builds the images.
+func (codeBuildEngine) GetPlatform() (string, string, error) {
+	return codeBuildOS, codeBuildArch, nil
+}
+
+// imageBuilderRoleARN returns the ARN of the role assumed by CodeBuild to build images in the environment.
+func imageBuilderRoleARN(env *config.Environment) (string, error) {
+	parsed, err := arn.Parse(env.ManagerRoleARN)
+	if err != nil {
+		return "", fmt.Errorf("parse manager role ARN of environment %s: %w", env.Name, err)
+	}
+	return arn.ARN{
+		Partition: parsed.Partition,
+		Service:   parsed.Service,
+		AccountID: parsed.AccountID,
+		Resource:  "role/" + fmt.Sprintf(fmtImageBuilderRoleName, stack.NameForEnv(env.App, env.Name)),
+	}.String(), nil
+}
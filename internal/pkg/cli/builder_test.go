@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewContainerBuilder(t *testing.T) {
+	mockApp := &config.Application{Name: "phonetool"}
+	mockEnv := &config.Environment{
+		App:            "phonetool",
+		Name:           "test",
+		Region:         "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+	}
+	mockSession, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-west-2"),
+	})
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		builder    string
+		setupMocks func(m *mocks.MockappResourcesGetter)
+
+		wantedType  repository.ContainerLoginBuildPusher
+		wantedError error
+	}{
+		"defaults to docker": {
+			wantedType: exec.DockerCommand{},
+		},
+		"podman": {
+			builder:    "podman",
+			wantedType: exec.DockerCommand{},
+		},
+		"buildx": {
+			builder:    "buildx",
+			wantedType: exec.DockerCommand{},
+		},
+		"errors if the builder is unknown": {
+			builder:     "kaniko",
+			wantedError: errors.New(`COPILOT_BUILDER "kaniko" must be one of docker, podman, buildx or codebuild`),
+		},
+		"errors if the artifact bucket of the environment region cannot be retrieved": {
+			builder: "codebuild",
+			setupMocks: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool resources from region us-west-2: some error"),
+		},
+		"errors if the artifact bucket of the environment region does not exist": {
+			builder: "codebuild",
+			setupMocks: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{}, nil)
+			},
+			wantedError: errors.New("cannot find the S3 artifact bucket in us-west-2 region"),
+		},
+		"codebuild": {
+			builder: "codebuild",
+			setupMocks: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+			},
+			wantedType: &repository.CodeBuild{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockappResourcesGetter(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			require.NoError(t, os.Setenv(envContainerBuilder, tc.builder))
+			defer os.Unsetenv(envContainerBuilder)
+
+			// WHEN
+			builder, err := newContainerBuilder(containerBuilderConfig{
+				app:           mockApp,
+				env:           mockEnv,
+				envSession:    mockSession,
+				bucketSession: mockSession,
+				appCFN:        m,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tc.wantedType, builder)
+		})
+	}
+}
+
+func TestNewLocalContainerBuilder(t *testing.T) {
+	require.NoError(t, os.Setenv(envContainerBuilder, "codebuild"))
+	defer os.Unsetenv(envContainerBuilder)
+
+	_, err := newLocalContainerBuilder()
+
+	require.EqualError(t, err, "COPILOT_BUILDER codebuild can only build the images of services and jobs deployed to an environment")
+}
+
+func TestNewDockerEngine(t *testing.T) {
+	t.Run("uses the local docker engine by default", func(t *testing.T) {
+		require.IsType(t, exec.DockerCommand{}, newDockerEngine())
+	})
+	t.Run("does not require a local engine to build images with codebuild", func(t *testing.T) {
+		require.NoError(t, os.Setenv(envContainerBuilder, "codebuild"))
+		defer os.Unsetenv(envContainerBuilder)
+
+		engine := newDockerEngine()
+		require.NoError(t, engine.CheckDockerEngineRunning())
+		os, arch, err := engine.GetPlatform()
+		require.NoError(t, err)
+		require.Equal(t, "linux", os)
+		require.Equal(t, "amd64", arch)
+	})
+}
+
+func TestImageBuilderRoleARN(t *testing.T) {
+	testCases := map[string]struct {
+		managerRoleARN string
+
+		wanted      string
+		wantedError error
+	}{
+		"errors if the manager role ARN is invalid": {
+			managerRoleARN: "not-an-arn",
+			wantedError:    errors.New("parse manager role ARN of environment test: arn: invalid prefix"),
+		},
+		"returns the role in the account and partition of the environment": {
+			managerRoleARN: "arn:aws-cn:iam::123456789012:role/phonetool-test-EnvManagerRole",
+			wanted:         "arn:aws-cn:iam::123456789012:role/phonetool-test-ImageBuilderRole",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := imageBuilderRoleARN(&config.Environment{
+				App:            "phonetool",
+				Name:           "test",
+				ManagerRoleARN: tc.managerRoleARN,
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDriftAppNamePrompt     = "Which application is the environment in?"
+	envDriftAppNameHelpPrompt = "An application is a collection of related services."
+	envDriftNamePrompt        = "Which environment of %s would you like to check for drift?"
+	envDriftHelpPrompt        = "Drift is detected on the environment stack and on the stacks of the services and jobs deployed to it."
+
+	fmtEnvDriftStart    = "Detecting drift for environment %s and its workloads."
+	fmtEnvDriftFailed   = "Failed to detect drift for environment %s.\n"
+	fmtEnvDriftComplete = "Detected drift for environment %s and its workloads.\n"
+)
+
+type driftEnvVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type driftEnvOpts struct {
+	driftEnvVars
+
+	w                  io.Writer
+	store              store
+	describer          describer
+	sel                configSelector
+	prog               progress
+	initDriftDescriber func() error
+}
+
+func newDriftEnvOpts(vars driftEnvVars) (*driftEnvOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+
+	opts := &driftEnvOpts{
+		driftEnvVars: vars,
+		store:        configStore,
+		w:            log.OutputWriter,
+		sel:          selector.NewConfigSelect(prompt.New(), configStore),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	opts.initDriftDescriber = func() error {
+		svcs, err := deployStore.ListDeployedServices(opts.appName, opts.name)
+		if err != nil {
+			return fmt.Errorf("list services deployed to environment %s: %w", opts.name, err)
+		}
+		jobs, err := deployStore.ListDeployedJobs(opts.appName, opts.name)
+		if err != nil {
+			return fmt.Errorf("list jobs deployed to environment %s: %w", opts.name, err)
+		}
+		d, err := describe.NewDriftDescriber(describe.NewDriftDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.name,
+			IncludeEnv:  true,
+			Workloads:   append(svcs, jobs...),
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create drift describer for environment %s in application %s: %w", opts.name, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *driftEnvOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *driftEnvOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute detects drift on the environment stack, the stacks of its workloads and their addons,
+// and writes the drifted resources.
+func (o *driftEnvOpts) Execute() error {
+	if err := o.initDriftDescriber(); err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtEnvDriftStart, color.HighlightUserInput(o.name)))
+	drift, err := o.describer.Describe()
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtEnvDriftFailed, color.HighlightUserInput(o.name)))
+		return fmt.Errorf("detect drift for environment %s: %w", o.name, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtEnvDriftComplete, color.HighlightUserInput(o.name)))
+	if o.shouldOutputJSON {
+		data, err := drift.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, drift.HumanString())
+	}
+	return nil
+}
+
+func (o *driftEnvOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(envDriftAppNamePrompt, envDriftAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *driftEnvOpts) askEnvName() error {
+	if o.name != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envDriftNamePrompt, color.HighlightUserInput(o.appName)), envDriftHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvDriftCmd builds the command for detecting drift in an environment.
+func buildEnvDriftCmd() *cobra.Command {
+	vars := driftEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects resources of an environment that were changed outside of Copilot.",
+		Long: `Detects resources of an environment that were changed outside of Copilot.
+Drift is detected on the environment stack, on the stacks of the services and jobs deployed to it, and on their addons.`,
+
+		Example: `
+  Shows the resources of the environment "test" and of its workloads that drifted from their expected configuration.
+  /code $ copilot env drift -n test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDriftEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
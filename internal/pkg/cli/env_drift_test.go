@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDrift_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp     string
+		inputEnv     string
+		mockSelector func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(envDriftAppNamePrompt, envDriftAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select environment": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(gomock.Any(), envDriftHelpPrompt, "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select environment for application phonetool: some error"),
+		},
+		"prompts for the application and environment": {
+			mockSelector: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(envDriftAppNamePrompt, envDriftAppNameHelpPrompt).Return("phonetool", nil)
+				m.EXPECT().Environment(gomock.Any(), envDriftHelpPrompt, "phonetool").Return("test", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"skips prompts if the flags are set": {
+			inputApp:     "phonetool",
+			inputEnv:     "test",
+			mockSelector: func(m *mocks.MockconfigSelector) {},
+			wantedApp:    "phonetool",
+			wantedEnv:    "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockconfigSelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &driftEnvOpts{
+				driftEnvVars: driftEnvVars{
+					appName: tc.inputApp,
+					name:    tc.inputEnv,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedEnv, opts.name)
+			}
+		})
+	}
+}
+
+func TestEnvDrift_Execute(t *testing.T) {
+	mockDrift := &describe.DriftDescription{
+		Stacks: []*describe.StackDrift{
+			{
+				Name:      "phonetool-test",
+				Resources: []*stack.ResourceDrift{},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(d *mocks.Mockdescriber, p *mocks.Mockprogress)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to detect drift": {
+			setupMocks: func(d *mocks.Mockdescriber, p *mocks.Mockprogress) {
+				p.EXPECT().Start(gomock.Any())
+				d.EXPECT().Describe().Return(nil, errors.New("some error"))
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: fmt.Errorf("detect drift for environment test: some error"),
+		},
+		"writes the drift in human format": {
+			setupMocks: func(d *mocks.Mockdescriber, p *mocks.Mockprogress) {
+				p.EXPECT().Start(gomock.Any())
+				d.EXPECT().Describe().Return(mockDrift, nil)
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedContent: "phonetool-test\n\n  No drift detected.\n",
+		},
+		"writes the drift in json format": {
+			shouldOutputJSON: true,
+			setupMocks: func(d *mocks.Mockdescriber, p *mocks.Mockprogress) {
+				p.EXPECT().Start(gomock.Any())
+				d.EXPECT().Describe().Return(mockDrift, nil)
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedContent: "{\"stacks\":[{\"name\":\"phonetool-test\",\"resources\":[]}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockdescriber(ctrl)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockDescriber, mockProgress)
+			b := &bytes.Buffer{}
+			opts := &driftEnvOpts{
+				driftEnvVars: driftEnvVars{
+					appName:          "phonetool",
+					name:             "test",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:                  b,
+				describer:          mockDescriber,
+				prog:               mockProgress,
+				initDriftDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
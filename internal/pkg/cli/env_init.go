@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -67,6 +68,8 @@ https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
 	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
 	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n\n"
 	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n\n"
+
+	acmService = "acm" // The service namespace of ACM certificate ARNs.
 )
 
 var (
@@ -78,9 +81,11 @@ var (
 )
 
 type importVPCVars struct {
-	ID               string
-	PublicSubnetIDs  []string
-	PrivateSubnetIDs []string
+	ID                   string
+	PublicSubnetIDs      []string
+	PrivateSubnetIDs     []string
+	PrivateRouteTableIDs []string // Looked up when workloads reach AWS services through VPC endpoints.
+	CIDR                 string   // Looked up to allow traffic from within the VPC to the internal load balancer.
 }
 
 func (v importVPCVars) isSet() bool {
@@ -120,8 +125,12 @@ type initEnvVars struct {
 	isProduction  bool   // True means retain resources even after deletion.
 	defaultConfig bool   // True means using default environment configuration.
 
-	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
-	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
+	importVPC      importVPCVars // Existing VPC resources to use instead of creating new ones.
+	adjustVPC      adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
+	importCertARNs []string      // Existing ACM certificates to use for the HTTPS listener of the load balancer.
+	vpcEndpoints   bool          // True means workloads reach AWS services through VPC endpoints instead of NAT gateways.
+
+	enableContainerInsights bool // True means Container Insights is enabled for the environment's cluster.
 
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
@@ -205,6 +214,9 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if err := o.validateCertARNs(); err != nil {
+		return err
+	}
 	return o.validateCredentials()
 }
 
@@ -289,7 +301,8 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
-	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig(), o.importCertARNs, o.vpcEndpoints)
+	env.Telemetry = o.telemetryConfig()
 
 	// 6. Store the environment in SSM.
 	if err := o.store.CreateEnvironment(env); err != nil {
@@ -341,6 +354,19 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	return nil
 }
 
+func (o *initEnvOpts) validateCertARNs() error {
+	for _, certARN := range o.importCertARNs {
+		parsed, err := arn.Parse(certARN)
+		if err != nil {
+			return fmt.Errorf("parse certificate ARN %s: %w", certARN, err)
+		}
+		if parsed.Service != acmService {
+			return fmt.Errorf("certificate ARN %s is not an ACM certificate", certARN)
+		}
+	}
+	return nil
+}
+
 func (o *initEnvOpts) askAppName() error {
 	if o.appName != "" {
 		return nil
@@ -497,6 +523,28 @@ If you proceed without at least two public subnets, you will not be able to depl
 		}
 		o.importVPC.PrivateSubnetIDs = privateSubnets
 	}
+	if !o.vpcEndpoints {
+		hasIGW, err := o.ec2Client.HasInternetGateway(o.importVPC.ID)
+		if err != nil {
+			return fmt.Errorf("check if VPC %s has an internet gateway: %w", o.importVPC.ID, err)
+		}
+		if !hasIGW {
+			log.Infof("VPC %s has no internet gateway, so workloads will reach AWS services through VPC endpoints.\n", o.importVPC.ID)
+			o.vpcEndpoints = true
+		}
+	}
+	if o.vpcEndpoints {
+		routeTableIDs, err := o.ec2Client.RouteTableIDs(o.importVPC.ID, o.importVPC.PrivateSubnetIDs)
+		if err != nil {
+			return fmt.Errorf("get route tables of private subnets in VPC %s: %w", o.importVPC.ID, err)
+		}
+		o.importVPC.PrivateRouteTableIDs = routeTableIDs
+	}
+	cidr, err := o.ec2Client.VPCCIDR(o.importVPC.ID)
+	if err != nil {
+		return fmt.Errorf("get CIDR block of VPC %s: %w", o.importVPC.ID, err)
+	}
+	o.importVPC.CIDR = cidr
 	return nil
 }
 
@@ -537,9 +585,11 @@ func (o *initEnvOpts) importVPCConfig() *config.ImportVPC {
 		return nil
 	}
 	return &config.ImportVPC{
-		ID:               o.importVPC.ID,
-		PrivateSubnetIDs: o.importVPC.PrivateSubnetIDs,
-		PublicSubnetIDs:  o.importVPC.PublicSubnetIDs,
+		ID:                   o.importVPC.ID,
+		PrivateSubnetIDs:     o.importVPC.PrivateSubnetIDs,
+		PublicSubnetIDs:      o.importVPC.PublicSubnetIDs,
+		PrivateRouteTableIDs: o.importVPC.PrivateRouteTableIDs,
+		CIDR:                 o.importVPC.CIDR,
 	}
 }
 
@@ -554,6 +604,15 @@ func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 	}
 }
 
+func (o *initEnvOpts) telemetryConfig() *config.Telemetry {
+	if !o.enableContainerInsights {
+		return nil
+	}
+	return &config.Telemetry{
+		EnableContainerInsights: true,
+	}
+}
+
 func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map[string]string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
@@ -571,6 +630,9 @@ func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map
 		CustomResourcesURLs: customResourcesURLs,
 		AdjustVPCConfig:     o.adjustVPCConfig(),
 		ImportVPCConfig:     o.importVPCConfig(),
+		ImportCertARNs:      o.importCertARNs,
+		VPCEndpoints:        o.vpcEndpoints,
+		Telemetry:           o.telemetryConfig(),
 		Version:             deploy.LatestEnvTemplateVersion,
 	}
 
@@ -687,6 +749,12 @@ func buildEnvInitCmd() *cobra.Command {
   /code --import-public-subnets subnet-013e8b691862966cf,subnet -014661ebb7ab8681a \
   /code --import-private-subnets subnet-055fafef48fb3c547,subnet-00c9e76f288363e7f
 
+  Creates an environment with an HTTPS listener that uses imported ACM certificates.
+  /code $ copilot env init --import-cert-arns arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012
+
+  Creates an environment whose workloads reach AWS services through VPC endpoints instead of NAT gateways.
+  /code $ copilot env init --name test --vpc-endpoints --default-config
+
   Creates an environment with overridden CIDRs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
@@ -718,12 +786,15 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.importCertARNs, certsFlag, nil, certsFlagDescription)
 
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, vpcCIDRFlag, net.IPNet{}, vpcCIDRFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PublicSubnetCIDRs, publicSubnetCIDRsFlag, nil, publicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PrivateSubnetCIDRs, privateSubnetCIDRsFlag, nil, privateSubnetCIDRsFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.vpcEndpoints, vpcEndpointsFlag, false, vpcEndpointsFlagDescription)
+	cmd.Flags().BoolVar(&vars.enableContainerInsights, enableContainerInsightsFlag, false, enableContainerInsightsFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -735,11 +806,14 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(vpcEndpointsFlag))
+	flags.AddFlag(cmd.Flags().Lookup(enableContainerInsightsFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(certsFlag))
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcCIDRFlag))
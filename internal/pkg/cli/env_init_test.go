@@ -44,6 +44,7 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inPrivateIDs  []string
 		inVPCCIDR     net.IPNet
 		inPublicCIDRs []string
+		inCertARNs    []string
 
 		inProfileName     string
 		inAccessKeyID     string
@@ -130,6 +131,20 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			inPublicIDs:  []string{"mockID", "anotherMockID", "yetAnotherMockID"},
 			inPrivateIDs: []string{"mockID", "anotherMockID"},
 		},
+		"should err if certificate ARN is invalid": {
+			inCertARNs: []string{"mockCert"},
+
+			wantedErrMsg: "parse certificate ARN mockCert: arn: invalid prefix",
+		},
+		"should err if certificate ARN is not an ACM certificate": {
+			inCertARNs: []string{"arn:aws:iam::123456789012:server-certificate/mockCert"},
+
+			wantedErrMsg: "certificate ARN arn:aws:iam::123456789012:server-certificate/mockCert is not an ACM certificate",
+		},
+		"valid certificates import with default config": {
+			inDefault:  true,
+			inCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/mockCert"},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -148,8 +163,9 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 						PrivateSubnetIDs: tc.inPrivateIDs,
 						ID:               tc.inVPCID,
 					},
-					appName: tc.inAppName,
-					profile: tc.inProfileName,
+					importCertARNs: tc.inCertARNs,
+					appName:        tc.inAppName,
+					profile:        tc.inProfileName,
 					tempCreds: tempCredsVars{
 						AccessKeyID:     tc.inAccessKeyID,
 						SecretAccessKey: tc.inSecretAccessKey,
@@ -443,10 +459,12 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					Return(envInitImportEnvResourcesSelectOption, nil)
 				m.selVPC.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("mockVPC", nil)
 				m.ec2Client.EXPECT().HasDNSSupport("mockVPC").Return(true, nil)
+				m.ec2Client.EXPECT().HasInternetGateway("mockVPC").Return(true, nil)
 				m.selVPC.EXPECT().PublicSubnets(envInitPublicSubnetsSelectPrompt, "", "mockVPC").
 					Return([]string{}, nil)
 				m.selVPC.EXPECT().PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
 					Return([]string{"mockPrivateSubnet", "anotherMockPrivateSubnet"}, nil)
+				m.ec2Client.EXPECT().VPCCIDR("mockVPC").Return("10.0.0.0/16", nil)
 			},
 		},
 		"success with importing env resources with no flags": {
@@ -459,10 +477,12 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					Return(envInitImportEnvResourcesSelectOption, nil)
 				m.selVPC.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("mockVPC", nil)
 				m.ec2Client.EXPECT().HasDNSSupport("mockVPC").Return(true, nil)
+				m.ec2Client.EXPECT().HasInternetGateway("mockVPC").Return(true, nil)
 				m.selVPC.EXPECT().PublicSubnets(envInitPublicSubnetsSelectPrompt, "", "mockVPC").
 					Return([]string{"mockPublicSubnet", "anotherMockPublicSubnet"}, nil)
 				m.selVPC.EXPECT().PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
 					Return([]string{"mockPrivateSubnet", "anotherMockPrivateSubnet"}, nil)
+				m.ec2Client.EXPECT().VPCCIDR("mockVPC").Return("10.0.0.0/16", nil)
 			},
 		},
 		"success with importing env resources with flags": {
@@ -478,7 +498,78 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any()).Times(0)
 				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().HasInternetGateway("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().VPCCIDR("mockVPCID").Return("10.0.0.0/16", nil)
+			},
+		},
+		"fail to check if the imported VPC has an internet gateway": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PublicSubnetIDs:  []string{},
+				PrivateSubnetIDs: []string{"mockPrivateSubnetID", "anotherMockPrivateSubnetID"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().HasInternetGateway("mockVPCID").Return(false, mockErr)
+			},
+			wantedError: fmt.Errorf("check if VPC mockVPCID has an internet gateway: some error"),
+		},
+		"fail to get the route tables of private subnets": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PublicSubnetIDs:  []string{},
+				PrivateSubnetIDs: []string{"mockPrivateSubnetID", "anotherMockPrivateSubnetID"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().HasInternetGateway("mockVPCID").Return(false, nil)
+				m.ec2Client.EXPECT().RouteTableIDs("mockVPCID", []string{"mockPrivateSubnetID", "anotherMockPrivateSubnetID"}).
+					Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("get route tables of private subnets in VPC mockVPCID: some error"),
+		},
+		"success with enabling VPC endpoints for an imported VPC without an internet gateway": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PublicSubnetIDs:  []string{},
+				PrivateSubnetIDs: []string{"mockPrivateSubnetID", "anotherMockPrivateSubnetID"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().HasInternetGateway("mockVPCID").Return(false, nil)
+				m.ec2Client.EXPECT().RouteTableIDs("mockVPCID", []string{"mockPrivateSubnetID", "anotherMockPrivateSubnetID"}).
+					Return([]string{"rtb-1234"}, nil)
+				m.ec2Client.EXPECT().VPCCIDR("mockVPCID").Return("10.0.0.0/16", nil)
+			},
+		},
+		"fail to get the CIDR block of the imported VPC": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnetID", "anotherMockPrivateSubnetID"},
+				PublicSubnetIDs:  []string{"mockPublicSubnetID", "anotherMockPublicSubnetID"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().HasInternetGateway("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().VPCCIDR("mockVPCID").Return("", mockErr)
 			},
+			wantedError: fmt.Errorf("get CIDR block of VPC mockVPCID: some error"),
 		},
 		"fail to get VPC CIDR": {
 			inAppName: mockApp,
@@ -270,9 +270,13 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
 	var importedVPC *config.ImportVPC
 	var adjustedVPC *config.AdjustVPC
+	var importedCertARNs []string
+	var vpcEndpoints bool
 	if conf.CustomConfig != nil {
 		importedVPC = conf.CustomConfig.ImportVPC
 		adjustedVPC = conf.CustomConfig.VPCConfig
+		importedCertARNs = conf.CustomConfig.ImportCertARNs
+		vpcEndpoints = conf.CustomConfig.VPCEndpoints
 	}
 
 	if err := upgrader.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
@@ -284,6 +288,9 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 		CustomResourcesURLs: customResourcesURLs,
 		ImportVPCConfig:     importedVPC,
 		AdjustVPCConfig:     adjustedVPC,
+		ImportCertARNs:      importedCertARNs,
+		VPCEndpoints:        vpcEndpoints,
+		Telemetry:           conf.Telemetry,
 		CFNServiceRoleARN:   conf.ExecutionRoleARN,
 	}); err != nil {
 		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
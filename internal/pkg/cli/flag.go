@@ -33,6 +33,9 @@ const (
 	stackOutputDirFlag    = "output-dir"
 	limitFlag             = "limit"
 	followFlag            = "follow"
+	watchFlag             = "watch"
+	graphFlag             = "graph"
+	graphFormatFlag       = "format"
 	sinceFlag             = "since"
 	startTimeFlag         = "start-time"
 	endTimeFlag           = "end-time"
@@ -50,6 +53,9 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	sourceRepoFlag        = "source-repo"
+	parallelFlag          = "parallel"
+	secretBackendFlag     = "backend"
 
 	storageTypeFlag              = "storage-type"
 	storagePartitionKeyFlag      = "partition-key"
@@ -77,16 +83,24 @@ const (
 	entrypointFlag      = "entrypoint"
 	taskDefaultFlag     = "default"
 	generateCommandFlag = "generate-cmd"
+	taskManifestFlag    = "task-manifest"
+	platformFlag        = "platform"
+	volumeFlag          = "volume"
+	ephemeralFlag       = "ephemeral-storage"
+	spotFlag            = "spot"
 
 	vpcIDFlag          = "import-vpc-id"
 	publicSubnetsFlag  = "import-public-subnets"
 	privateSubnetsFlag = "import-private-subnets"
+	certsFlag          = "import-cert-arns"
 
 	vpcCIDRFlag            = "override-vpc-cidr"
 	publicSubnetCIDRsFlag  = "override-public-cidrs"
 	privateSubnetCIDRsFlag = "override-private-cidrs"
 
-	defaultConfigFlag = "default-config"
+	defaultConfigFlag           = "default-config"
+	vpcEndpointsFlag            = "vpc-endpoints"
+	enableContainerInsightsFlag = "container-insights"
 
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
@@ -96,6 +110,7 @@ const (
 	retriesFlag  = "retries"
 	timeoutFlag  = "timeout"
 	scheduleFlag = "schedule"
+	untilFlag    = "until"
 
 	taskIDFlag    = "task-id"
 	containerFlag = "container"
@@ -105,6 +120,11 @@ const (
 	inputFilePathFlag = "cli-input-yaml"
 
 	includeStateMachineLogsFlag = "include-state-machine"
+
+	imageScanOnPushFlag         = "image-scan-on-push"
+	imageImmutableTagsFlag      = "image-immutable-tags"
+	imageMaxCountFlag           = "image-max-count"
+	imageUntaggedExpiryDaysFlag = "image-untagged-expiry-days"
 )
 
 // Short flag names.
@@ -135,6 +155,8 @@ var (
 Mutually exclusive with -%s, --%s.`, dockerFileFlagShort, dockerFileFlag)
 	dockerFileFlagDescription = fmt.Sprintf(`Path to the Dockerfile.
 Mutually exclusive with -%s, --%s.`, imageFlagShort, imageFlag)
+	sourceRepoFlagDescription = fmt.Sprintf(`Optional. URL of a source code repository that App Runner builds the service from.
+Only applies to a %s. Mutually exclusive with --%s and --%s.`, manifest.RequestDrivenWebServiceType, dockerFileFlag, imageFlag)
 	storageTypeFlagDescription = fmt.Sprintf(`Type of storage to add. Must be one of:
 %s.`, strings.Join(template.QuoteSliceFunc(storageTypes), ", "))
 	jobTypeFlagDescription = fmt.Sprintf(`Type of job to create. Must be one of:
@@ -154,6 +176,10 @@ Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, subnetsFlag)
 Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
 	taskDeleteDefaultFlagDescription = fmt.Sprintf(`Optional. Delete a task which was launched in the default cluster and subnets.
 Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
+	taskListDefaultFlagDescription = fmt.Sprintf(`Optional. List the tasks which were launched in the default cluster and subnets.
+Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, allFlag)
+	taskListAllFlagDescription = fmt.Sprintf(`Optional. List the tasks in all the environments of the application.
+Cannot be specified with '%s' or '%s'.`, envFlag, taskDefaultFlag)
 	taskEnvFlagDescription = fmt.Sprintf(`Optional. Name of the environment.
 Cannot be specified with '%s', '%s' or '%s'.`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
 	taskAppFlagDescription = fmt.Sprintf(`Optional. Name of the application.
@@ -166,6 +192,14 @@ Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretInputFilePathFlagDescription = fmt.Sprintf(`Optional. A YAML file in which the secret values are specified.
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
 
+	secretBackendFlagDescription = fmt.Sprintf(`Optional. Where the secret is stored.
+Must be one of %s. Defaults to %s.`, strings.Join(secretBackends, " or "), secretBackendSSM)
+	secretRotateValuesFlagDescription = `New values of the secret in each environment. Specified as <environment>=<value> separated by commas.
+The secret must already exist in these environments.`
+
+	graphFormatFlagDescription = fmt.Sprintf(`Optional. Format of the graph when used with --graph.
+Must be one of: %s.`, strings.Join(graphFormats, ", "))
+
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
 Supported providers are: %s`, strings.Join(manifest.PipelineProviders, ", "))
 )
@@ -175,6 +209,7 @@ const (
 	envFlagDescription      = "Name of the environment."
 	svcFlagDescription      = "Name of the service."
 	jobFlagDescription      = "Name of the job."
+	taskFlagDescription     = "Name of the task."
 	workloadFlagDescription = "Name of the service or job."
 	nameFlagDescription     = "Name of the service, job, or task group."
 	pipelineFlagDescription = "Name of the pipeline."
@@ -186,12 +221,25 @@ const (
 	imageTagFlagDescription     = `Optional. The container image tag.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
 Allows you to categorize resources.`
+	svcDeployEnvFlagDescription = `Name of the environment.
+Separate multiple environments with commas to deploy to each of them in order.`
+	parallelFlagDescription       = "Optional. Deploys to multiple environments at the same time."
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
 	prodEnvFlagDescription        = "If the environment contains production services."
 
+	imageScanOnPushFlagDescription         = "Optional. Scans the images of your workloads for vulnerabilities when they are pushed."
+	imageImmutableTagsFlagDescription      = "Optional. Prevents the tags of the images of your workloads from being overwritten."
+	imageMaxCountFlagDescription           = "Optional. The number of most recent images to keep in each repository."
+	imageUntaggedExpiryDaysFlagDescription = "Optional. The number of days after which untagged images are expired."
+
+	graphFlagDescription    = "Optional. Shows which services call or subscribe to which, and the addons each workload owns."
+	graphEnvFlagDescription = `Optional. Name of the environment whose deployed stacks complement
+the manifests in the workspace. Must be used with --graph.`
+
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
 	followFlagDescription = "Optional. Specifies if the logs should be streamed."
+	watchFlagDescription  = "Optional. Refreshes the status until interrupted with Ctrl-C."
 	sinceFlagDescription  = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
@@ -248,16 +296,38 @@ Tasks with the same group name share the same set of resources.
 To use it for an ECS service, specify --generate-cmd <cluster name>/<service name>.
 Alternatively, if the service or job is created with Copilot, specify --generate-cmd <application>/<environment>/<service or job name>.
 Cannot be specified with any other flags.`
+	taskManifestFlagDescription = `Optional. The name of a task manifest under copilot/tasks/ to read the task configuration from.
+Flags that are specified override the values in the manifest.`
+	volumeFlagDescription = `Optional. Mount an EFS file system, specified as name:fsid:/container/path[:ro].
+Use "copilot" as the fsid to mount the Copilot-managed EFS file system of the environment.
+Can be specified multiple times.`
+	ephemeralFlagDescription    = "Optional. Size in GiB of the ephemeral storage of the task, between 20 and 200."
+	taskPlatformFlagDescription = `Optional. The platform to run the task on and to build its image for.
+Must be one of "linux/x86_64", "linux/arm64" or "windows/x86_64". Defaults to "linux/x86_64".`
+	spotFlagDescription = `Optional. Run the task on Fargate Spot capacity, which can be interrupted.
+Only supported on the "linux/x86_64" platform.`
+	taskScheduleFlagDescription = `Optional. Run the task on a recurring schedule instead of once.
+Accepts cron expressions of the format (M H DoM M DoW) and schedule definition strings.
+For example: "0 * * * *", "@daily", "@every 1h30m", "rate(10 minutes)".`
+	untilFlagDescription = `Optional. Stop running the scheduled task after this time.
+Accepts an RFC3339 timestamp such as "2021-06-30T17:00:00Z" or a duration from now such as "72h".`
+	taskTimeoutFlagDescription = `Optional. Stop the tasks if they are still running after this duration.
+Accepts valid Go duration strings. For example: "30m", "1h30m". Requires 'follow'.`
 
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
+	certsFlagDescription          = "Optional. Apply existing ACM certificates to the HTTPS listener of the load balancer."
 
 	vpcCIDRFlagDescription            = "Optional. Global CIDR to use for VPC (default 10.0.0.0/16)."
 	publicSubnetCIDRsFlagDescription  = "Optional. CIDR to use for public subnets (default 10.0.0.0/24,10.0.1.0/24)."
 	privateSubnetCIDRsFlagDescription = "Optional. CIDR to use for private subnets (default 10.0.2.0/24,10.0.3.0/24)."
 
 	defaultConfigFlagDescription = "Optional. Skip prompting and use default environment configuration."
+	vpcEndpointsFlagDescription  = `Optional. Reach AWS services through VPC endpoints instead of NAT gateways
+for workloads placed in private subnets.
+Enabled by default for imported VPCs without an internet gateway.`
+	enableContainerInsightsFlagDescription = "Optional. Enable CloudWatch Container Insights for the environment's cluster."
 
 	accessKeyIDFlagDescription     = "Optional. An AWS access key."
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key."
@@ -279,5 +349,8 @@ are also accepted.`
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
-	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+	secretOverwriteFlagDescription  = "Optional. Whether to overwrite an existing secret."
+	secretFlagDescription           = "Name of the secret."
+	secretEnvFlagDescription        = "Name of the environment to show the secret from."
+	secretDeleteEnvsFlagDescription = "Optional. Environments to delete the secret from, separated by commas. Defaults to all environments."
 )
@@ -218,7 +218,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 					init:         wlInitializer,
 					sel:          sel,
 					prompt:       prompt,
-					dockerEngine: exec.NewDockerCommand(),
+					dockerEngine: newDockerEngine(),
 					initParser: func(s string) dockerfileParser {
 						return exec.NewDockerfile(fs, s)
 					},
@@ -238,7 +238,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 					init:         wlInitializer,
 					sel:          sel,
 					prompt:       prompt,
-					dockerEngine: exec.NewDockerCommand(),
+					dockerEngine: newDockerEngine(),
 				}
 				opts.dockerfile = func(path string) dockerfileParser {
 					if opts.df != nil {
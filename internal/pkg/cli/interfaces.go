@@ -7,6 +7,7 @@ import (
 	"encoding"
 	"io"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -183,6 +184,11 @@ type runner interface {
 
 type eventsWriter interface {
 	WriteEventsUntilStopped() error
+	StoppedTasks() []*awsecs.Task
+}
+
+type ecsTaskStopper interface {
+	StopTasks(tasks []string, opts ...awsecs.StopTasksOpts) error
 }
 
 type defaultSessionProvider interface {
@@ -233,6 +239,16 @@ type copilotDirGetter interface {
 	CopilotDirPath() (string, error)
 }
 
+type wsTaskReader interface {
+	ReadTaskManifest(name string) ([]byte, error)
+	copilotDirGetter
+}
+
+type wsTaskWriter interface {
+	WriteTaskManifest(marshaler encoding.BinaryMarshaler, name string) (string, error)
+	copilotDirGetter
+}
+
 type wsPipelineManifestReader interface {
 	ReadPipelineManifest() ([]byte, error)
 }
@@ -286,6 +302,7 @@ type wsWlDirReader interface {
 type wsPipelineReader interface {
 	wsPipelineManifestReader
 	WorkloadNames() ([]string, error)
+	ReadWorkloadManifest(name string) ([]byte, error)
 }
 
 type wsAppManager interface {
@@ -337,6 +354,7 @@ type jobRemoverFromApp interface {
 
 type imageRemover interface {
 	ClearRepository(repoName string) error // implemented by ECR Service
+	RepositoryNames(prefix string) ([]string, error)
 }
 
 type pipelineDeployer interface {
@@ -358,6 +376,10 @@ type appDeployer interface {
 	DeleteApp(name string) error
 }
 
+type sidecarRepoAdder interface {
+	AddSidecarReposToApp(app *config.Application, wlName string, sidecars []string) error
+}
+
 type appResourcesGetter interface {
 	GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error)
 	GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error)
@@ -365,6 +387,7 @@ type appResourcesGetter interface {
 
 type taskDeployer interface {
 	DeployTask(out termprogress.FileWriter, input *deploy.CreateTaskResourcesInput, opts ...awscloudformation.StackOption) error
+	GetTaskStack(taskName string) (*deploy.TaskStackInfo, error)
 }
 
 type taskStackManager interface {
@@ -372,8 +395,21 @@ type taskStackManager interface {
 	GetTaskStack(taskName string) (*deploy.TaskStackInfo, error)
 }
 
+type taskStackLister interface {
+	ListTaskStacks(appName, envName string) ([]deploy.TaskStackInfo, error)
+	ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error)
+}
+
+type oneOffTaskLister interface {
+	ListActiveAppEnvTasks(opts ecs.ListActiveAppEnvTasksOpts) ([]*awsecs.Task, error)
+	ListActiveDefaultClusterTasks(filter ecs.ListTasksFilter) ([]*awsecs.Task, error)
+	ListStoppedAppEnvTasks(app, env string) ([]*awsecs.Task, error)
+	ListStoppedDefaultClusterTasks() ([]*awsecs.Task, error)
+}
+
 type taskRunner interface {
 	Run() ([]*task.Task, error)
+	RunTaskInput() (*awsecs.RunTaskInput, error)
 }
 
 type defaultClusterGetter interface {
@@ -404,10 +440,18 @@ type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 }
 
+type workloadGraphDescriber interface {
+	Describe() (*describe.WorkloadGraph, error)
+}
+
 type versionGetter interface {
 	Version() (string, error)
 }
 
+type aliasCertValidator interface {
+	ValidateCertAliases(aliases []string, certs []string) error
+}
+
 type endpointGetter interface {
 	ServiceDiscoveryEndpoint() (string, error)
 }
@@ -509,6 +553,9 @@ type credsSelector interface {
 
 type ec2Client interface {
 	HasDNSSupport(vpcID string) (bool, error)
+	HasInternetGateway(vpcID string) (bool, error)
+	VPCCIDR(vpcID string) (string, error)
+	RouteTableIDs(vpcID string, subnetIDs []string) ([]string, error)
 }
 
 type serviceResumer interface {
@@ -593,6 +640,37 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretsManagerSecretPutter interface {
+	PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error)
+}
+
+type secretLister interface {
+	ListSecrets(path string) ([]string, error)
+}
+
+type secretValueGetter interface {
+	SecretValue(name string) (string, error)
+}
+
+type secretUpdater interface {
+	UpdateSecret(name, value string) error
+}
+
+type ssmParameterChecker interface {
+	ParameterExists(name string) (bool, error)
+}
+
+type secretsManagerSecretChecker interface {
+	SecretExists(secretID string) (bool, error)
+}
+
+type secretsClient interface {
+	secretLister
+	secretValueGetter
+	secretUpdater
+	secretDeleter
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
@@ -320,6 +320,16 @@ func (o *deleteJobOpts) emptyECRRepos(envs []*config.Environment) error {
 		if err := client.ClearRepository(repoName); err != nil {
 			return err
 		}
+		// The images of the sidecars are stored in repositories under the repository of the workload.
+		sidecarRepos, err := client.RepositoryNames(repoName + "/")
+		if err != nil {
+			return err
+		}
+		for _, sidecarRepo := range sidecarRepos {
+			if err := client.ClearRepository(sidecarRepo); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
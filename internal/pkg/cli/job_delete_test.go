@@ -340,6 +340,8 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobTasksStopComplete, mockJobName, mockEnvName)),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
+					mocks.ecr.EXPECT().RepositoryNames(mockRepo+"/").Return([]string{mockRepo + "/nginx"}, nil),
+					mocks.ecr.EXPECT().ClearRepository(mockRepo+"/nginx").Return(nil),
 
 					// removeJobFromApp
 					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
@@ -20,7 +20,9 @@ import (
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -45,10 +47,15 @@ type deployJobOpts struct {
 	appCFN             appResourcesGetter
 	jobCFN             cloudformation.CloudFormation
 	imageBuilderPusher imageBuilderPusher
+	containerBuilder   repository.ContainerLoginBuildPusher
+	sidecarRepos       sidecarRepoAdder
+	newSidecarPusher   func(repoName string) (imageBuilderPusher, error)
 	sessProvider       sessionProvider
 	s3                 artifactUploader
 	envUpgradeCmd      actionCommand
 	endpointGetter     endpointGetter
+	paramChecker       ssmParameterChecker
+	secretChecker      secretsManagerSecretChecker
 
 	spinner progress
 	sel     wsSelector
@@ -59,6 +66,7 @@ type deployJobOpts struct {
 	targetJob         *config.Workload
 	imageDigest       string
 	buildRequired     bool
+	sidecarDigests    map[string]string // Digests of the sidecar images built from a Dockerfile.
 }
 
 func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
@@ -143,6 +151,10 @@ func (o *deployJobOpts) Execute() error {
 		return err
 	}
 
+	if err := o.validateSecrets(); err != nil {
+		return err
+	}
+
 	if err := o.envUpgradeCmd.Execute(); err != nil {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
@@ -203,11 +215,16 @@ func (o *deployJobOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.newSidecarPusher = func(repoName string) (imageBuilderPusher, error) {
+		return repository.New(repoName, registry)
+	}
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
 	// CF client against env account profile AND target environment region
 	o.jobCFN = cloudformation.New(envSession)
+	o.paramChecker = ssm.New(envSession)
+	o.secretChecker = secretsmanager.NewWithSession(envSession)
 	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
@@ -228,7 +245,20 @@ func (o *deployJobOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("create default session: %w", err)
 	}
-	o.appCFN = cloudformation.New(defaultSess)
+	appCFN := cloudformation.New(defaultSess)
+	o.appCFN = appCFN
+	o.sidecarRepos = appCFN
+
+	o.containerBuilder, err = newContainerBuilder(containerBuilderConfig{
+		app:           o.targetApp,
+		env:           o.targetEnvironment,
+		envSession:    envSession,
+		bucketSession: defaultSessEnvRegion,
+		appCFN:        o.appCFN,
+	})
+	if err != nil {
+		return fmt.Errorf("initiate container builder: %w", err)
+	}
 
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
 		appName: o.appName,
@@ -241,6 +271,15 @@ func (o *deployJobOpts) configureClients() error {
 	return nil
 }
 
+// validateSecrets returns an error if any secret referenced by the job manifest doesn't exist in the target environment.
+func (o *deployJobOpts) validateSecrets() error {
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	return validateSecretRefs(mft, o.targetEnvironment.Name, o.paramChecker, o.secretChecker)
+}
+
 func (o *deployJobOpts) configureContainerImage() error {
 	job, err := o.manifest()
 	if err != nil {
@@ -250,20 +289,40 @@ func (o *deployJobOpts) configureContainerImage() error {
 	if err != nil {
 		return err
 	}
-	if !required {
-		return nil
-	}
-	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := o.dfBuildArgs(job)
+	if required {
+		// If it is built from local Dockerfile, build and push to the ECR repo.
+		if err := validateImmutableImageTag(o.targetApp, o.imageTag); err != nil {
+			return err
+		}
+		buildArg, err := o.dfBuildArgs(job)
+		if err != nil {
+			return err
+		}
+		if err := validateImmutableBuildCache(o.targetApp, buildArg); err != nil {
+			return err
+		}
+		buildArg.SkipLatestTag = o.targetApp.HasImmutableImageTags()
+		digest, err := o.imageBuilderPusher.BuildAndPush(o.containerBuilder, buildArg)
+		if err != nil {
+			return fmt.Errorf("build and push image: %w", err)
+		}
+		o.imageDigest = digest
+		o.buildRequired = true
+	}
+	digests, err := buildAndPushSidecarImages(sidecarImagesInput{
+		app:                   o.targetApp,
+		wlName:                o.name,
+		imageTag:              o.imageTag,
+		mft:                   job,
+		ws:                    o.ws,
+		repos:                 o.sidecarRepos,
+		newImageBuilderPusher: o.newSidecarPusher,
+		builder:               o.containerBuilder,
+	})
 	if err != nil {
 		return err
 	}
-	digest, err := o.imageBuilderPusher.BuildAndPush(exec.NewDockerCommand(), buildArg)
-	if err != nil {
-		return fmt.Errorf("build and push image: %w", err)
-	}
-	o.imageDigest = digest
-	o.buildRequired = true
+	o.sidecarDigests = digests
 	return nil
 }
 
@@ -314,12 +373,13 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	if err != nil {
 		return nil, err
 	}
-	if !o.buildRequired {
-		return &stack.RuntimeConfig{
-			AddonsTemplateURL:        addonsURL,
-			AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
-			ServiceDiscoveryEndpoint: endpoint,
-		}, nil
+	rc := &stack.RuntimeConfig{
+		AddonsTemplateURL:        addonsURL,
+		AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
+		ServiceDiscoveryEndpoint: endpoint,
+	}
+	if !o.buildRequired && len(o.sidecarDigests) == 0 {
+		return rc, nil
 	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
 	if err != nil {
@@ -333,16 +393,15 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 			appAccountID: o.targetApp.AccountID,
 		}
 	}
-	return &stack.RuntimeConfig{
-		Image: &stack.ECRImage{
+	if o.buildRequired {
+		rc.Image = &stack.ECRImage{
 			RepoURL:  repoURL,
 			ImageTag: o.imageTag,
 			Digest:   o.imageDigest,
-		},
-		AddonsTemplateURL:        addonsURL,
-		AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
-		ServiceDiscoveryEndpoint: endpoint,
-	}, nil
+		}
+	}
+	rc.SidecarImages = sidecarECRImages(repoURL, o.imageTag, o.sidecarDigests)
+	return rc, nil
 }
 
 func (o *deployJobOpts) manifest() (interface{}, error) {
@@ -325,6 +325,7 @@ image:
 				deployWkldVars: deployWkldVars{
 					name: test.inputSvc,
 				},
+				targetApp:          &config.Application{Name: "phonetool"},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
 				ws:                 mockWorkspace,
@@ -106,7 +106,7 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 		init:         jobInitter,
 		prompt:       prompter,
 		sel:          sel,
-		dockerEngine: exec.NewDockerCommand(),
+		dockerEngine: newDockerEngine(),
 		initParser: func(path string) dockerfileParser {
 			return exec.NewDockerfile(fs, path)
 		},
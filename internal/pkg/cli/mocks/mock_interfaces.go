@@ -14,6 +14,7 @@ import (
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -1691,6 +1692,20 @@ func (m *MockeventsWriter) EXPECT() *MockeventsWriterMockRecorder {
 	return m.recorder
 }
 
+// StoppedTasks mocks base method.
+func (m *MockeventsWriter) StoppedTasks() []*ecs.Task {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedTasks")
+	ret0, _ := ret[0].([]*ecs.Task)
+	return ret0
+}
+
+// StoppedTasks indicates an expected call of StoppedTasks.
+func (mr *MockeventsWriterMockRecorder) StoppedTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedTasks", reflect.TypeOf((*MockeventsWriter)(nil).StoppedTasks))
+}
+
 // WriteEventsUntilStopped mocks base method.
 func (m *MockeventsWriter) WriteEventsUntilStopped() error {
 	m.ctrl.T.Helper()
@@ -1705,6 +1720,48 @@ func (mr *MockeventsWriterMockRecorder) WriteEventsUntilStopped() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEventsUntilStopped", reflect.TypeOf((*MockeventsWriter)(nil).WriteEventsUntilStopped))
 }
 
+// MockecsTaskStopper is a mock of ecsTaskStopper interface.
+type MockecsTaskStopper struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsTaskStopperMockRecorder
+}
+
+// MockecsTaskStopperMockRecorder is the mock recorder for MockecsTaskStopper.
+type MockecsTaskStopperMockRecorder struct {
+	mock *MockecsTaskStopper
+}
+
+// NewMockecsTaskStopper creates a new mock instance.
+func NewMockecsTaskStopper(ctrl *gomock.Controller) *MockecsTaskStopper {
+	mock := &MockecsTaskStopper{ctrl: ctrl}
+	mock.recorder = &MockecsTaskStopperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsTaskStopper) EXPECT() *MockecsTaskStopperMockRecorder {
+	return m.recorder
+}
+
+// StopTasks mocks base method.
+func (m *MockecsTaskStopper) StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{tasks}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopTasks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTasks indicates an expected call of StopTasks.
+func (mr *MockecsTaskStopperMockRecorder) StopTasks(tasks interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{tasks}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTasks", reflect.TypeOf((*MockecsTaskStopper)(nil).StopTasks), varargs...)
+}
+
 // MockdefaultSessionProvider is a mock of defaultSessionProvider interface.
 type MockdefaultSessionProvider struct {
 	ctrl     *gomock.Controller
@@ -2182,6 +2239,112 @@ func (mr *MockcopilotDirGetterMockRecorder) CopilotDirPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockcopilotDirGetter)(nil).CopilotDirPath))
 }
 
+// MockwsTaskReader is a mock of wsTaskReader interface.
+type MockwsTaskReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsTaskReaderMockRecorder
+}
+
+// MockwsTaskReaderMockRecorder is the mock recorder for MockwsTaskReader.
+type MockwsTaskReaderMockRecorder struct {
+	mock *MockwsTaskReader
+}
+
+// NewMockwsTaskReader creates a new mock instance.
+func NewMockwsTaskReader(ctrl *gomock.Controller) *MockwsTaskReader {
+	mock := &MockwsTaskReader{ctrl: ctrl}
+	mock.recorder = &MockwsTaskReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsTaskReader) EXPECT() *MockwsTaskReaderMockRecorder {
+	return m.recorder
+}
+
+// CopilotDirPath mocks base method.
+func (m *MockwsTaskReader) CopilotDirPath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopilotDirPath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopilotDirPath indicates an expected call of CopilotDirPath.
+func (mr *MockwsTaskReaderMockRecorder) CopilotDirPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsTaskReader)(nil).CopilotDirPath))
+}
+
+// ReadTaskManifest mocks base method.
+func (m *MockwsTaskReader) ReadTaskManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTaskManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTaskManifest indicates an expected call of ReadTaskManifest.
+func (mr *MockwsTaskReaderMockRecorder) ReadTaskManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTaskManifest", reflect.TypeOf((*MockwsTaskReader)(nil).ReadTaskManifest), name)
+}
+
+// MockwsTaskWriter is a mock of wsTaskWriter interface.
+type MockwsTaskWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsTaskWriterMockRecorder
+}
+
+// MockwsTaskWriterMockRecorder is the mock recorder for MockwsTaskWriter.
+type MockwsTaskWriterMockRecorder struct {
+	mock *MockwsTaskWriter
+}
+
+// NewMockwsTaskWriter creates a new mock instance.
+func NewMockwsTaskWriter(ctrl *gomock.Controller) *MockwsTaskWriter {
+	mock := &MockwsTaskWriter{ctrl: ctrl}
+	mock.recorder = &MockwsTaskWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsTaskWriter) EXPECT() *MockwsTaskWriterMockRecorder {
+	return m.recorder
+}
+
+// CopilotDirPath mocks base method.
+func (m *MockwsTaskWriter) CopilotDirPath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopilotDirPath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopilotDirPath indicates an expected call of CopilotDirPath.
+func (mr *MockwsTaskWriterMockRecorder) CopilotDirPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsTaskWriter)(nil).CopilotDirPath))
+}
+
+// WriteTaskManifest mocks base method.
+func (m *MockwsTaskWriter) WriteTaskManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTaskManifest", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteTaskManifest indicates an expected call of WriteTaskManifest.
+func (mr *MockwsTaskWriterMockRecorder) WriteTaskManifest(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTaskManifest", reflect.TypeOf((*MockwsTaskWriter)(nil).WriteTaskManifest), marshaler, name)
+}
+
 // MockwsPipelineManifestReader is a mock of wsPipelineManifestReader interface.
 type MockwsPipelineManifestReader struct {
 	ctrl     *gomock.Controller
@@ -2810,6 +2973,21 @@ func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
 }
 
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsPipelineReader) ReadWorkloadManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsPipelineReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadWorkloadManifest), name)
+}
+
 // WorkloadNames mocks base method.
 func (m *MockwsPipelineReader) WorkloadNames() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -3329,6 +3507,21 @@ func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
 }
 
+// RepositoryNames mocks base method.
+func (m *MockimageRemover) RepositoryNames(prefix string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepositoryNames", prefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepositoryNames indicates an expected call of RepositoryNames.
+func (mr *MockimageRemoverMockRecorder) RepositoryNames(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryNames", reflect.TypeOf((*MockimageRemover)(nil).RepositoryNames), prefix)
+}
+
 // MockpipelineDeployer is a mock of pipelineDeployer interface.
 type MockpipelineDeployer struct {
 	ctrl     *gomock.Controller
@@ -3560,6 +3753,43 @@ func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
 }
 
+// MocksidecarRepoAdder is a mock of sidecarRepoAdder interface.
+type MocksidecarRepoAdder struct {
+	ctrl     *gomock.Controller
+	recorder *MocksidecarRepoAdderMockRecorder
+}
+
+// MocksidecarRepoAdderMockRecorder is the mock recorder for MocksidecarRepoAdder.
+type MocksidecarRepoAdderMockRecorder struct {
+	mock *MocksidecarRepoAdder
+}
+
+// NewMocksidecarRepoAdder creates a new mock instance.
+func NewMocksidecarRepoAdder(ctrl *gomock.Controller) *MocksidecarRepoAdder {
+	mock := &MocksidecarRepoAdder{ctrl: ctrl}
+	mock.recorder = &MocksidecarRepoAdderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksidecarRepoAdder) EXPECT() *MocksidecarRepoAdderMockRecorder {
+	return m.recorder
+}
+
+// AddSidecarReposToApp mocks base method.
+func (m *MocksidecarRepoAdder) AddSidecarReposToApp(app *config.Application, wlName string, sidecars []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSidecarReposToApp", app, wlName, sidecars)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSidecarReposToApp indicates an expected call of AddSidecarReposToApp.
+func (mr *MocksidecarRepoAdderMockRecorder) AddSidecarReposToApp(app, wlName, sidecars interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSidecarReposToApp", reflect.TypeOf((*MocksidecarRepoAdder)(nil).AddSidecarReposToApp), app, wlName, sidecars)
+}
+
 // MockappResourcesGetter is a mock of appResourcesGetter interface.
 type MockappResourcesGetter struct {
 	ctrl     *gomock.Controller
@@ -3655,6 +3885,21 @@ func (mr *MocktaskDeployerMockRecorder) DeployTask(out, input interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), varargs...)
 }
 
+// GetTaskStack mocks base method.
+func (m *MocktaskDeployer) GetTaskStack(taskName string) (*deploy.TaskStackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskStack", taskName)
+	ret0, _ := ret[0].(*deploy.TaskStackInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskStack indicates an expected call of GetTaskStack.
+func (mr *MocktaskDeployerMockRecorder) GetTaskStack(taskName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStack", reflect.TypeOf((*MocktaskDeployer)(nil).GetTaskStack), taskName)
+}
+
 // MocktaskStackManager is a mock of taskStackManager interface.
 type MocktaskStackManager struct {
 	ctrl     *gomock.Controller
@@ -3707,6 +3952,142 @@ func (mr *MocktaskStackManagerMockRecorder) GetTaskStack(taskName interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStack", reflect.TypeOf((*MocktaskStackManager)(nil).GetTaskStack), taskName)
 }
 
+// MocktaskStackLister is a mock of taskStackLister interface.
+type MocktaskStackLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStackListerMockRecorder
+}
+
+// MocktaskStackListerMockRecorder is the mock recorder for MocktaskStackLister.
+type MocktaskStackListerMockRecorder struct {
+	mock *MocktaskStackLister
+}
+
+// NewMocktaskStackLister creates a new mock instance.
+func NewMocktaskStackLister(ctrl *gomock.Controller) *MocktaskStackLister {
+	mock := &MocktaskStackLister{ctrl: ctrl}
+	mock.recorder = &MocktaskStackListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskStackLister) EXPECT() *MocktaskStackListerMockRecorder {
+	return m.recorder
+}
+
+// ListDefaultTaskStacks mocks base method.
+func (m *MocktaskStackLister) ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDefaultTaskStacks")
+	ret0, _ := ret[0].([]deploy.TaskStackInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDefaultTaskStacks indicates an expected call of ListDefaultTaskStacks.
+func (mr *MocktaskStackListerMockRecorder) ListDefaultTaskStacks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDefaultTaskStacks", reflect.TypeOf((*MocktaskStackLister)(nil).ListDefaultTaskStacks))
+}
+
+// ListTaskStacks mocks base method.
+func (m *MocktaskStackLister) ListTaskStacks(appName, envName string) ([]deploy.TaskStackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskStacks", appName, envName)
+	ret0, _ := ret[0].([]deploy.TaskStackInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskStacks indicates an expected call of ListTaskStacks.
+func (mr *MocktaskStackListerMockRecorder) ListTaskStacks(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskStacks", reflect.TypeOf((*MocktaskStackLister)(nil).ListTaskStacks), appName, envName)
+}
+
+// MockoneOffTaskLister is a mock of oneOffTaskLister interface.
+type MockoneOffTaskLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockoneOffTaskListerMockRecorder
+}
+
+// MockoneOffTaskListerMockRecorder is the mock recorder for MockoneOffTaskLister.
+type MockoneOffTaskListerMockRecorder struct {
+	mock *MockoneOffTaskLister
+}
+
+// NewMockoneOffTaskLister creates a new mock instance.
+func NewMockoneOffTaskLister(ctrl *gomock.Controller) *MockoneOffTaskLister {
+	mock := &MockoneOffTaskLister{ctrl: ctrl}
+	mock.recorder = &MockoneOffTaskListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoneOffTaskLister) EXPECT() *MockoneOffTaskListerMockRecorder {
+	return m.recorder
+}
+
+// ListActiveAppEnvTasks mocks base method.
+func (m *MockoneOffTaskLister) ListActiveAppEnvTasks(opts ecs0.ListActiveAppEnvTasksOpts) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveAppEnvTasks", opts)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveAppEnvTasks indicates an expected call of ListActiveAppEnvTasks.
+func (mr *MockoneOffTaskListerMockRecorder) ListActiveAppEnvTasks(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveAppEnvTasks", reflect.TypeOf((*MockoneOffTaskLister)(nil).ListActiveAppEnvTasks), opts)
+}
+
+// ListActiveDefaultClusterTasks mocks base method.
+func (m *MockoneOffTaskLister) ListActiveDefaultClusterTasks(filter ecs0.ListTasksFilter) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveDefaultClusterTasks", filter)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveDefaultClusterTasks indicates an expected call of ListActiveDefaultClusterTasks.
+func (mr *MockoneOffTaskListerMockRecorder) ListActiveDefaultClusterTasks(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveDefaultClusterTasks", reflect.TypeOf((*MockoneOffTaskLister)(nil).ListActiveDefaultClusterTasks), filter)
+}
+
+// ListStoppedAppEnvTasks mocks base method.
+func (m *MockoneOffTaskLister) ListStoppedAppEnvTasks(app, env string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoppedAppEnvTasks", app, env)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoppedAppEnvTasks indicates an expected call of ListStoppedAppEnvTasks.
+func (mr *MockoneOffTaskListerMockRecorder) ListStoppedAppEnvTasks(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoppedAppEnvTasks", reflect.TypeOf((*MockoneOffTaskLister)(nil).ListStoppedAppEnvTasks), app, env)
+}
+
+// ListStoppedDefaultClusterTasks mocks base method.
+func (m *MockoneOffTaskLister) ListStoppedDefaultClusterTasks() ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoppedDefaultClusterTasks")
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoppedDefaultClusterTasks indicates an expected call of ListStoppedDefaultClusterTasks.
+func (mr *MockoneOffTaskListerMockRecorder) ListStoppedDefaultClusterTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoppedDefaultClusterTasks", reflect.TypeOf((*MockoneOffTaskLister)(nil).ListStoppedDefaultClusterTasks))
+}
+
 // MocktaskRunner is a mock of taskRunner interface.
 type MocktaskRunner struct {
 	ctrl     *gomock.Controller
@@ -3745,6 +4126,21 @@ func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
 }
 
+// RunTaskInput mocks base method.
+func (m *MocktaskRunner) RunTaskInput() (*ecs.RunTaskInput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTaskInput")
+	ret0, _ := ret[0].(*ecs.RunTaskInput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunTaskInput indicates an expected call of RunTaskInput.
+func (mr *MocktaskRunnerMockRecorder) RunTaskInput() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTaskInput", reflect.TypeOf((*MocktaskRunner)(nil).RunTaskInput))
+}
+
 // MockdefaultClusterGetter is a mock of defaultClusterGetter interface.
 type MockdefaultClusterGetter struct {
 	ctrl     *gomock.Controller
@@ -4245,6 +4641,44 @@ func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
 }
 
+// MockworkloadGraphDescriber is a mock of workloadGraphDescriber interface.
+type MockworkloadGraphDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadGraphDescriberMockRecorder
+}
+
+// MockworkloadGraphDescriberMockRecorder is the mock recorder for MockworkloadGraphDescriber.
+type MockworkloadGraphDescriberMockRecorder struct {
+	mock *MockworkloadGraphDescriber
+}
+
+// NewMockworkloadGraphDescriber creates a new mock instance.
+func NewMockworkloadGraphDescriber(ctrl *gomock.Controller) *MockworkloadGraphDescriber {
+	mock := &MockworkloadGraphDescriber{ctrl: ctrl}
+	mock.recorder = &MockworkloadGraphDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadGraphDescriber) EXPECT() *MockworkloadGraphDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockworkloadGraphDescriber) Describe() (*describe.WorkloadGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.WorkloadGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockworkloadGraphDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockworkloadGraphDescriber)(nil).Describe))
+}
+
 // MockversionGetter is a mock of versionGetter interface.
 type MockversionGetter struct {
 	ctrl     *gomock.Controller
@@ -4283,6 +4717,43 @@ func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
 }
 
+// MockaliasCertValidator is a mock of aliasCertValidator interface.
+type MockaliasCertValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockaliasCertValidatorMockRecorder
+}
+
+// MockaliasCertValidatorMockRecorder is the mock recorder for MockaliasCertValidator.
+type MockaliasCertValidatorMockRecorder struct {
+	mock *MockaliasCertValidator
+}
+
+// NewMockaliasCertValidator creates a new mock instance.
+func NewMockaliasCertValidator(ctrl *gomock.Controller) *MockaliasCertValidator {
+	mock := &MockaliasCertValidator{ctrl: ctrl}
+	mock.recorder = &MockaliasCertValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaliasCertValidator) EXPECT() *MockaliasCertValidatorMockRecorder {
+	return m.recorder
+}
+
+// ValidateCertAliases mocks base method.
+func (m *MockaliasCertValidator) ValidateCertAliases(aliases, certs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCertAliases", aliases, certs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCertAliases indicates an expected call of ValidateCertAliases.
+func (mr *MockaliasCertValidatorMockRecorder) ValidateCertAliases(aliases, certs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCertAliases", reflect.TypeOf((*MockaliasCertValidator)(nil).ValidateCertAliases), aliases, certs)
+}
+
 // MockendpointGetter is a mock of endpointGetter interface.
 type MockendpointGetter struct {
 	ctrl     *gomock.Controller
@@ -5440,6 +5911,51 @@ func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
 }
 
+// HasInternetGateway mocks base method.
+func (m *Mockec2Client) HasInternetGateway(vpcID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasInternetGateway", vpcID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasInternetGateway indicates an expected call of HasInternetGateway.
+func (mr *Mockec2ClientMockRecorder) HasInternetGateway(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasInternetGateway", reflect.TypeOf((*Mockec2Client)(nil).HasInternetGateway), vpcID)
+}
+
+// RouteTableIDs mocks base method.
+func (m *Mockec2Client) RouteTableIDs(vpcID string, subnetIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RouteTableIDs", vpcID, subnetIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RouteTableIDs indicates an expected call of RouteTableIDs.
+func (mr *Mockec2ClientMockRecorder) RouteTableIDs(vpcID, subnetIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouteTableIDs", reflect.TypeOf((*Mockec2Client)(nil).RouteTableIDs), vpcID, subnetIDs)
+}
+
+// VPCCIDR mocks base method.
+func (m *Mockec2Client) VPCCIDR(vpcID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCCIDR", vpcID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCCIDR indicates an expected call of VPCCIDR.
+func (mr *Mockec2ClientMockRecorder) VPCCIDR(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCCIDR", reflect.TypeOf((*Mockec2Client)(nil).VPCCIDR), vpcID)
+}
+
 // MockserviceResumer is a mock of serviceResumer interface.
 type MockserviceResumer struct {
 	ctrl     *gomock.Controller
@@ -6245,6 +6761,314 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretsManagerSecretPutter is a mock of secretsManagerSecretPutter interface.
+type MocksecretsManagerSecretPutter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretPutterMockRecorder
+}
+
+// MocksecretsManagerSecretPutterMockRecorder is the mock recorder for MocksecretsManagerSecretPutter.
+type MocksecretsManagerSecretPutterMockRecorder struct {
+	mock *MocksecretsManagerSecretPutter
+}
+
+// NewMocksecretsManagerSecretPutter creates a new mock instance.
+func NewMocksecretsManagerSecretPutter(ctrl *gomock.Controller) *MocksecretsManagerSecretPutter {
+	mock := &MocksecretsManagerSecretPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretPutterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretPutter) EXPECT() *MocksecretsManagerSecretPutterMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method.
+func (m *MocksecretsManagerSecretPutter) PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*secretsmanager.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretsManagerSecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretsManagerSecretPutter)(nil).PutSecret), in)
+}
+
+// MocksecretLister is a mock of secretLister interface.
+type MocksecretLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretListerMockRecorder
+}
+
+// MocksecretListerMockRecorder is the mock recorder for MocksecretLister.
+type MocksecretListerMockRecorder struct {
+	mock *MocksecretLister
+}
+
+// NewMocksecretLister creates a new mock instance.
+func NewMocksecretLister(ctrl *gomock.Controller) *MocksecretLister {
+	mock := &MocksecretLister{ctrl: ctrl}
+	mock.recorder = &MocksecretListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretLister) EXPECT() *MocksecretListerMockRecorder {
+	return m.recorder
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretLister) ListSecrets(path string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", path)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretListerMockRecorder) ListSecrets(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretLister)(nil).ListSecrets), path)
+}
+
+// MocksecretValueGetter is a mock of secretValueGetter interface.
+type MocksecretValueGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretValueGetterMockRecorder
+}
+
+// MocksecretValueGetterMockRecorder is the mock recorder for MocksecretValueGetter.
+type MocksecretValueGetterMockRecorder struct {
+	mock *MocksecretValueGetter
+}
+
+// NewMocksecretValueGetter creates a new mock instance.
+func NewMocksecretValueGetter(ctrl *gomock.Controller) *MocksecretValueGetter {
+	mock := &MocksecretValueGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretValueGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretValueGetter) EXPECT() *MocksecretValueGetterMockRecorder {
+	return m.recorder
+}
+
+// SecretValue mocks base method.
+func (m *MocksecretValueGetter) SecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretValue indicates an expected call of SecretValue.
+func (mr *MocksecretValueGetterMockRecorder) SecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretValue", reflect.TypeOf((*MocksecretValueGetter)(nil).SecretValue), name)
+}
+
+// MocksecretUpdater is a mock of secretUpdater interface.
+type MocksecretUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretUpdaterMockRecorder
+}
+
+// MocksecretUpdaterMockRecorder is the mock recorder for MocksecretUpdater.
+type MocksecretUpdaterMockRecorder struct {
+	mock *MocksecretUpdater
+}
+
+// NewMocksecretUpdater creates a new mock instance.
+func NewMocksecretUpdater(ctrl *gomock.Controller) *MocksecretUpdater {
+	mock := &MocksecretUpdater{ctrl: ctrl}
+	mock.recorder = &MocksecretUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretUpdater) EXPECT() *MocksecretUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateSecret mocks base method.
+func (m *MocksecretUpdater) UpdateSecret(name, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecret", name, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecret indicates an expected call of UpdateSecret.
+func (mr *MocksecretUpdaterMockRecorder) UpdateSecret(name, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MocksecretUpdater)(nil).UpdateSecret), name, value)
+}
+
+// MockssmParameterChecker is a mock of ssmParameterChecker interface.
+type MockssmParameterChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmParameterCheckerMockRecorder
+}
+
+// MockssmParameterCheckerMockRecorder is the mock recorder for MockssmParameterChecker.
+type MockssmParameterCheckerMockRecorder struct {
+	mock *MockssmParameterChecker
+}
+
+// NewMockssmParameterChecker creates a new mock instance.
+func NewMockssmParameterChecker(ctrl *gomock.Controller) *MockssmParameterChecker {
+	mock := &MockssmParameterChecker{ctrl: ctrl}
+	mock.recorder = &MockssmParameterCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmParameterChecker) EXPECT() *MockssmParameterCheckerMockRecorder {
+	return m.recorder
+}
+
+// ParameterExists mocks base method.
+func (m *MockssmParameterChecker) ParameterExists(name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParameterExists", name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParameterExists indicates an expected call of ParameterExists.
+func (mr *MockssmParameterCheckerMockRecorder) ParameterExists(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParameterExists", reflect.TypeOf((*MockssmParameterChecker)(nil).ParameterExists), name)
+}
+
+// MocksecretsManagerSecretChecker is a mock of secretsManagerSecretChecker interface.
+type MocksecretsManagerSecretChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretCheckerMockRecorder
+}
+
+// MocksecretsManagerSecretCheckerMockRecorder is the mock recorder for MocksecretsManagerSecretChecker.
+type MocksecretsManagerSecretCheckerMockRecorder struct {
+	mock *MocksecretsManagerSecretChecker
+}
+
+// NewMocksecretsManagerSecretChecker creates a new mock instance.
+func NewMocksecretsManagerSecretChecker(ctrl *gomock.Controller) *MocksecretsManagerSecretChecker {
+	mock := &MocksecretsManagerSecretChecker{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretChecker) EXPECT() *MocksecretsManagerSecretCheckerMockRecorder {
+	return m.recorder
+}
+
+// SecretExists mocks base method.
+func (m *MocksecretsManagerSecretChecker) SecretExists(secretID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretExists", secretID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretExists indicates an expected call of SecretExists.
+func (mr *MocksecretsManagerSecretCheckerMockRecorder) SecretExists(secretID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretExists", reflect.TypeOf((*MocksecretsManagerSecretChecker)(nil).SecretExists), secretID)
+}
+
+// MocksecretsClient is a mock of secretsClient interface.
+type MocksecretsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsClientMockRecorder
+}
+
+// MocksecretsClientMockRecorder is the mock recorder for MocksecretsClient.
+type MocksecretsClientMockRecorder struct {
+	mock *MocksecretsClient
+}
+
+// NewMocksecretsClient creates a new mock instance.
+func NewMocksecretsClient(ctrl *gomock.Controller) *MocksecretsClient {
+	mock := &MocksecretsClient{ctrl: ctrl}
+	mock.recorder = &MocksecretsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsClient) EXPECT() *MocksecretsClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MocksecretsClient) DeleteSecret(secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MocksecretsClientMockRecorder) DeleteSecret(secretName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretsClient)(nil).DeleteSecret), secretName)
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretsClient) ListSecrets(path string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", path)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretsClientMockRecorder) ListSecrets(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretsClient)(nil).ListSecrets), path)
+}
+
+// SecretValue mocks base method.
+func (m *MocksecretsClient) SecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretValue indicates an expected call of SecretValue.
+func (mr *MocksecretsClientMockRecorder) SecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretValue", reflect.TypeOf((*MocksecretsClient)(nil).SecretValue), name)
+}
+
+// UpdateSecret mocks base method.
+func (m *MocksecretsClient) UpdateSecret(name, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecret", name, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecret indicates an expected call of UpdateSecret.
+func (mr *MocksecretsClientMockRecorder) UpdateSecret(name, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MocksecretsClient)(nil).UpdateSecret), name, value)
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
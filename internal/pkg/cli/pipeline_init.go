@@ -103,9 +103,10 @@ type initPipelineOpts struct {
 }
 
 type artifactBucket struct {
-	BucketName   string
-	Region       string
-	Environments []string
+	BucketName     string
+	Region         string
+	Environments   []string
+	RepositoryURLs map[string]string // The image repository URLs in the region by workload name.
 }
 
 func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
@@ -644,9 +645,10 @@ func (o *initPipelineOpts) artifactBuckets() ([]artifactBucket, error) {
 			}
 		}
 		bucket := artifactBucket{
-			BucketName:   resource.S3Bucket,
-			Region:       resource.Region,
-			Environments: envNames,
+			BucketName:     resource.S3Bucket,
+			Region:         resource.Region,
+			Environments:   envNames,
+			RepositoryURLs: resource.RepositoryURLs,
 		}
 		buckets = append(buckets, bucket)
 	}
@@ -1019,6 +1019,67 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestInitPipelineOpts_artifactBuckets(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStore.EXPECT().GetApplication("badgoose").Return(&config.Application{Name: "badgoose"}, nil)
+	mockRegionalResourcesGetter := mocks.NewMockappResourcesGetter(ctrl)
+	mockRegionalResourcesGetter.EXPECT().GetRegionalAppResources(&config.Application{Name: "badgoose"}).Return([]*stack.AppRegionalResources{
+		{
+			Region:   "us-west-2",
+			S3Bucket: "gooseBucket",
+			RepositoryURLs: map[string]string{
+				"api": "123456789012.dkr.ecr.us-west-2.amazonaws.com/badgoose/api",
+			},
+		},
+		{
+			Region:   "eu-west-1",
+			S3Bucket: "ganderBucket",
+			RepositoryURLs: map[string]string{
+				"api": "123456789012.dkr.ecr.eu-west-1.amazonaws.com/badgoose/api",
+			},
+		},
+	}, nil)
+	opts := &initPipelineOpts{
+		initPipelineVars: initPipelineVars{
+			appName: "badgoose",
+		},
+		store:     mockStore,
+		cfnClient: mockRegionalResourcesGetter,
+		envConfigs: []*config.Environment{
+			{Name: "test", Region: "us-west-2"},
+			{Name: "staging", Region: "us-west-2"},
+			{Name: "prod", Region: "eu-west-1"},
+		},
+	}
+
+	// WHEN
+	buckets, err := opts.artifactBuckets()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []artifactBucket{
+		{
+			BucketName:   "gooseBucket",
+			Region:       "us-west-2",
+			Environments: []string{"test", "staging"},
+			RepositoryURLs: map[string]string{
+				"api": "123456789012.dkr.ecr.us-west-2.amazonaws.com/badgoose/api",
+			},
+		},
+		{
+			BucketName:   "ganderBucket",
+			Region:       "eu-west-1",
+			Environments: []string{"prod"},
+			RepositoryURLs: map[string]string{
+				"api": "123456789012.dkr.ecr.eu-west-1.amazonaws.com/badgoose/api",
+			},
+		},
+	}, buckets)
+}
+
 func TestInitPipelineOpts_pipelineName(t *testing.T) {
 	testCases := map[string]struct {
 		inRepoName string
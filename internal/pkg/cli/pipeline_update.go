@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
@@ -59,6 +60,7 @@ type updatePipelineOpts struct {
 	envStore         environmentStore
 	ws               wsPipelineReader
 	codestar         codestar
+	sidecarRepos     sidecarRepoAdder
 
 	pipelineName                 string
 	shouldPromptUpdateConnection bool
@@ -85,9 +87,10 @@ func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error)
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
 
+	deployer := deploycfn.New(defaultSession)
 	return &updatePipelineOpts{
 		app:                app,
-		pipelineDeployer:   deploycfn.New(defaultSession),
+		pipelineDeployer:   deployer,
 		region:             aws.StringValue(defaultSession.Config.Region),
 		updatePipelineVars: vars,
 		envStore:           store,
@@ -95,6 +98,7 @@ func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error)
 		prog:               termprogress.NewSpinner(log.DiagnosticWriter),
 		prompt:             prompt.New(),
 		codestar:           cs.New(defaultSession),
+		sidecarRepos:       deployer,
 	}, nil
 }
 
@@ -150,6 +154,13 @@ func (o *updatePipelineOpts) Execute() error {
 		return fmt.Errorf("convert environments to deployment stage: %w", err)
 	}
 
+	// create the ECR repositories that the buildspec pushes the sidecar images to
+	if len(stages) > 0 {
+		if err := o.addSidecarRepos(stages[0].LocalWorkloads); err != nil {
+			return err
+		}
+	}
+
 	// get cross-regional resources
 	artifactBuckets, err := o.getArtifactBuckets()
 	if err != nil {
@@ -202,6 +213,37 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 	return stages, nil
 }
 
+// addSidecarRepos adds the "<app>/<workload>/<sidecar>" ECR repositories of the sidecars that
+// the pipeline builds from a Dockerfile to the application.
+func (o *updatePipelineOpts) addSidecarRepos(workloads []string) error {
+	for _, name := range workloads {
+		raw, err := o.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			return fmt.Errorf("read manifest of workload %s: %w", name, err)
+		}
+		mft, err := manifest.UnmarshalWorkload(raw)
+		if err != nil {
+			return fmt.Errorf("unmarshal manifest of workload %s: %w", name, err)
+		}
+		builds, err := manifest.SidecarBuilds(mft)
+		if err != nil {
+			return err
+		}
+		if len(builds) == 0 {
+			continue
+		}
+		var sidecars []string
+		for sidecar := range builds {
+			sidecars = append(sidecars, sidecar)
+		}
+		sort.Strings(sidecars)
+		if err := o.sidecarRepos.AddSidecarReposToApp(o.app, name, sidecars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *updatePipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, error) {
 	regionalResources, err := o.pipelineDeployer.GetRegionalAppResources(o.app)
 	if err != nil {
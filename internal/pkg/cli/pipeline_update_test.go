@@ -24,6 +24,8 @@ type updatePipelineMocks struct {
 	prog     *mocks.Mockprogress
 	deployer *mocks.MockpipelineDeployer
 	ws       *mocks.MockwsPipelineReader
+
+	sidecarRepos *mocks.MocksidecarRepoAdder
 }
 
 func TestUpdatePipelineOpts_convertStages(t *testing.T) {
@@ -261,6 +263,30 @@ stages:
       name: wings
       test_commands:
         - echo "bok bok bok"
+`
+		frontendManifest = `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+`
+		backendManifest = `
+name: backend
+type: Backend Service
+image:
+  build: backend/Dockerfile
+sidecars:
+  nginx:
+    image:
+      build: nginx/Dockerfile
+    port: 80
+  envoy:
+    image: public.ecr.aws/appmesh/aws-appmesh-envoy:v1.19.1.0-prod
+  xray:
+    image:
+      build:
+        dockerfile: xray/Dockerfile
 `
 	)
 
@@ -315,6 +341,11 @@ stages:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -345,6 +376,11 @@ stages:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -376,6 +412,11 @@ stages:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -404,6 +445,11 @@ stages:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -518,6 +564,54 @@ source:
 			},
 			expectedError: fmt.Errorf("convert environments to deployment stage: get workload names from workspace: some error"),
 		},
+		"returns an error if fails to add the repositories of the sidecars": {
+			inApp:     &app,
+			inRegion:  region,
+			inAppName: appName,
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+
+					// convertStages
+					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(errors.New("some error")),
+				)
+			},
+			expectedError: fmt.Errorf("some error"),
+		},
+		"returns an error if fails to read the manifest of a workload": {
+			inApp:     &app,
+			inRegion:  region,
+			inAppName: appName,
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+
+					// convertStages
+					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(nil, errors.New("some error")),
+				)
+			},
+			expectedError: fmt.Errorf("read manifest of workload frontend: some error"),
+		},
 		"returns an error if fails to get cross-regional resources": {
 			inApp:     &app,
 			inRegion:  region,
@@ -535,6 +629,11 @@ source:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, errors.New("some error")),
 				)
@@ -558,6 +657,11 @@ source:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -584,6 +688,11 @@ source:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -614,6 +723,11 @@ source:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -671,6 +785,11 @@ stages:
 					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
 
+					// addSidecarRepos
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("backend").Return([]byte(backendManifest), nil),
+					m.sidecarRepos.EXPECT().AddSidecarReposToApp(&app, "backend", []string{"nginx", "xray"}).Return(nil),
+
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
 
@@ -697,6 +816,7 @@ stages:
 			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
 			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockSidecarRepos := mocks.NewMocksidecarRepoAdder(ctrl)
 
 			mocks := updatePipelineMocks{
 				envStore: mockEnvStore,
@@ -704,6 +824,8 @@ stages:
 				prog:     mockProgress,
 				deployer: mockPipelineDeployer,
 				ws:       mockWorkspace,
+
+				sidecarRepos: mockSidecarRepos,
 			}
 
 			tc.callMocks(mocks)
@@ -719,6 +841,7 @@ stages:
 				envStore:         mockEnvStore,
 				prog:             mockProgress,
 				prompt:           mockPrompt,
+				sidecarRepos:     mockSidecarRepos,
 
 				pipelineName: tc.inPipelineName,
 			}
@@ -4,11 +4,31 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/spf13/cobra"
 )
 
+const (
+	secretBackendSSM            = "ssm"
+	secretBackendSecretsManager = "secretsmanager"
+
+	fmtSecretPath = "/copilot/%s/%s/secrets/"
+)
+
+var secretBackends = []string{secretBackendSSM, secretBackendSecretsManager}
+
 // BuildSecretCmd is the top level command for secret.
 func BuildSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,6 +38,10 @@ Secrets are sensitive information that you need in your application.`,
 	}
 
 	cmd.AddCommand(buildSecretInitCmd())
+	cmd.AddCommand(buildSecretListCmd())
+	cmd.AddCommand(buildSecretShowCmd())
+	cmd.AddCommand(buildSecretRotateCmd())
+	cmd.AddCommand(buildSecretDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -25,3 +49,91 @@ Secrets are sensitive information that you need in your application.`,
 	}
 	return cmd
 }
+
+func validateSecretBackend(backend string) error {
+	for _, b := range secretBackends {
+		if backend == b {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid backend %s: must be one of %s", backend, strings.Join(secretBackends, ", "))
+}
+
+// newSecretsClientForEnv returns a client to the secrets backend of an environment using the environment manager role.
+func newSecretsClientForEnv(backend string, env *config.Environment) (secretsClient, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	if backend == secretBackendSecretsManager {
+		return secretsmanager.NewWithSession(sess), nil
+	}
+	return ssm.New(sess), nil
+}
+
+// isSecretNotFound returns true if the error is due to a secret that does not exist in any backend.
+func isSecretNotFound(err error) bool {
+	var errParamNotFound *ssm.ErrParameterNotFound
+	var errSecretNotFound *secretsmanager.ErrSecretNotFound
+	return errors.As(err, &errParamNotFound) || errors.As(err, &errSecretNotFound)
+}
+
+type errMissingSecrets struct {
+	envName string
+	refs    []manifest.SecretRef
+}
+
+func (e *errMissingSecrets) Error() string {
+	lines := []string{fmt.Sprintf("the following secrets referenced in the manifest do not exist in environment %s:", e.envName)}
+	for _, ref := range e.refs {
+		lines = append(lines, fmt.Sprintf("  %s: %s", ref.Path, ref.ValueFrom))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// validateSecretRefs returns an error listing every secret referenced by the manifest that doesn't exist in the environment.
+// Secrets that can't be verified, for example because the environment manager role isn't allowed to read them, are only logged.
+func validateSecretRefs(mft interface{}, envName string, params ssmParameterChecker, secrets secretsManagerSecretChecker) error {
+	var missing []manifest.SecretRef
+	for _, ref := range manifest.SecretRefs(mft) {
+		exists, err := secretRefExists(ref.ValueFrom, params, secrets)
+		if err != nil {
+			log.Warningf("Couldn't verify that %s referenced by %s exists: %v\n", ref.ValueFrom, ref.Path, err)
+			continue
+		}
+		if !exists {
+			missing = append(missing, ref)
+		}
+	}
+	if len(missing) != 0 {
+		return &errMissingSecrets{
+			envName: envName,
+			refs:    missing,
+		}
+	}
+	return nil
+}
+
+// secretRefExists checks a secret referenced the same way as the "valueFrom" field of an ECS container definition:
+// the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret with an optional JSON key, version stage and version ID.
+func secretRefExists(valueFrom string, params ssmParameterChecker, secrets secretsManagerSecretChecker) (bool, error) {
+	if !arn.IsARN(valueFrom) {
+		return params.ParameterExists(valueFrom)
+	}
+	parsed, err := arn.Parse(valueFrom)
+	if err != nil {
+		return false, fmt.Errorf("parse ARN %s: %w", valueFrom, err)
+	}
+	switch parsed.Service {
+	case "ssm":
+		return params.ParameterExists(valueFrom)
+	case "secretsmanager":
+		// Drop the json-key, version-stage and version-id suffixes, the resource is "secret:<name>".
+		if parts := strings.Split(parsed.Resource, ":"); len(parts) > 2 {
+			parsed.Resource = strings.Join(parts[:2], ":")
+		}
+		return secrets.SecretExists(parsed.String())
+	default:
+		return false, fmt.Errorf("unsupported service %s", parsed.Service)
+	}
+}
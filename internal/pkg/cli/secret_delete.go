@@ -0,0 +1,227 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretDeleteAppNamePrompt     = "Which application is the secret in?"
+	secretDeleteAppNamePromptHelp = "An application groups the secrets of its environments."
+	secretDeleteNamePrompt        = "Which secret would you like to delete?"
+	secretDeleteNamePromptHelp    = "The name of the secret, such as 'db_password'."
+
+	fmtSecretDeleteConfirmPrompt     = "Are you sure you want to delete secret %s from %s?"
+	fmtSecretDeleteConfirmPromptHelp = "Services and jobs that use the secret will fail to start new tasks."
+)
+
+var (
+	errSecretDeleteCancelled = errors.New("secret delete cancelled - no changes made")
+)
+
+type deleteSecretVars struct {
+	appName          string
+	name             string
+	envNames         []string
+	backend          string
+	skipConfirmation bool
+}
+
+type deleteSecretOpts struct {
+	deleteSecretVars
+
+	store  store
+	prompt prompter
+	sel    appSelector
+
+	newSecretDeleter func(env *config.Environment) (secretDeleter, error)
+}
+
+func newDeleteSecretOpts(vars deleteSecretVars) (*deleteSecretOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &deleteSecretOpts{
+		deleteSecretVars: vars,
+		store:            store,
+		prompt:           prompter,
+		sel:              selector.NewSelect(prompter, store),
+	}
+	opts.newSecretDeleter = func(env *config.Environment) (secretDeleter, error) {
+		return newSecretsClientForEnv(opts.backend, env)
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *deleteSecretOpts) Validate() error {
+	if o.backend != "" {
+		if err := validateSecretBackend(o.backend); err != nil {
+			return err
+		}
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if o.name != "" {
+		if err := validateSecretName(o.name); err != nil {
+			return err
+		}
+	}
+	for _, env := range o.envNames {
+		if _, err := o.store.GetEnvironment(o.appName, env); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", env, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided,
+// and for a confirmation before deleting the secret.
+func (o *deleteSecretOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askSecretName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	target := "all environments"
+	if len(o.envNames) != 0 {
+		target = fmt.Sprintf("%s %s", english.PluralWord(len(o.envNames), "environment", ""), english.WordSeries(o.envNames, "and"))
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSecretDeleteConfirmPrompt, color.HighlightUserInput(o.name), target), fmtSecretDeleteConfirmPromptHelp)
+	if err != nil {
+		return fmt.Errorf("confirm to delete secret %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errSecretDeleteCancelled
+	}
+	return nil
+}
+
+// Execute deletes the secret from the environments.
+// Environments that don't have the secret are skipped.
+func (o *deleteSecretOpts) Execute() error {
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, env := range envs {
+		deleter, err := o.newSecretDeleter(env)
+		if err != nil {
+			return err
+		}
+		err = deleter.DeleteSecret(fmt.Sprintf(fmtSecretParameterName, o.appName, env.Name, o.name))
+		switch {
+		case err == nil:
+			log.Successf("Deleted secret %s from environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+		case isSecretNotFound(err):
+			log.Infof("Secret %s does not exist in environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+		default:
+			log.Errorf("Failed to delete secret %s from environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+			errs = append(errs, fmt.Sprintf("delete secret %s from environment %s: %v", o.name, env.Name, err))
+		}
+	}
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func (o *deleteSecretOpts) targetEnvs() ([]*config.Environment, error) {
+	if len(o.envNames) == 0 {
+		envs, err := o.store.ListEnvironments(o.appName)
+		if err != nil {
+			return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+		}
+		return envs, nil
+	}
+	envs := make([]*config.Environment, len(o.envNames))
+	for i, name := range o.envNames {
+		env, err := o.store.GetEnvironment(o.appName, name)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", name, o.appName, err)
+		}
+		envs[i] = env
+	}
+	return envs, nil
+}
+
+func (o *deleteSecretOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretDeleteAppNamePrompt, secretDeleteAppNamePromptHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *deleteSecretOpts) askSecretName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(secretDeleteNamePrompt, secretDeleteNamePromptHelp, validateSecretName, prompt.WithFinalMessage("secret name: "))
+	if err != nil {
+		return fmt.Errorf("ask for the secret name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// buildSecretDeleteCmd builds the command for deleting a secret from environments.
+func buildSecretDeleteCmd() *cobra.Command {
+	vars := deleteSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a secret from environments.",
+		Example: `
+  Delete the secret db-password from all the environments of the application.
+  /code $ copilot secret delete --name db-password
+  Delete the secret db-password from the test environment without a confirmation prompt.
+  /code $ copilot secret delete --name db-password --env test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeleteSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.envNames, envFlag, envFlagShort, nil, secretDeleteEnvsFlagDescription)
+	cmd.Flags().StringVar(&vars.backend, secretBackendFlag, secretBackendSSM, secretBackendFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvNames []string
+		confirmed  bool
+
+		wantedPrompt string
+		wantedError  error
+	}{
+		"confirms deletion from all environments": {
+			confirmed:    true,
+			wantedPrompt: fmt.Sprintf(fmtSecretDeleteConfirmPrompt, color.HighlightUserInput("db-password"), "all environments"),
+		},
+		"confirms deletion from specific environments": {
+			inEnvNames:   []string{"test", "prod"},
+			confirmed:    true,
+			wantedPrompt: fmt.Sprintf(fmtSecretDeleteConfirmPrompt, color.HighlightUserInput("db-password"), "environments test and prod"),
+		},
+		"cancelled if the user does not confirm": {
+			wantedPrompt: fmt.Sprintf(fmtSecretDeleteConfirmPrompt, color.HighlightUserInput("db-password"), "all environments"),
+			wantedError:  errSecretDeleteCancelled,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			mockPrompter.EXPECT().Confirm(tc.wantedPrompt, fmtSecretDeleteConfirmPromptHelp).Return(tc.confirmed, nil)
+			opts := &deleteSecretOpts{
+				deleteSecretVars: deleteSecretVars{
+					appName:  "phonetool",
+					name:     "db-password",
+					envNames: tc.inEnvNames,
+				},
+				prompt: mockPrompter,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type secretDeleteMocks struct {
+	store   *mocks.Mockstore
+	deleter *mocks.MocksecretDeleter
+}
+
+func TestDeleteSecretOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvNames []string
+		setupMocks func(m secretDeleteMocks)
+
+		wantedError error
+	}{
+		"deletes the secret from all environments and skips environments without the secret": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+				m.deleter.EXPECT().DeleteSecret("/copilot/phonetool/test/secrets/db-password").Return(nil)
+				m.deleter.EXPECT().DeleteSecret("/copilot/phonetool/prod/secrets/db-password").Return(&ssm.ErrParameterNotFound{})
+			},
+		},
+		"deletes the secret from the specified environments": {
+			inEnvNames: []string{"prod"},
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod"}, nil)
+				m.deleter.EXPECT().DeleteSecret("/copilot/phonetool/prod/secrets/db-password").Return(nil)
+			},
+		},
+		"collects errors by environment": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				m.deleter.EXPECT().DeleteSecret("/copilot/phonetool/test/secrets/db-password").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete secret db-password from environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretDeleteMocks{
+				store:   mocks.NewMockstore(ctrl),
+				deleter: mocks.NewMocksecretDeleter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deleteSecretOpts{
+				deleteSecretVars: deleteSecretVars{
+					appName:  "phonetool",
+					name:     "db-password",
+					envNames: tc.inEnvNames,
+				},
+				store: m.store,
+				newSecretDeleter: func(*config.Environment) (secretDeleter, error) {
+					return m.deleter, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -53,6 +54,7 @@ type secretInitVars struct {
 	values        map[string]string
 	inputFilePath string
 	overwrite     bool
+	backend       string
 }
 
 type secretInitOpts struct {
@@ -68,8 +70,12 @@ type secretInitOpts struct {
 
 	shouldShowOverwriteHint bool
 
-	envUpgradeCMDs map[string]actionCommand
-	secretPutters  map[string]secretPutter
+	envUpgradeCMDs        map[string]actionCommand
+	secretPutters         map[string]secretPutter
+	secretsManagerPutters map[string]secretsManagerSecretPutter
+
+	// secretARNs holds the ARNs of the secrets put in Secrets Manager by secret name and environment name.
+	secretARNs map[string]map[string]string
 
 	configureClientsForEnv func(envName string) error
 	readFile               func() ([]byte, error)
@@ -87,8 +93,10 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		store:          store,
 		fs:             &afero.Afero{Fs: afero.NewOsFs()},
 
-		envUpgradeCMDs: make(map[string]actionCommand),
-		secretPutters:  make(map[string]secretPutter),
+		envUpgradeCMDs:        make(map[string]actionCommand),
+		secretPutters:         make(map[string]secretPutter),
+		secretsManagerPutters: make(map[string]secretsManagerSecretPutter),
+		secretARNs:            make(map[string]map[string]string),
 
 		prompter: prompter,
 		selector: selector.NewSelect(prompter, store),
@@ -112,6 +120,10 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		if err != nil {
 			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
 		}
+		if opts.backend == secretBackendSecretsManager {
+			opts.secretsManagerPutters[envName] = secretsmanager.NewWithSession(sess)
+			return nil
+		}
 		opts.secretPutters[envName] = ssm.New(sess)
 
 		return nil
@@ -143,6 +155,12 @@ func (o *secretInitOpts) Validate() error {
 		return errors.New("cannot specify `--cli-input-yaml` with `--values`")
 	}
 
+	if o.backend != "" {
+		if err := validateSecretBackend(o.backend); err != nil {
+			return err
+		}
+	}
+
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
 		if err != nil {
@@ -288,6 +306,9 @@ func (o *secretInitOpts) putSecret(secretName string, values map[string]string)
 }
 
 func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error {
+	if o.backend == secretBackendSecretsManager {
+		return o.putSecretsManagerSecretInEnv(secretName, envName, value)
+	}
 	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
 	in := ssm.PutSecretInput{
 		Name:      name,
@@ -320,6 +341,49 @@ func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error
 	return nil
 }
 
+func (o *secretInitOpts) putSecretsManagerSecretInEnv(secretName, envName, value string) error {
+	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
+	out, err := o.secretsManagerPutters[envName].PutSecret(secretsmanager.PutSecretInput{
+		Name:      name,
+		Value:     value,
+		Overwrite: o.overwrite,
+		Tags: map[string]string{
+			deploy.AppTagKey: o.appName,
+			deploy.EnvTagKey: envName,
+		},
+	})
+	if err != nil {
+		var targetErr *secretsmanager.ErrSecretAlreadyExists
+		if errors.As(err, &targetErr) {
+			o.shouldShowOverwriteHint = true
+			log.Successf("Secret %s already exists in environment %s as %s. Did not overwrite. \n", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name))
+			return nil
+		}
+		return err
+	}
+
+	if _, ok := o.secretARNs[secretName]; !ok {
+		o.secretARNs[secretName] = make(map[string]string)
+	}
+	o.secretARNs[secretName][envName] = out.ARN
+	if out.Overwritten {
+		log.Successln(fmt.Sprintf("Secret %s already exists in environment %s. Overwritten.", name, color.HighlightUserInput(envName)))
+		return nil
+	}
+	log.Successln(fmt.Sprintf("Successfully put secret %s in environment %s as %s.", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(out.ARN)))
+	return nil
+}
+
+// secretValueFrom returns the value to refer to a secret from the "secrets" section of a manifest.
+// Secrets Manager secrets must be referred to by ARN, so the secret is skipped if its ARN is unknown.
+func (o *secretInitOpts) secretValueFrom(secretName, envName string) (string, bool) {
+	if o.backend != secretBackendSecretsManager {
+		return fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName), true
+	}
+	arn, ok := o.secretARNs[secretName][envName]
+	return arn, ok
+}
+
 func (o *secretInitOpts) parseSecretsInputFile() (map[string]map[string]string, error) {
 	raw, err := o.readFile()
 	if err != nil {
@@ -410,10 +474,14 @@ func (o *secretInitOpts) RecommendedActions() error {
 	secretsPerEnv := make(map[string]map[string]string)
 	for secretName, values := range o.secretValues {
 		for envName := range values {
+			valueFrom, ok := o.secretValueFrom(secretName, envName)
+			if !ok {
+				continue
+			}
 			if _, ok := secretsPerEnv[envName]; !ok {
 				secretsPerEnv[envName] = make(map[string]string)
 			}
-			secretsPerEnv[envName][template.ToSnakeCaseFunc(secretName)] = fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
+			secretsPerEnv[envName][template.ToSnakeCaseFunc(secretName)] = valueFrom
 		}
 	}
 
@@ -485,12 +553,14 @@ func buildSecretInitCmd() *cobra.Command {
 	vars := secretInitVars{}
 	cmd := &cobra.Command{
 		Use:   "init",
-		Short: "Create or update secrets in SSM Parameter Store.",
+		Short: "Create or update secrets in SSM Parameter Store or Secrets Manager.",
 		Example: `
 Create a secret with prompts. 
 /code $ copilot secret init
 Create a secret named db-password in multiple environments.
 /code $ copilot secret init --name db-password
+Create a secret named db-password in Secrets Manager.
+/code $ copilot secret init --name db-password --backend secretsmanager
 Create secrets from input.yml. For the format of the YAML file, please see https://aws.github.io/copilot-cli/docs/commands/secret-init/.
 /code $ copilot secret init --cli-input-yaml input.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -525,5 +595,6 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	cmd.Flags().StringVar(&vars.backend, secretBackendFlag, secretBackendSSM, secretBackendFlagDescription)
 	return cmd
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/golang/mock/gomock"
@@ -34,6 +35,7 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 		inValues        map[string]string
 		inOverwrite     bool
 		inInputFilePath string
+		inBackend       string
 
 		setupMocks func(m secretInitMocks)
 
@@ -63,6 +65,11 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "bad_village").Return(&config.Environment{}, nil)
 			},
 		},
+		"error if backend is invalid": {
+			inBackend:   "vault",
+			setupMocks:  func(m secretInitMocks) {},
+			wantedError: errors.New("invalid backend vault: must be one of ssm, secretsmanager"),
+		},
 		"error getting app": {
 			inApp: "dragon_befriending",
 			setupMocks: func(m secretInitMocks) {
@@ -122,6 +129,7 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 					values:        tc.inValues,
 					inputFilePath: tc.inInputFilePath,
 					overwrite:     tc.inOverwrite,
+					backend:       tc.inBackend,
 				},
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
 				store: mockStore,
@@ -601,6 +609,87 @@ db-host:
 	}
 }
 
+func TestSecretInitOpts_ExecuteWithSecretsManager(t *testing.T) {
+	const testARN = "arn:aws:secretsmanager:us-west-2:123456789012:secret:/copilot/test-app/test/secrets/db-password-AbCdEf"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MocksecretsManagerSecretPutter)
+
+		wantedARNs  map[string]map[string]string
+		wantedError error
+	}{
+		"records the ARNs of the created secrets": {
+			setupMocks: func(m *mocks.MocksecretsManagerSecretPutter) {
+				m.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:  "/copilot/test-app/test/secrets/db-password",
+					Value: "test-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&secretsmanager.PutSecretOutput{
+					ARN: testARN,
+				}, nil)
+			},
+			wantedARNs: map[string]map[string]string{
+				"db-password": {
+					"test": testARN,
+				},
+			},
+		},
+		"do not throw error if secret already exists": {
+			setupMocks: func(m *mocks.MocksecretsManagerSecretPutter) {
+				m.EXPECT().PutSecret(gomock.Any()).Return(nil, &secretsmanager.ErrSecretAlreadyExists{})
+			},
+			wantedARNs: map[string]map[string]string{},
+		},
+		"a secret fails to create": {
+			setupMocks: func(m *mocks.MocksecretsManagerSecretPutter) {
+				m.EXPECT().PutSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("put secret db-password in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPutter := mocks.NewMocksecretsManagerSecretPutter(ctrl)
+			mockEnvUpgrader := mocks.NewMockactionCommand(ctrl)
+			mockEnvUpgrader.EXPECT().Execute().Return(nil)
+			tc.setupMocks(mockPutter)
+
+			opts := secretInitOpts{
+				secretInitVars: secretInitVars{
+					appName: "test-app",
+					name:    "db-password",
+					values: map[string]string{
+						"test": "test-password",
+					},
+					backend: secretBackendSecretsManager,
+				},
+				secretsManagerPutters: make(map[string]secretsManagerSecretPutter),
+				secretARNs:            make(map[string]map[string]string),
+				envUpgradeCMDs:        make(map[string]actionCommand),
+			}
+			opts.configureClientsForEnv = func(envName string) error {
+				opts.secretsManagerPutters[envName] = mockPutter
+				opts.envUpgradeCMDs[envName] = mockEnvUpgrader
+				return nil
+			}
+
+			err := opts.Execute()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARNs, opts.secretARNs)
+			}
+		})
+	}
+}
+
 func Test_SecretInitParseSecretsInputFile(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		opts := secretInitOpts{
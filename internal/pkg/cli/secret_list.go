@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretListAppNamePrompt     = "Which application's secrets would you like to list?"
+	secretListAppNamePromptHelp = "The secrets of each environment in the application will be listed."
+
+	secretExistsInEnv  = "✔"
+	secretMissingInEnv = "✘"
+
+	secretListMinCellWidth     = 20 // minimum number of characters in a cell of the secrets table.
+	secretListTabWidth         = 4  // number of characters in a tab.
+	secretListCellPaddingWidth = 2  // number of padding characters added to a cell.
+)
+
+type listSecretVars struct {
+	appName          string
+	backend          string
+	shouldOutputJSON bool
+}
+
+type listSecretOpts struct {
+	listSecretVars
+
+	store store
+	sel   appSelector
+	w     io.Writer
+
+	newSecretLister func(env *config.Environment) (secretLister, error)
+}
+
+func newListSecretOpts(vars listSecretVars) (*listSecretOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	opts := &listSecretOpts{
+		listSecretVars: vars,
+		store:          store,
+		sel:            selector.NewSelect(prompt.New(), store),
+		w:              os.Stdout,
+	}
+	opts.newSecretLister = func(env *config.Environment) (secretLister, error) {
+		return newSecretsClientForEnv(opts.backend, env)
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *listSecretOpts) Validate() error {
+	if o.backend != "" {
+		if err := validateSecretBackend(o.backend); err != nil {
+			return err
+		}
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *listSecretOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNamePromptHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// secretInEnvs represents a secret and the environments that it exists in.
+type secretInEnvs struct {
+	Name         string   `json:"name"`
+	Environments []string `json:"environments"`
+}
+
+// Execute writes the secrets of the application and the environments that each secret exists in.
+func (o *listSecretOpts) Execute() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+
+	envsBySecret := make(map[string][]string)
+	for _, env := range envs {
+		lister, err := o.newSecretLister(env)
+		if err != nil {
+			return err
+		}
+		path := fmt.Sprintf(fmtSecretPath, o.appName, env.Name)
+		names, err := lister.ListSecrets(path)
+		if err != nil {
+			return fmt.Errorf("list secrets in environment %s: %w", env.Name, err)
+		}
+		for _, name := range names {
+			secret := strings.TrimPrefix(name, path)
+			envsBySecret[secret] = append(envsBySecret[secret], env.Name)
+		}
+	}
+
+	secrets := make([]*secretInEnvs, 0, len(envsBySecret))
+	for name, envNames := range envsBySecret {
+		secrets = append(secrets, &secretInEnvs{
+			Name:         name,
+			Environments: envNames,
+		})
+	}
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].Name < secrets[j].Name })
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(secrets)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(envs, secrets)
+	return nil
+}
+
+func (o *listSecretOpts) humanOutput(envs []*config.Environment, secrets []*secretInEnvs) {
+	headers := []string{"Name"}
+	for _, env := range envs {
+		headers = append(headers, env.Name)
+	}
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		underlines[i] = strings.Repeat("-", len(header))
+	}
+	w := tabwriter.NewWriter(o.w, secretListMinCellWidth, secretListTabWidth, secretListCellPaddingWidth, ' ', 0)
+	fmt.Fprintf(w, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "%s\n", strings.Join(underlines, "\t"))
+	for _, secret := range secrets {
+		row := []string{secret.Name}
+		for _, env := range envs {
+			row = append(row, secretMissingInEnv)
+			for _, envName := range secret.Environments {
+				if envName == env.Name {
+					row[len(row)-1] = secretExistsInEnv
+					break
+				}
+			}
+		}
+		fmt.Fprintf(w, "%s\n", strings.Join(row, "\t"))
+	}
+	w.Flush()
+}
+
+func (o *listSecretOpts) jsonOutput(secrets []*secretInEnvs) (string, error) {
+	type serializedSecrets struct {
+		Secrets []*secretInEnvs `json:"secrets"`
+	}
+	b, err := json.Marshal(serializedSecrets{Secrets: secrets})
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildSecretListCmd builds the command for listing the secrets of an application.
+func buildSecretListCmd() *cobra.Command {
+	vars := listSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of an application and the environments that they exist in.",
+		Example: `
+  Lists the secrets stored in SSM Parameter Store for the frontend application.
+  /code $ copilot secret ls -a frontend
+  Lists the secrets stored in Secrets Manager in JSON format.
+  /code $ copilot secret ls -a frontend --backend secretsmanager --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.backend, secretBackendFlag, secretBackendSSM, secretBackendFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretListMocks struct {
+	store  *mocks.Mockstore
+	lister *mocks.MocksecretLister
+}
+
+func TestListSecretOpts_Execute(t *testing.T) {
+	testEnvs := []*config.Environment{
+		{Name: "test"},
+		{Name: "prod"},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m secretListMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"wraps error from listing environments": {
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments in application phonetool: some error"),
+		},
+		"wraps error from listing secrets": {
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/phonetool/test/secrets/").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list secrets in environment test: some error"),
+		},
+		"writes a table of the secrets in each environment": {
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/phonetool/test/secrets/").Return([]string{
+					"/copilot/phonetool/test/secrets/db-password",
+					"/copilot/phonetool/test/secrets/api-key",
+				}, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/phonetool/prod/secrets/").Return([]string{
+					"/copilot/phonetool/prod/secrets/db-password",
+				}, nil)
+			},
+			wantedContent: `Name                test                prod
+----                ----                ----
+api-key             ✔                   ✘
+db-password         ✔                   ✔
+`,
+		},
+		"writes json": {
+			inJSON: true,
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/phonetool/test/secrets/").Return([]string{
+					"/copilot/phonetool/test/secrets/db-password",
+				}, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/phonetool/prod/secrets/").Return(nil, nil)
+			},
+			wantedContent: `{"secrets":[{"name":"db-password","environments":["test"]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretListMocks{
+				store:  mocks.NewMockstore(ctrl),
+				lister: mocks.NewMocksecretLister(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &listSecretOpts{
+				listSecretVars: listSecretVars{
+					appName:          "phonetool",
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				w:     b,
+				newSecretLister: func(*config.Environment) (secretLister, error) {
+					return m.lister, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretRotateAppNamePrompt     = "Which application is the secret in?"
+	secretRotateAppNamePromptHelp = "An application groups the secrets of its environments."
+	secretRotateNamePrompt        = "Which secret would you like to rotate?"
+	secretRotateNamePromptHelp    = "The name of the secret, such as 'db_password'."
+
+	fmtSecretRotateValuePrompt     = "What is the new value of secret %s in environment %s?"
+	fmtSecretRotateValuePromptHelp = "If you do not wish to rotate the secret %s in environment %s, you can leave this blank by pressing 'Enter' without entering any value."
+)
+
+type rotateSecretVars struct {
+	appName string
+	name    string
+	values  map[string]string
+	backend string
+}
+
+type rotateSecretOpts struct {
+	rotateSecretVars
+
+	store    store
+	prompter prompter
+	selector appSelector
+
+	newSecretUpdater func(env *config.Environment) (secretUpdater, error)
+}
+
+func newRotateSecretOpts(vars rotateSecretVars) (*rotateSecretOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &rotateSecretOpts{
+		rotateSecretVars: vars,
+		store:            store,
+		prompter:         prompter,
+		selector:         selector.NewSelect(prompter, store),
+	}
+	opts.newSecretUpdater = func(env *config.Environment) (secretUpdater, error) {
+		return newSecretsClientForEnv(opts.backend, env)
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *rotateSecretOpts) Validate() error {
+	if o.backend != "" {
+		if err := validateSecretBackend(o.backend); err != nil {
+			return err
+		}
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if o.name != "" {
+		if err := validateSecretName(o.name); err != nil {
+			return err
+		}
+	}
+	for env := range o.values {
+		if _, err := o.store.GetEnvironment(o.appName, env); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", env, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *rotateSecretOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askSecretName(); err != nil {
+		return err
+	}
+	return o.askSecretValues()
+}
+
+// Execute overwrites the value of the existing secret in each environment.
+func (o *rotateSecretOpts) Execute() error {
+	envNames := make([]string, 0, len(o.values))
+	for env := range o.values {
+		envNames = append(envNames, env)
+	}
+	sort.Strings(envNames)
+
+	errorsForEnvironments := make(map[string]error)
+	for _, envName := range envNames {
+		if err := o.rotateSecretInEnv(envName, o.values[envName]); err != nil {
+			log.Errorf("Failed to rotate secret %s in environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(envName))
+			errorsForEnvironments[envName] = err
+			continue
+		}
+		log.Successf("Rotated secret %s in environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(envName))
+	}
+	if len(errorsForEnvironments) != 0 {
+		return &errSecretFailedInSomeEnvironments{
+			secretName:            o.name,
+			errorsForEnvironments: errorsForEnvironments,
+		}
+	}
+	return nil
+}
+
+func (o *rotateSecretOpts) rotateSecretInEnv(envName, value string) error {
+	env, err := o.store.GetEnvironment(o.appName, envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", envName, o.appName, err)
+	}
+	updater, err := o.newSecretUpdater(env)
+	if err != nil {
+		return err
+	}
+	if err := updater.UpdateSecret(fmt.Sprintf(fmtSecretParameterName, o.appName, envName, o.name), value); err != nil {
+		if isSecretNotFound(err) {
+			return fmt.Errorf("secret %s does not exist, run %s to create it", o.name, color.HighlightCode("copilot secret init"))
+		}
+		return err
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *rotateSecretOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to restart the tasks of the services that use the secret with its new value.", color.HighlightCode("copilot svc deploy")),
+	}
+}
+
+func (o *rotateSecretOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.selector.Application(secretRotateAppNamePrompt, secretRotateAppNamePromptHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *rotateSecretOpts) askSecretName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompter.Get(secretRotateNamePrompt, secretRotateNamePromptHelp, validateSecretName, prompt.WithFinalMessage("secret name: "))
+	if err != nil {
+		return fmt.Errorf("ask for the secret name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *rotateSecretOpts) askSecretValues() error {
+	if o.values != nil {
+		return nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in app %s: %w", o.appName, err)
+	}
+	values := make(map[string]string)
+	for _, env := range envs {
+		value, err := o.prompter.GetSecret(
+			fmt.Sprintf(fmtSecretRotateValuePrompt, color.HighlightUserInput(o.name), env.Name),
+			fmt.Sprintf(fmtSecretRotateValuePromptHelp, color.HighlightUserInput(o.name), env.Name))
+		if err != nil {
+			return fmt.Errorf("get new value of secret %s in environment %s: %w", o.name, env.Name, err)
+		}
+		if value != "" {
+			values[env.Name] = value
+		}
+	}
+	o.values = values
+	return nil
+}
+
+// buildSecretRotateCmd builds the command for overwriting the value of an existing secret.
+func buildSecretRotateCmd() *cobra.Command {
+	vars := rotateSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Overwrites the value of an existing secret in environments.",
+		Example: `
+  Rotate the secret db-password with prompts for the new values.
+  /code $ copilot secret rotate --name db-password
+  Rotate the secret db-password in the test and prod environments.
+  /code $ copilot secret rotate --name db-password --values test=new-test-password,prod=new-prod-password`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRotateSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln()
+			log.Infoln("Recommended follow-up actions:")
+			for _, followUp := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followUp)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretRotateValuesFlagDescription)
+	cmd.Flags().StringVar(&vars.backend, secretBackendFlag, secretBackendSSM, secretBackendFlagDescription)
+	return cmd
+}
@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateSecretOpts_Ask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockstore(ctrl)
+	mockPrompter := mocks.NewMockprompter(ctrl)
+	mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+		{Name: "test"},
+		{Name: "prod"},
+	}, nil)
+	gomock.InOrder(
+		mockPrompter.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return("new-test-password", nil),
+		mockPrompter.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return("", nil),
+	)
+	opts := &rotateSecretOpts{
+		rotateSecretVars: rotateSecretVars{
+			appName: "phonetool",
+			name:    "db-password",
+		},
+		store:    mockStore,
+		prompter: mockPrompter,
+	}
+
+	err := opts.Ask()
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"test": "new-test-password"}, opts.values)
+}
+
+func TestRotateSecretOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(updater *mocks.MocksecretUpdater)
+
+		wantedError error
+	}{
+		"rotates the secret in each environment": {
+			setupMocks: func(updater *mocks.MocksecretUpdater) {
+				updater.EXPECT().UpdateSecret("/copilot/phonetool/prod/secrets/db-password", "new-prod-password").Return(nil)
+				updater.EXPECT().UpdateSecret("/copilot/phonetool/test/secrets/db-password", "new-test-password").Return(nil)
+			},
+		},
+		"collects errors by environment": {
+			setupMocks: func(updater *mocks.MocksecretUpdater) {
+				updater.EXPECT().UpdateSecret("/copilot/phonetool/prod/secrets/db-password", gomock.Any()).Return(&secretsmanager.ErrSecretNotFound{})
+				updater.EXPECT().UpdateSecret("/copilot/phonetool/test/secrets/db-password", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("put secret db-password in environment prod: secret db-password does not exist, run %s to create it\nput secret db-password in environment test: some error", color.HighlightCode("copilot secret init")),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			mockStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod"}, nil)
+			mockUpdater := mocks.NewMocksecretUpdater(ctrl)
+			tc.setupMocks(mockUpdater)
+			opts := &rotateSecretOpts{
+				rotateSecretVars: rotateSecretVars{
+					appName: "phonetool",
+					name:    "db-password",
+					values: map[string]string{
+						"test": "new-test-password",
+						"prod": "new-prod-password",
+					},
+				},
+				store: mockStore,
+				newSecretUpdater: func(*config.Environment) (secretUpdater, error) {
+					return mockUpdater, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretShowAppNamePrompt     = "Which application is the secret in?"
+	secretShowAppNamePromptHelp = "An application groups the secrets of its environments."
+	secretShowNamePrompt        = "Which secret would you like to show?"
+	secretShowNamePromptHelp    = "The name of the secret, such as 'db_password'."
+	secretShowEnvNamePrompt     = "Which environment would you like to show the secret from?"
+
+	fmtSecretShowConfirmPrompt     = "Are you sure you want to decrypt and print the value of secret %s in environment %s?"
+	fmtSecretShowConfirmPromptHelp = "The value of the secret will be printed in plain text to your terminal."
+)
+
+var (
+	errSecretShowCancelled = errors.New("secret show cancelled - no secret was decrypted")
+)
+
+type showSecretVars struct {
+	appName          string
+	envName          string
+	name             string
+	backend          string
+	skipConfirmation bool
+}
+
+type showSecretOpts struct {
+	showSecretVars
+
+	store  store
+	prompt prompter
+	sel    appEnvSelector
+	w      io.Writer
+
+	newSecretGetter func(env *config.Environment) (secretValueGetter, error)
+}
+
+func newShowSecretOpts(vars showSecretVars) (*showSecretOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &showSecretOpts{
+		showSecretVars: vars,
+		store:          store,
+		prompt:         prompter,
+		sel:            selector.NewSelect(prompter, store),
+		w:              os.Stdout,
+	}
+	opts.newSecretGetter = func(env *config.Environment) (secretValueGetter, error) {
+		return newSecretsClientForEnv(opts.backend, env)
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *showSecretOpts) Validate() error {
+	if o.backend != "" {
+		if err := validateSecretBackend(o.backend); err != nil {
+			return err
+		}
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if o.name != "" {
+		if err := validateSecretName(o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided,
+// and for a confirmation before decrypting the secret.
+func (o *showSecretOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askSecretName(); err != nil {
+		return err
+	}
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSecretShowConfirmPrompt, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName)), fmtSecretShowConfirmPromptHelp)
+	if err != nil {
+		return fmt.Errorf("confirm to show secret %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errSecretShowCancelled
+	}
+	return nil
+}
+
+// Execute writes the decrypted value of the secret.
+func (o *showSecretOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+	}
+	getter, err := o.newSecretGetter(env)
+	if err != nil {
+		return err
+	}
+	value, err := getter.SecretValue(fmt.Sprintf(fmtSecretParameterName, o.appName, o.envName, o.name))
+	if err != nil {
+		if isSecretNotFound(err) {
+			return fmt.Errorf("secret %s does not exist in environment %s", o.name, o.envName)
+		}
+		return fmt.Errorf("get secret %s in environment %s: %w", o.name, o.envName, err)
+	}
+	fmt.Fprintln(o.w, value)
+	return nil
+}
+
+func (o *showSecretOpts) askAppName() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretShowAppNamePrompt, secretShowAppNamePromptHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *showSecretOpts) askSecretName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(secretShowNamePrompt, secretShowNamePromptHelp, validateSecretName, prompt.WithFinalMessage("secret name: "))
+	if err != nil {
+		return fmt.Errorf("ask for the secret name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *showSecretOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(secretShowEnvNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = env
+	return nil
+}
+
+// buildSecretShowCmd builds the command for showing the value of a secret in an environment.
+func buildSecretShowCmd() *cobra.Command {
+	vars := showSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Decrypts and prints the value of a secret in an environment.",
+		Example: `
+  Print the value of the secret db-password in the test environment.
+  /code $ copilot secret show --name db-password --env test
+  Print the value of a secret stored in Secrets Manager without a confirmation prompt.
+  /code $ copilot secret show --name db-password --env test --backend secretsmanager --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.backend, secretBackendFlag, secretBackendSSM, secretBackendFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretShowAskMocks struct {
+	prompt *mocks.Mockprompter
+	sel    *mocks.MockappEnvSelector
+}
+
+func TestShowSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName          string
+		inEnvName          string
+		inName             string
+		inSkipConfirmation bool
+		setupMocks         func(m secretShowAskMocks)
+
+		wantedError error
+	}{
+		"prompts for missing fields and confirmation": {
+			setupMocks: func(m secretShowAskMocks) {
+				m.sel.EXPECT().Application(secretShowAppNamePrompt, secretShowAppNamePromptHelp).Return("phonetool", nil)
+				m.prompt.EXPECT().Get(secretShowNamePrompt, secretShowNamePromptHelp, gomock.Any(), gomock.Any()).Return("db-password", nil)
+				m.sel.EXPECT().Environment(secretShowEnvNamePrompt, "", "phonetool").Return("test", nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), fmtSecretShowConfirmPromptHelp).Return(true, nil)
+			},
+		},
+		"skips confirmation with --yes": {
+			inAppName:          "phonetool",
+			inEnvName:          "test",
+			inName:             "db-password",
+			inSkipConfirmation: true,
+			setupMocks:         func(m secretShowAskMocks) {},
+		},
+		"cancelled if the user does not confirm": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inName:    "db-password",
+			setupMocks: func(m secretShowAskMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedError: errSecretShowCancelled,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretShowAskMocks{
+				prompt: mocks.NewMockprompter(ctrl),
+				sel:    mocks.NewMockappEnvSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showSecretOpts{
+				showSecretVars: showSecretVars{
+					appName:          tc.inAppName,
+					envName:          tc.inEnvName,
+					name:             tc.inName,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				prompt: m.prompt,
+				sel:    m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestShowSecretOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(getter *mocks.MocksecretValueGetter)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if the secret does not exist": {
+			setupMocks: func(getter *mocks.MocksecretValueGetter) {
+				getter.EXPECT().SecretValue("/copilot/phonetool/test/secrets/db-password").Return("", &ssm.ErrParameterNotFound{})
+			},
+			wantedError: errors.New("secret db-password does not exist in environment test"),
+		},
+		"wraps other errors": {
+			setupMocks: func(getter *mocks.MocksecretValueGetter) {
+				getter.EXPECT().SecretValue(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get secret db-password in environment test: some error"),
+		},
+		"writes the value of the secret": {
+			setupMocks: func(getter *mocks.MocksecretValueGetter) {
+				getter.EXPECT().SecretValue("/copilot/phonetool/test/secrets/db-password").Return("super secure password", nil)
+			},
+			wantedContent: "super secure password\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			mockGetter := mocks.NewMocksecretValueGetter(ctrl)
+			tc.setupMocks(mockGetter)
+			b := &bytes.Buffer{}
+			opts := &showSecretOpts{
+				showSecretVars: showSecretVars{
+					appName: "phonetool",
+					envName: "test",
+					name:    "db-password",
+				},
+				store: mockStore,
+				w:     b,
+				newSecretGetter: func(*config.Environment) (secretValueGetter, error) {
+					return mockGetter, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretRefsMocks struct {
+	params  *mocks.MockssmParameterChecker
+	secrets *mocks.MocksecretsManagerSecretChecker
+}
+
+func TestValidateSecretRefs(t *testing.T) {
+	mft := &manifest.BackendService{
+		BackendServiceConfig: manifest.BackendServiceConfig{
+			TaskConfig: manifest.TaskConfig{
+				Secrets: map[string]string{
+					"DB_PASSWORD": "/copilot/phonetool/test/secrets/db-password",
+					"API_KEY":     "arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key-AbCdEf:key::",
+				},
+			},
+			Sidecars: map[string]*manifest.SidecarConfig{
+				"nginx": {
+					Secrets: map[string]string{
+						"CERT": "arn:aws:ssm:us-west-2:123456789012:parameter/nginx/cert",
+					},
+				},
+			},
+			Logging: &manifest.Logging{
+				SecretOptions: map[string]string{
+					"LICENSE": "license",
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m secretRefsMocks)
+
+		wantedError error
+	}{
+		"returns nil if every secret exists": {
+			setupMocks: func(m secretRefsMocks) {
+				m.params.EXPECT().ParameterExists("license").Return(true, nil)
+				m.params.EXPECT().ParameterExists("/copilot/phonetool/test/secrets/db-password").Return(true, nil)
+				m.params.EXPECT().ParameterExists("arn:aws:ssm:us-west-2:123456789012:parameter/nginx/cert").Return(true, nil)
+				m.secrets.EXPECT().SecretExists("arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key-AbCdEf").Return(true, nil)
+			},
+		},
+		"reports all the missing secrets with their path in the manifest": {
+			setupMocks: func(m secretRefsMocks) {
+				m.params.EXPECT().ParameterExists("license").Return(false, nil)
+				m.params.EXPECT().ParameterExists("/copilot/phonetool/test/secrets/db-password").Return(true, nil)
+				m.params.EXPECT().ParameterExists("arn:aws:ssm:us-west-2:123456789012:parameter/nginx/cert").Return(false, nil)
+				m.secrets.EXPECT().SecretExists(gomock.Any()).Return(false, nil)
+			},
+			wantedError: errors.New(`the following secrets referenced in the manifest do not exist in environment test:
+  logging.secretOptions.LICENSE: license
+  secrets.API_KEY: arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key-AbCdEf:key::
+  sidecars.nginx.secrets.CERT: arn:aws:ssm:us-west-2:123456789012:parameter/nginx/cert`),
+		},
+		"skips secrets that can't be verified": {
+			setupMocks: func(m secretRefsMocks) {
+				m.params.EXPECT().ParameterExists(gomock.Any()).Return(false, errors.New("access denied")).Times(3)
+				m.secrets.EXPECT().SecretExists(gomock.Any()).Return(false, errors.New("access denied"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretRefsMocks{
+				params:  mocks.NewMockssmParameterChecker(ctrl),
+				secrets: mocks.NewMocksecretsManagerSecretChecker(ctrl),
+			}
+			tc.setupMocks(m)
+
+			// WHEN
+			err := validateSecretRefs(mft, "test", m.params, m.secrets)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+)
+
+// sidecarImagesInput holds the fields required to build and push the images of the sidecars of a workload.
+type sidecarImagesInput struct {
+	app      *config.Application
+	wlName   string
+	imageTag string
+	mft      interface{} // Manifest of the workload with the environment overrides applied.
+
+	ws                    copilotDirGetter
+	repos                 sidecarRepoAdder
+	newImageBuilderPusher func(repoName string) (imageBuilderPusher, error)
+	builder               repository.ContainerLoginBuildPusher
+}
+
+// buildAndPushSidecarImages builds the images of the sidecars that are built from a local Dockerfile,
+// and pushes them to the "<app>/<workload>/<sidecar>" ECR repositories.
+// It returns the digests of the pushed images keyed by sidecar name.
+func buildAndPushSidecarImages(in sidecarImagesInput) (map[string]string, error) {
+	builds, err := manifest.SidecarBuilds(in.mft)
+	if err != nil {
+		return nil, err
+	}
+	if len(builds) == 0 {
+		return nil, nil
+	}
+	if err := validateImmutableImageTag(in.app, in.imageTag); err != nil {
+		return nil, err
+	}
+	copilotDir, err := in.ws.CopilotDirPath()
+	if err != nil {
+		return nil, fmt.Errorf("get copilot directory: %w", err)
+	}
+	var names []string
+	for name := range builds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if err := in.repos.AddSidecarReposToApp(in.app, in.wlName, names); err != nil {
+		return nil, err
+	}
+	digests := make(map[string]string, len(names))
+	for _, name := range names {
+		repoName := fmt.Sprintf("%s/%s/%s", in.app.Name, in.wlName, name)
+		pusher, err := in.newImageBuilderPusher(repoName)
+		if err != nil {
+			return nil, fmt.Errorf("initiate image builder pusher for sidecar %s: %w", name, err)
+		}
+		args := toBuildArguments(builds[name].BuildConfig(filepath.Dir(copilotDir)), in.imageTag)
+		if err := validateImmutableBuildCache(in.app, args); err != nil {
+			return nil, fmt.Errorf("build image of sidecar %s: %w", name, err)
+		}
+		args.SkipLatestTag = in.app.HasImmutableImageTags()
+		digest, err := pusher.BuildAndPush(in.builder, args)
+		if err != nil {
+			return nil, fmt.Errorf("build and push image of sidecar %s: %w", name, err)
+		}
+		digests[name] = digest
+	}
+	return digests, nil
+}
+
+// sidecarECRImages returns the images of the sidecars pushed under the repository of the workload.
+func sidecarECRImages(wlRepoURL, imageTag string, digests map[string]string) map[string]stack.ECRImage {
+	if len(digests) == 0 {
+		return nil
+	}
+	images := make(map[string]stack.ECRImage, len(digests))
+	for name, digest := range digests {
+		images[name] = stack.ECRImage{
+			RepoURL:  fmt.Sprintf("%s/%s", wlRepoURL, name),
+			ImageTag: imageTag,
+			Digest:   digest,
+		}
+	}
+	return images
+}
@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type sidecarImagesMocks struct {
+	ws     *mocks.MockcopilotDirGetter
+	repos  *mocks.MocksidecarRepoAdder
+	pusher *mocks.MockimageBuilderPusher
+}
+
+func TestBuildAndPushSidecarImages(t *testing.T) {
+	mockApp := &config.Application{Name: "phonetool"}
+	mockMft := &manifest.LoadBalancedWebService{
+		LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+			Sidecars: map[string]*manifest.SidecarConfig{
+				"nginx": {
+					Image: manifest.SidecarImage{
+						Build: manifest.BuildArgsOrString{
+							BuildString: aws.String("proxy/Dockerfile"),
+						},
+					},
+				},
+				"xray": {
+					Image: manifest.SidecarImage{
+						Location: aws.String("public.ecr.aws/xray/aws-xray-daemon"),
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mft        interface{}
+		setupMocks func(m sidecarImagesMocks)
+
+		wantedRepo    string
+		wantedDigests map[string]string
+		wantedError   error
+	}{
+		"does nothing if no sidecar is built from a Dockerfile": {
+			mft: &manifest.LoadBalancedWebService{},
+			setupMocks: func(m sidecarImagesMocks) {
+				m.ws.EXPECT().CopilotDirPath().Times(0)
+				m.repos.EXPECT().AddSidecarReposToApp(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"errors if the sidecar repositories cannot be created": {
+			mft: mockMft,
+			setupMocks: func(m sidecarImagesMocks) {
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.repos.EXPECT().AddSidecarReposToApp(mockApp, "frontend", []string{"nginx"}).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"errors if the image of a sidecar cannot be pushed": {
+			mft: mockMft,
+			setupMocks: func(m sidecarImagesMocks) {
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.repos.EXPECT().AddSidecarReposToApp(mockApp, "frontend", []string{"nginx"}).Return(nil)
+				m.pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("build and push image of sidecar nginx: some error"),
+		},
+		"builds and pushes the sidecar images to their repositories": {
+			mft: mockMft,
+			setupMocks: func(m sidecarImagesMocks) {
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.repos.EXPECT().AddSidecarReposToApp(mockApp, "frontend", []string{"nginx"}).Return(nil)
+				m.pusher.EXPECT().BuildAndPush(gomock.Any(), &exec.BuildArguments{
+					Dockerfile: filepath.Join("/ws", "proxy", "Dockerfile"),
+					Context:    filepath.Join("/ws", "proxy"),
+					Tags:       []string{"g123bfc"},
+				}).Return("sha256:abc", nil)
+			},
+			wantedRepo:    "phonetool/frontend/nginx",
+			wantedDigests: map[string]string{"nginx": "sha256:abc"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := sidecarImagesMocks{
+				ws:     mocks.NewMockcopilotDirGetter(ctrl),
+				repos:  mocks.NewMocksidecarRepoAdder(ctrl),
+				pusher: mocks.NewMockimageBuilderPusher(ctrl),
+			}
+			tc.setupMocks(m)
+			var repoName string
+
+			// WHEN
+			digests, err := buildAndPushSidecarImages(sidecarImagesInput{
+				app:      mockApp,
+				wlName:   "frontend",
+				imageTag: "g123bfc",
+				mft:      tc.mft,
+				ws:       m.ws,
+				repos:    m.repos,
+				newImageBuilderPusher: func(name string) (imageBuilderPusher, error) {
+					repoName = name
+					return m.pusher, nil
+				},
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigests, digests)
+			require.Equal(t, tc.wantedRepo, repoName)
+		})
+	}
+}
+
+func TestSidecarECRImages(t *testing.T) {
+	require.Nil(t, sidecarECRImages("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend", "g123bfc", nil))
+	require.Equal(t, map[string]stack.ECRImage{
+		"nginx": {
+			RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend/nginx",
+			ImageTag: "g123bfc",
+			Digest:   "sha256:abc",
+		},
+	}, sidecarECRImages("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend", "g123bfc", map[string]string{
+		"nginx": "sha256:abc",
+	}))
+}
@@ -30,6 +30,8 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
+	cmd.AddCommand(buildSvcDriftCmd())
+	cmd.AddCommand(buildSvcImagesCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
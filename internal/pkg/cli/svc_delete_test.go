@@ -335,6 +335,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
+					mocks.ecr.EXPECT().RepositoryNames(mockRepo+"/").Return([]string{mockRepo + "/nginx"}, nil),
+					mocks.ecr.EXPECT().ClearRepository(mockRepo+"/nginx").Return(nil),
 
 					// removeSvcFromApp
 					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
@@ -9,7 +9,10 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"golang.org/x/mod/semver"
@@ -17,10 +20,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -52,6 +58,9 @@ type deploySvcOpts struct {
 	store               store
 	ws                  wsSvcDirReader
 	imageBuilderPusher  imageBuilderPusher
+	containerBuilder    repository.ContainerLoginBuildPusher
+	sidecarRepos        sidecarRepoAdder
+	newSidecarPusher    func(repoName string) (imageBuilderPusher, error)
 	unmarshal           func([]byte) (manifest.WorkloadManifest, error)
 	s3                  artifactUploader
 	cmd                 runner
@@ -62,17 +71,35 @@ type deploySvcOpts struct {
 	envUpgradeCmd       actionCommand
 	newAppVersionGetter func(string) (versionGetter, error)
 	endpointGetter      endpointGetter
+	certValidator       aliasCertValidator
+	paramChecker        ssmParameterChecker
+	secretChecker       secretsManagerSecretChecker
 
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
 
+	// Deploy to several environments at once.
+	parallel          bool
+	hideStackProgress bool // Don't stream stack events when deployments run concurrently.
+
 	// cached variables
 	targetApp         *config.Application
 	targetEnvironment *config.Environment
 	targetSvc         *config.Workload
 	imageDigest       string
 	buildRequired     bool
+	sidecarDigests    map[string]string // Digests of the sidecar images built from a Dockerfile.
+
+	// Images already pushed while deploying to several environments, by region.
+	pushedImages map[string]*pushedImages
+}
+
+// pushedImages holds the digests of the images of a service pushed to the repositories of a region.
+type pushedImages struct {
+	imageDigest    string
+	buildRequired  bool
+	sidecarDigests map[string]string
 }
 
 func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
@@ -117,7 +144,7 @@ func (o *deploySvcOpts) Validate() error {
 		}
 	}
 	if o.envName != "" {
-		if err := o.validateEnvName(); err != nil {
+		if err := o.validateEnvNames(); err != nil {
 			return err
 		}
 	}
@@ -137,47 +164,135 @@ func (o *deploySvcOpts) Ask() error {
 
 // Execute builds and pushes the container image for the service,
 func (o *deploySvcOpts) Execute() error {
+	if envNames := splitEnvNames(o.envName); len(envNames) > 1 {
+		return o.deployToEnvs(envNames)
+	}
+	addonsURL, err := o.prepare()
+	if err != nil {
+		return err
+	}
+
+	if err := o.deploySvc(addonsURL); err != nil {
+		return err
+	}
+
+	return o.showSvcURI()
+}
+
+// prepare upgrades the target environment if needed, pushes the container image and the addons template,
+// and returns the URL of the addons template.
+func (o *deploySvcOpts) prepare() (string, error) {
 	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
-		return err
+		return "", err
 	}
 	o.targetEnvironment = env
 
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
-		return err
+		return "", err
 	}
 	o.targetApp = app
 
 	svc, err := o.store.GetService(o.appName, o.name)
 	if err != nil {
-		return fmt.Errorf("get service configuration: %w", err)
+		return "", fmt.Errorf("get service configuration: %w", err)
 	}
 	o.targetSvc = svc
 
 	if err := o.configureClients(); err != nil {
-		return err
+		return "", err
+	}
+
+	if err := o.validateSecrets(); err != nil {
+		return "", err
 	}
 
 	if err := o.envUpgradeCmd.Execute(); err != nil {
-		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
+		return "", fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
 
 	if err := o.configureContainerImage(); err != nil {
-		return err
+		return "", err
 	}
 
-	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
-	if err != nil {
-		return err
+	return o.pushAddonsTemplateToS3Bucket()
+}
+
+// deployToEnvs deploys the service to each environment in the order they were given.
+// A failed deployment skips the remaining environments.
+// If the deployments run in parallel, the environments are still prepared in order and only the stacks are deployed concurrently.
+// The images of the service are built and pushed once per region, and reused by every environment in the region.
+func (o *deploySvcOpts) deployToEnvs(envNames []string) error {
+	o.pushedImages = make(map[string]*pushedImages)
+	var deployments []*envDeployment
+	for _, name := range envNames {
+		env, err := targetEnv(o.store, o.appName, name)
+		if err != nil {
+			return err
+		}
+		deployments = append(deployments, &envDeployment{env: env, status: envDeploymentStatusSkipped})
 	}
 
-	if err := o.deploySvc(addonsURL); err != nil {
-		return err
+	var deployed []*deploySvcOpts
+	if o.parallel {
+		deployInParallel(deployments, func(env *config.Environment) (*envDeploymentFuncs, error) {
+			log.Infof("Preparing to deploy %s to environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+			opts := o.forEnv(env.Name)
+			opts.hideStackProgress = true
+			addonsURL, err := opts.prepare()
+			if err != nil {
+				return nil, err
+			}
+			deployed = append(deployed, opts)
+			return &envDeploymentFuncs{
+				deploy: func() error {
+					return opts.deploySvc(addonsURL)
+				},
+				cancel: func() error {
+					return opts.svcCFN.CancelWorkloadUpdate(stack.NameForService(o.appName, env.Name, o.name))
+				},
+			}, nil
+		})
+	} else {
+		deployInOrder(deployments, func(env *config.Environment) error {
+			log.Infof("Deploying %s to environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+			return o.forEnv(env.Name).Execute()
+		})
+	}
+	for i, opts := range deployed {
+		if deployments[i].status != envDeploymentStatusDeployed {
+			continue
+		}
+		if err := opts.showSvcURI(); err != nil {
+			log.Warningf("Deployed %s to environment %s but couldn't retrieve its URI: %v\n", o.name, opts.targetEnvironment.Name, err)
+		}
 	}
 
-	return o.showSvcURI()
+	log.Infoln()
+	log.Info(renderEnvDeploymentSummary(deployments))
+	var failed int
+	for _, d := range deployments {
+		if d.status == envDeploymentStatusFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("deploy service %s: %d of %d environments failed", o.name, failed, len(deployments))
+	}
+	return nil
+}
+
+// forEnv returns a copy of the options that targets a single environment.
+func (o *deploySvcOpts) forEnv(envName string) *deploySvcOpts {
+	opts := *o
+	opts.envName = envName
+	opts.targetEnvironment = nil
+	opts.imageDigest = ""
+	opts.buildRequired = false
+	opts.sidecarDigests = nil
+	return &opts
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
@@ -198,13 +313,31 @@ func (o *deploySvcOpts) validateSvcName() error {
 	return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
 }
 
-func (o *deploySvcOpts) validateEnvName() error {
-	if _, err := targetEnv(o.store, o.appName, o.envName); err != nil {
-		return err
+func (o *deploySvcOpts) validateEnvNames() error {
+	seen := make(map[string]bool)
+	for _, name := range splitEnvNames(o.envName) {
+		if seen[name] {
+			return fmt.Errorf("environment %s is specified more than once", name)
+		}
+		seen[name] = true
+		if _, err := targetEnv(o.store, o.appName, name); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// splitEnvNames returns the names of the environments in a comma-separated list.
+func splitEnvNames(envNames string) []string {
+	var names []string
+	for _, name := range strings.Split(envNames, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func targetEnv(s store, appName, envName string) (*config.Environment, error) {
 	env, err := s.GetEnvironment(appName, envName)
 	if err != nil {
@@ -257,11 +390,17 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.newSidecarPusher = func(repoName string) (imageBuilderPusher, error) {
+		return repository.New(repoName, registry)
+	}
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
 	// CF client against env account profile AND target environment region
 	o.svcCFN = cloudformation.New(envSession)
+	o.certValidator = acm.New(envSession)
+	o.paramChecker = ssm.New(envSession)
+	o.secretChecker = secretsmanager.NewWithSession(envSession)
 
 	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
@@ -282,7 +421,20 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("create default session: %w", err)
 	}
-	o.appCFN = cloudformation.New(defaultSess)
+	appCFN := cloudformation.New(defaultSess)
+	o.appCFN = appCFN
+	o.sidecarRepos = appCFN
+
+	o.containerBuilder, err = newContainerBuilder(containerBuilderConfig{
+		app:           o.targetApp,
+		env:           o.targetEnvironment,
+		envSession:    envSession,
+		bucketSession: defaultSessEnvRegion,
+		appCFN:        o.appCFN,
+	})
+	if err != nil {
+		return fmt.Errorf("initiate container builder: %w", err)
+	}
 
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
 		appName: o.appName,
@@ -295,7 +447,20 @@ func (o *deploySvcOpts) configureClients() error {
 	return nil
 }
 
+// validateSecrets returns an error if any secret referenced by the service manifest doesn't exist in the target environment.
+func (o *deploySvcOpts) validateSecrets() error {
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	return validateSecretRefs(mft, o.targetEnvironment.Name, o.paramChecker, o.secretChecker)
+}
+
 func (o *deploySvcOpts) configureContainerImage() error {
+	if pushed, ok := o.pushedImages[o.targetEnvironment.Region]; ok {
+		o.imageDigest, o.buildRequired, o.sidecarDigests = pushed.imageDigest, pushed.buildRequired, pushed.sidecarDigests
+		return nil
+	}
 	svc, err := o.manifest()
 	if err != nil {
 		return err
@@ -304,20 +469,47 @@ func (o *deploySvcOpts) configureContainerImage() error {
 	if err != nil {
 		return err
 	}
-	if !required {
-		return nil
-	}
-	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := o.dfBuildArgs(svc)
+	if required {
+		// If it is built from local Dockerfile, build and push to the ECR repo.
+		if err := validateImmutableImageTag(o.targetApp, o.imageTag); err != nil {
+			return err
+		}
+		buildArg, err := o.dfBuildArgs(svc)
+		if err != nil {
+			return err
+		}
+		if err := validateImmutableBuildCache(o.targetApp, buildArg); err != nil {
+			return err
+		}
+		buildArg.SkipLatestTag = o.targetApp.HasImmutableImageTags()
+		digest, err := o.imageBuilderPusher.BuildAndPush(o.containerBuilder, buildArg)
+		if err != nil {
+			return fmt.Errorf("build and push image: %w", err)
+		}
+		o.imageDigest = digest
+		o.buildRequired = true
+	}
+	digests, err := buildAndPushSidecarImages(sidecarImagesInput{
+		app:                   o.targetApp,
+		wlName:                o.name,
+		imageTag:              o.imageTag,
+		mft:                   svc,
+		ws:                    o.ws,
+		repos:                 o.sidecarRepos,
+		newImageBuilderPusher: o.newSidecarPusher,
+		builder:               o.containerBuilder,
+	})
 	if err != nil {
 		return err
 	}
-	digest, err := o.imageBuilderPusher.BuildAndPush(exec.NewDockerCommand(), buildArg)
-	if err != nil {
-		return fmt.Errorf("build and push image: %w", err)
+	o.sidecarDigests = digests
+	if o.pushedImages != nil {
+		o.pushedImages[o.targetEnvironment.Region] = &pushedImages{
+			imageDigest:    o.imageDigest,
+			buildRequired:  o.buildRequired,
+			sidecarDigests: o.sidecarDigests,
+		}
 	}
-	o.imageDigest = digest
-	o.buildRequired = true
 	return nil
 }
 
@@ -337,11 +529,32 @@ func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{
 	if !ok {
 		return nil, fmt.Errorf("%s does not have required method BuildArgs()", name)
 	}
+	return toBuildArguments(mf.BuildArgs(filepath.Dir(copilotDir)), imageTag), nil
+}
+
+// validateImmutableImageTag returns an error if the tags of the app's images can't be overwritten but no image tag is provided.
+func validateImmutableImageTag(app *config.Application, imageTag string) error {
+	if !app.HasImmutableImageTags() || imageTag != "" {
+		return nil
+	}
+	return fmt.Errorf("application %s has immutable image tags: specify a unique tag with --%s or deploy from a git repository without uncommitted changes", app.Name, imageTagFlag)
+}
+
+// validateImmutableBuildCache returns an error if the tags of the app's images can't be overwritten
+// but the build exports its cache to a tag of the image repository that is overwritten on every build.
+func validateImmutableBuildCache(app *config.Application, args *exec.BuildArguments) error {
+	if !app.HasImmutableImageTags() || !args.ExportsCacheToImageRepository() {
+		return nil
+	}
+	return fmt.Errorf(`application %s has immutable image tags: specify a "ref" for the registry destinations of "cache_to"`, app.Name)
+}
+
+// toBuildArguments converts the build configuration of a manifest to the arguments of a container build.
+func toBuildArguments(args *manifest.DockerBuildArgs, imageTag string) *exec.BuildArguments {
 	var tags []string
 	if imageTag != "" {
 		tags = append(tags, imageTag)
 	}
-	args := mf.BuildArgs(filepath.Dir(copilotDir))
 	return &exec.BuildArguments{
 		Dockerfile: *args.Dockerfile,
 		Context:    *args.Context,
@@ -349,7 +562,30 @@ func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{
 		CacheFrom:  args.CacheFrom,
 		Target:     aws.StringValue(args.Target),
 		Tags:       tags,
-	}, nil
+		Secrets:    buildSecrets(args.Secrets),
+		SSH:        args.SSH,
+		CacheTo:    args.CacheTo,
+		Labels:     args.Labels,
+		Network:    aws.StringValue(args.Network),
+	}
+}
+
+// buildSecrets returns the build secrets of a manifest sorted by id.
+func buildSecrets(secrets map[string]manifest.BuildSecret) []exec.BuildSecret {
+	var ids []string
+	for id := range secrets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var out []exec.BuildSecret
+	for _, id := range ids {
+		out = append(out, exec.BuildSecret{
+			ID:   id,
+			File: aws.StringValue(secrets[id].File),
+			Env:  aws.StringValue(secrets[id].Env),
+		})
+	}
+	return out
 }
 
 // pushAddonsTemplateToS3Bucket generates the addons template for the service and pushes it to S3.
@@ -394,17 +630,23 @@ func (o *deploySvcOpts) manifest() (interface{}, error) {
 	return envMft, nil
 }
 
-func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
+func (o *deploySvcOpts) runtimeConfig(addonsURL string, mft interface{}) (*stack.RuntimeConfig, error) {
 	endpoint, err := o.endpointGetter.ServiceDiscoveryEndpoint()
 	if err != nil {
 		return nil, err
 	}
-	if !o.buildRequired {
-		return &stack.RuntimeConfig{
-			AddonsTemplateURL:        addonsURL,
-			AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
-			ServiceDiscoveryEndpoint: endpoint,
-		}, nil
+	callees, err := connectCallees(o.ws, o.unmarshal, o.envName, mft)
+	if err != nil {
+		return nil, err
+	}
+	rc := &stack.RuntimeConfig{
+		AddonsTemplateURL:        addonsURL,
+		AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
+		ServiceDiscoveryEndpoint: endpoint,
+		ConnectCallees:           callees,
+	}
+	if !o.buildRequired && len(o.sidecarDigests) == 0 {
+		return rc, nil
 	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
 	if err != nil {
@@ -418,16 +660,58 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 			appAccountID: o.targetApp.AccountID,
 		}
 	}
-	return &stack.RuntimeConfig{
-		AddonsTemplateURL: addonsURL,
-		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
-		Image: &stack.ECRImage{
+	if o.buildRequired {
+		rc.Image = &stack.ECRImage{
 			RepoURL:  repoURL,
 			ImageTag: o.imageTag,
 			Digest:   o.imageDigest,
-		},
-		ServiceDiscoveryEndpoint: endpoint,
-	}, nil
+		}
+	}
+	rc.SidecarImages = sidecarECRImages(repoURL, o.imageTag, o.sidecarDigests)
+	return rc, nil
+}
+
+// connectCallees returns the network connect configuration of the services listed in the "network.connect.services"
+// field of the manifest, after applying the overrides of the environment.
+func connectCallees(ws svcManifestReader, unmarshal func([]byte) (manifest.WorkloadManifest, error), envName string, mft interface{}) (map[string]*manifest.ConnectConfig, error) {
+	network := workloadNetwork(mft)
+	if network == nil || network.Connect == nil || len(network.Connect.Services) == 0 {
+		return nil, nil
+	}
+	callees := make(map[string]*manifest.ConnectConfig, len(network.Connect.Services))
+	for _, name := range network.Connect.Services {
+		if _, ok := callees[name]; ok {
+			continue
+		}
+		raw, err := ws.ReadServiceManifest(name)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest of service %s: %w", name, err)
+		}
+		calleeMft, err := unmarshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal manifest of service %s: %w", name, err)
+		}
+		envMft, err := calleeMft.ApplyEnv(envName)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override to service %s: %w", envName, name, err)
+		}
+		callees[name] = nil
+		if calleeNetwork := workloadNetwork(envMft); calleeNetwork != nil {
+			callees[name] = calleeNetwork.Connect
+		}
+	}
+	return callees, nil
+}
+
+func workloadNetwork(mft interface{}) *manifest.NetworkConfig {
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return t.Network
+	case *manifest.BackendService:
+		return t.Network
+	default:
+		return nil
+	}
 }
 
 func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.StackConfiguration, error) {
@@ -435,19 +719,19 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
-	rc, err := o.runtimeConfig(addonsURL)
+	rc, err := o.runtimeConfig(addonsURL, mft)
 	if err != nil {
 		return nil, err
 	}
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
-		if o.targetApp.RequiresDNSDelegation() {
+		if !aws.BoolValue(t.Internal) && (o.targetApp.RequiresDNSDelegation() || o.targetEnvironment.HasImportedCerts()) {
 			var appVersionGetter versionGetter
 			if appVersionGetter, err = o.newAppVersionGetter(o.appName); err != nil {
 				return nil, err
 			}
-			if err = validateAlias(aws.StringValue(t.Name), aws.StringValue(t.Alias), o.targetApp, o.envName, appVersionGetter); err != nil {
+			if err = validateAlias(aws.StringValue(t.Name), aws.StringValue(t.Alias), o.targetApp, o.targetEnvironment, appVersionGetter, o.certValidator); err != nil {
 				return nil, err
 			}
 			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
@@ -478,16 +762,30 @@ func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 		return err
 	}
 
-	if err := o.svcCFN.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	var out termprogress.FileWriter = os.Stderr
+	if o.hideStackProgress {
+		out = discardFileWriter{}
+	}
+	if err := o.svcCFN.DeployService(out, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
 		return fmt.Errorf("deploy service: %w", err)
 	}
 	return nil
 }
 
-func validateAlias(svcName, alias string, app *config.Application, envName string, appVersionGetter versionGetter) error {
+func validateAlias(svcName, alias string, app *config.Application, env *config.Environment, appVersionGetter versionGetter, certValidator aliasCertValidator) error {
+	if env.HasImportedCerts() {
+		if err := validateAliasWithImportedCerts(svcName, alias, app, env, certValidator); err != nil {
+			return err
+		}
+		if app.Domain == "" {
+			return nil
+		}
+		// Copilot still manages the A-records of the aliases of apps with a domain, so the alias must be in a hosted zone it manages.
+	}
 	if alias == "" {
 		return nil
 	}
+	envName := env.Name
 	if err := validateAppVersion(alias, app, appVersionGetter); err != nil {
 		log.Errorf(`Cannot deploy service %s because the application version is incompatible.
 To upgrade the application, please run %s first (see https://aws.github.io/copilot-cli/docs/credentials/#application-credentials).
@@ -525,6 +823,24 @@ To upgrade the application, please run %s first (see https://aws.github.io/copil
 	return fmt.Errorf("alias is not supported in hosted zones not managed by Copilot")
 }
 
+// validateAliasWithImportedCerts returns an error if the alias, or the default domain name of the service when there is no alias,
+// is not covered by the certificates imported to the environment.
+func validateAliasWithImportedCerts(svcName, alias string, app *config.Application, env *config.Environment, certValidator aliasCertValidator) error {
+	if alias == "" {
+		if app.Domain == "" {
+			log.Errorf(`Environment %s uses imported certificates and application %s does not have a domain.
+Please specify %s in the manifest of service %s.
+`, env.Name, app.Name, color.HighlightCode("http.alias"), svcName)
+			return fmt.Errorf("alias is required for service %s in environment %s with imported certificates", svcName, env.Name)
+		}
+		alias = fmt.Sprintf("%s.%s.%s.%s", svcName, env.Name, app.Name, app.Domain)
+	}
+	if err := certValidator.ValidateCertAliases([]string{alias}, env.CustomConfig.ImportCertARNs); err != nil {
+		return fmt.Errorf("validate alias against the imported certificates of environment %s: %w", env.Name, err)
+	}
+	return nil
+}
+
 func validateAppVersion(alias string, app *config.Application, appVersionGetter versionGetter) error {
 	appVersion, err := appVersionGetter.Version()
 	if err != nil {
@@ -593,9 +909,137 @@ func (o *deploySvcOpts) showSvcURI() error {
 	return nil
 }
 
+const (
+	envDeploymentStatusDeployed  = "deployed"
+	envDeploymentStatusFailed    = "failed"
+	envDeploymentStatusSkipped   = "skipped"
+	envDeploymentStatusCancelled = "cancelled"
+
+	envDeploymentSummaryMinCellWidth     = 20 // minimum number of characters in a cell of the summary table.
+	envDeploymentSummaryTabWidth         = 4  // number of characters in a tab.
+	envDeploymentSummaryCellPaddingWidth = 2  // number of padding characters added to a cell.
+)
+
+// envDeployment is the result of deploying a service to one of several environments.
+type envDeployment struct {
+	env    *config.Environment
+	status string
+	err    error
+}
+
+// deployInOrder deploys to each environment one after the other.
+// Once a deployment fails, the remaining environments are skipped.
+func deployInOrder(deployments []*envDeployment, deploy func(env *config.Environment) error) {
+	for _, d := range deployments {
+		if err := deploy(d.env); err != nil {
+			d.status, d.err = envDeploymentStatusFailed, err
+			return
+		}
+		d.status = envDeploymentStatusDeployed
+	}
+}
+
+// envDeploymentFuncs deploys a prepared environment, and cancels the deployment while it is in progress.
+type envDeploymentFuncs struct {
+	deploy func() error
+	cancel func() error
+}
+
+// deployInParallel prepares each environment one after the other, and then runs the deployments of all the
+// prepared environments at the same time.
+// If an environment fails to be prepared, none of the environments are deployed.
+// Once a deployment fails, the deployments that are still in progress are cancelled.
+func deployInParallel(deployments []*envDeployment, prepare func(env *config.Environment) (*envDeploymentFuncs, error)) {
+	var fns []*envDeploymentFuncs
+	for _, d := range deployments {
+		fn, err := prepare(d.env)
+		if err != nil {
+			d.status, d.err = envDeploymentStatusFailed, err
+			return
+		}
+		fns = append(fns, fn)
+	}
+
+	var mu sync.Mutex
+	inProgress := make(map[int]bool)
+	cancelled := make(map[int]bool)
+	cancelInProgress := func() {
+		mu.Lock()
+		var toCancel []int
+		for i := range inProgress {
+			if !cancelled[i] {
+				cancelled[i] = true
+				toCancel = append(toCancel, i)
+			}
+		}
+		mu.Unlock()
+		for _, i := range toCancel {
+			if err := fns[i].cancel(); err != nil {
+				log.Warningf("Couldn't cancel the deployment to environment %s: %v\n", deployments[i].env.Name, err)
+			}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range fns {
+		inProgress[i] = true
+	}
+	for i := range fns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := fns[i].deploy()
+			mu.Lock()
+			delete(inProgress, i)
+			d := deployments[i]
+			switch {
+			case err == nil:
+				d.status = envDeploymentStatusDeployed
+			case cancelled[i]:
+				d.status, d.err = envDeploymentStatusCancelled, err
+			default:
+				d.status, d.err = envDeploymentStatusFailed, err
+			}
+			failed := d.status == envDeploymentStatusFailed
+			mu.Unlock()
+			if failed {
+				cancelInProgress()
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// discardFileWriter is a termprogress.FileWriter that drops everything written to it.
+type discardFileWriter struct{}
+
+func (discardFileWriter) Write(p []byte) (int, error) { return len(p), nil }
+func (discardFileWriter) Fd() uintptr                 { return os.Stderr.Fd() }
+
+// renderEnvDeploymentSummary returns a table of the status of the deployment to each environment
+// followed by the reason of each failed deployment.
+func renderEnvDeploymentSummary(deployments []*envDeployment) string {
+	var b strings.Builder
+	b.WriteString(color.Bold.Sprint("Summary\n\n"))
+	writer := tabwriter.NewWriter(&b, envDeploymentSummaryMinCellWidth, envDeploymentSummaryTabWidth, envDeploymentSummaryCellPaddingWidth, ' ', 0)
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Environment", "Region", "Status")
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "-----------", "------", "------")
+	for _, d := range deployments {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", d.env.Name, d.env.Region, d.status)
+	}
+	writer.Flush()
+	for _, d := range deployments {
+		if d.status == envDeploymentStatusFailed {
+			fmt.Fprintf(&b, "\n%s\n", log.Serrorf("Deployment to environment %s failed: %v", d.env.Name, d.err))
+		}
+	}
+	return b.String()
+}
+
 // buildSvcDeployCmd builds the `svc deploy` subcommand.
 func buildSvcDeployCmd() *cobra.Command {
 	vars := deployWkldVars{}
+	var parallel bool
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploys a service to an environment.",
@@ -603,6 +1047,10 @@ func buildSvcDeployCmd() *cobra.Command {
 		Example: `
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
+  Deploys a service to a "prod-us" and then a "prod-eu" environment.
+  /code $ copilot svc deploy --name frontend --env prod-us,prod-eu
+  Deploys a service to several environments at the same time.
+  /code $ copilot svc deploy --name frontend --env prod-us,prod-eu --parallel
   Deploys a service with additional resource tags.
   /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -610,6 +1058,7 @@ func buildSvcDeployCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			opts.parallel = parallel
 			if err := opts.Validate(); err != nil {
 				return err
 			}
@@ -624,9 +1073,10 @@ func buildSvcDeployCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
-	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", svcDeployEnvFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&parallel, parallelFlag, false, parallelFlagDescription)
 
 	return cmd
 }
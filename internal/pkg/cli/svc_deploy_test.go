@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -75,6 +76,36 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("get environment test configuration: unknown env"),
 		},
+		"with duplicated environments": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			inEnvName: "test, prod,test",
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").
+					Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").
+					Return(&config.Environment{Name: "prod"}, nil)
+			},
+
+			wantedError: errors.New("environment test is specified more than once"),
+		},
+		"successful validation with multiple environments": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			inEnvName: "test,prod",
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").
+					Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").
+					Return(&config.Environment{Name: "prod"}, nil)
+			},
+		},
 		"successful validation": {
 			inAppName: "phonetool",
 			inSvcName: "frontend",
@@ -122,6 +153,103 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 	}
 }
 
+func TestDeployInOrder(t *testing.T) {
+	// GIVEN
+	deployments := []*envDeployment{
+		{env: &config.Environment{Name: "test"}, status: envDeploymentStatusSkipped},
+		{env: &config.Environment{Name: "staging"}, status: envDeploymentStatusSkipped},
+		{env: &config.Environment{Name: "prod"}, status: envDeploymentStatusSkipped},
+	}
+	var deployed []string
+
+	// WHEN
+	deployInOrder(deployments, func(env *config.Environment) error {
+		deployed = append(deployed, env.Name)
+		if env.Name == "staging" {
+			return errors.New("some error")
+		}
+		return nil
+	})
+
+	// THEN
+	require.Equal(t, []string{"test", "staging"}, deployed)
+	require.Equal(t, envDeploymentStatusDeployed, deployments[0].status)
+	require.Equal(t, envDeploymentStatusFailed, deployments[1].status)
+	require.EqualError(t, deployments[1].err, "some error")
+	require.Equal(t, envDeploymentStatusSkipped, deployments[2].status)
+}
+
+func TestDeployInParallel(t *testing.T) {
+	testCases := map[string]struct {
+		failPrepare string
+		failDeploy  string
+
+		wantedStatus []string
+	}{
+		"deploys to every environment": {
+			wantedStatus: []string{envDeploymentStatusDeployed, envDeploymentStatusDeployed, envDeploymentStatusDeployed},
+		},
+		"does not deploy any environment if one fails to be prepared": {
+			failPrepare:  "staging",
+			wantedStatus: []string{envDeploymentStatusSkipped, envDeploymentStatusFailed, envDeploymentStatusSkipped},
+		},
+		"cancels the deployments in progress when one deployment fails": {
+			failDeploy:   "staging",
+			wantedStatus: []string{envDeploymentStatusCancelled, envDeploymentStatusFailed, envDeploymentStatusCancelled},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			deployments := []*envDeployment{
+				{env: &config.Environment{Name: "test"}, status: envDeploymentStatusSkipped},
+				{env: &config.Environment{Name: "staging"}, status: envDeploymentStatusSkipped},
+				{env: &config.Environment{Name: "prod"}, status: envDeploymentStatusSkipped},
+			}
+			var mu sync.Mutex
+			var deployed []string
+
+			// WHEN
+			deployInParallel(deployments, func(env *config.Environment) (*envDeploymentFuncs, error) {
+				if env.Name == tc.failPrepare {
+					return nil, errors.New("some error")
+				}
+				cancelled := make(chan struct{})
+				return &envDeploymentFuncs{
+					deploy: func() error {
+						mu.Lock()
+						deployed = append(deployed, env.Name)
+						mu.Unlock()
+						if tc.failDeploy == "" {
+							return nil
+						}
+						if env.Name == tc.failDeploy {
+							return errors.New("some error")
+						}
+						<-cancelled // Wait until the deployment is cancelled by the failed one.
+						return errors.New("update cancelled")
+					},
+					cancel: func() error {
+						close(cancelled)
+						return nil
+					},
+				}, nil
+			})
+
+			// THEN
+			var status []string
+			for _, d := range deployments {
+				status = append(status, d.status)
+			}
+			require.Equal(t, tc.wantedStatus, status)
+			if tc.failPrepare != "" {
+				require.Empty(t, deployed)
+			}
+		})
+	}
+}
+
 func TestSvcDeployOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName  string
@@ -222,13 +350,34 @@ type: 'Load Balanced Web Service'
 image:
   build:
     dockerfile: path/to/Dockerfile`)
+	mockMftBuildKit := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build:
+    dockerfile: path/to/Dockerfile
+    secrets:
+      npmrc:
+        file: .npmrc
+      github_token:
+        env: GITHUB_TOKEN
+    ssh:
+      - default
+    cache_to:
+      - type=registry,mode=max
+    labels:
+      team: payments
+    network: host`)
 
 	tests := map[string]struct {
-		inputSvc   string
-		setupMocks func(mocks deploySvcMocks)
+		inputSvc       string
+		inImageTag     string
+		inApp          *config.Application
+		inPushedImages map[string]*pushedImages
+		setupMocks     func(mocks deploySvcMocks)
 
-		wantErr      error
-		wantedDigest string
+		wantErr            error
+		wantedDigest       string
+		wantedPushedImages map[string]*pushedImages
 	}{
 		"should return error if ws ReadFile returns error": {
 			inputSvc: "serviceA",
@@ -312,6 +461,134 @@ image:
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
 		},
+		"should return error if the app has immutable image tags and the image has no tag": {
+			inputSvc: "serviceA",
+			inApp: &config.Application{
+				Name: "phonetool",
+				ImageRepository: &config.ImageRepositoryConfig{
+					ImmutableTags: true,
+				},
+			},
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: errors.New("application phonetool has immutable image tags: specify a unique tag with --tag or deploy from a git repository without uncommitted changes"),
+		},
+		"should not tag the image as latest if the app has immutable image tags": {
+			inputSvc:   "serviceA",
+			inImageTag: "g123bfc",
+			inApp: &config.Application{
+				Name: "phonetool",
+				ImageRepository: &config.ImageRepositoryConfig{
+					ImmutableTags: true,
+				},
+			},
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &exec.BuildArguments{
+						Dockerfile:    filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:       filepath.Join("/ws", "root", "path"),
+						Tags:          []string{"g123bfc"},
+						SkipLatestTag: true,
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should return error if the app has immutable image tags and the cache is exported to the image repository": {
+			inputSvc:   "serviceA",
+			inImageTag: "g123bfc",
+			inApp: &config.Application{
+				Name: "phonetool",
+				ImageRepository: &config.ImageRepositoryConfig{
+					ImmutableTags: true,
+				},
+			},
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftBuildKit, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: errors.New(`application phonetool has immutable image tags: specify a "ref" for the registry destinations of "cache_to"`),
+		},
+		"should reuse the images already pushed to the region of the environment": {
+			inputSvc: "serviceA",
+			inPushedImages: map[string]*pushedImages{
+				"us-west-2": {
+					imageDigest:   "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+					buildRequired: true,
+				},
+			},
+			setupMocks: func(m deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(gomock.Any()).Times(0)
+				m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			wantedPushedImages: map[string]*pushedImages{
+				"us-west-2": {
+					imageDigest:   "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+					buildRequired: true,
+				},
+			},
+		},
+		"should record the images pushed to the region of the environment": {
+			inputSvc: "serviceA",
+			inPushedImages: map[string]*pushedImages{
+				"eu-west-1": {
+					imageDigest:   "sha256:badbad",
+					buildRequired: true,
+				},
+			},
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			wantedPushedImages: map[string]*pushedImages{
+				"eu-west-1": {
+					imageDigest:   "sha256:badbad",
+					buildRequired: true,
+				},
+				"us-west-2": {
+					imageDigest:   "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+					buildRequired: true,
+				},
+			},
+		},
+		"with BuildKit options": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftBuildKit, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &exec.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Secrets: []exec.BuildSecret{
+							{ID: "github_token", Env: "GITHUB_TOKEN"},
+							{ID: "npmrc", File: filepath.Join("/ws", "root", ".npmrc")},
+						},
+						SSH:     []string{"default"},
+						CacheTo: []string{"type=registry,mode=max"},
+						Labels: map[string]string{
+							"team": "payments",
+						},
+						Network: "host",
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
 	}
 
 	for name, test := range tests {
@@ -326,13 +603,24 @@ image:
 				mockimageBuilderPusher: mockimageBuilderPusher,
 			}
 			test.setupMocks(mocks)
+			app := test.inApp
+			if app == nil {
+				app = &config.Application{Name: "phonetool"}
+			}
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					name: test.inputSvc,
+					name:     test.inputSvc,
+					imageTag: test.inImageTag,
+				},
+				targetApp: app,
+				targetEnvironment: &config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
 				ws:                 mockWorkspace,
+				pushedImages:       test.inPushedImages,
 			}
 
 			gotErr := opts.configureContainerImage()
@@ -342,6 +630,7 @@ image:
 			} else {
 				require.NoError(t, gotErr)
 				require.Equal(t, test.wantedDigest, opts.imageDigest)
+				require.Equal(t, test.wantedPushedImages, opts.pushedImages)
 			}
 		})
 	}
@@ -518,6 +807,7 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 		mockAppResourcesGetter func(m *mocks.MockappResourcesGetter)
 		mockAppVersionGetter   func(m *mocks.MockversionGetter)
 		mockEndpointGetter     func(m *mocks.MockendpointGetter)
+		mockCertValidator      func(m *mocks.MockaliasCertValidator)
 
 		wantErr error
 	}{
@@ -646,6 +936,131 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("alias is not supported in hosted zones not managed by Copilot"),
 		},
+		"fail to enable https without alias because the app has no domain for imported certificates": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: fmt.Errorf("alias is required for service mockSvc in environment mockEnv with imported certificates"),
+		},
+		"fail to enable https alias because it is not covered by the imported certificates": {
+			inAlias: "v1.example.com",
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			mockCertValidator: func(m *mocks.MockaliasCertValidator) {
+				m.EXPECT().ValidateCertAliases([]string{"v1.example.com"}, []string{"mockCertARN"}).Return(mockError)
+			},
+			wantErr: fmt.Errorf("validate alias against the imported certificates of environment mockEnv: %w", mockError),
+		},
+		"success with imported certificates": {
+			inAlias: "v1.example.com",
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			mockCertValidator: func(m *mocks.MockaliasCertValidator) {
+				m.EXPECT().ValidateCertAliases([]string{"v1.example.com"}, []string{"mockCertARN"}).Return(nil)
+			},
+		},
+		"fail to enable https alias of an app with a domain outside of its hosted zones with imported certificates": {
+			inAlias: "v1.example.com",
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter: func(m *mocks.MockversionGetter) {
+				m.EXPECT().Version().Return("v1.0.0", nil)
+			},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			mockCertValidator: func(m *mocks.MockaliasCertValidator) {
+				m.EXPECT().ValidateCertAliases([]string{"v1.example.com"}, []string{"mockCertARN"}).Return(nil)
+			},
+			wantErr: fmt.Errorf("alias is not supported in hosted zones not managed by Copilot"),
+		},
+		"success with imported certificates for an app with a domain": {
+			inAlias: "v1.mockDomain",
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter: func(m *mocks.MockversionGetter) {
+				m.EXPECT().Version().Return("v1.0.0", nil)
+			},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			mockCertValidator: func(m *mocks.MockaliasCertValidator) {
+				m.EXPECT().ValidateCertAliases([]string{"v1.mockDomain"}, []string{"mockCertARN"}).Return(nil)
+			},
+		},
 		"success": {
 			inAlias: "v1.mockDomain",
 			inEnvironment: &config.Environment{
@@ -682,6 +1097,10 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			tc.mockAppResourcesGetter(mockAppResourcesGetter)
 			tc.mockAppVersionGetter(mockAppVersionGetter)
 			tc.mockEndpointGetter(mockEndpointGetter)
+			mockCertValidator := mocks.NewMockaliasCertValidator(ctrl)
+			if tc.mockCertValidator != nil {
+				tc.mockCertValidator(mockCertValidator)
+			}
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
@@ -696,6 +1115,7 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 					return mockAppVersionGetter, nil
 				},
 				endpointGetter:    mockEndpointGetter,
+				certValidator:     mockCertValidator,
 				targetApp:         tc.inApp,
 				targetEnvironment: tc.inEnvironment,
 				unmarshal: func(b []byte) (manifest.WorkloadManifest, error) {
@@ -722,3 +1142,96 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func Test_connectCallees(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest interface{}
+		mockWs     func(m *mocks.MocksvcManifestReader)
+
+		wanted      map[string]*manifest.ConnectConfig
+		wantedError error
+	}{
+		"no callees if the service does not call other services": {
+			inManifest: &manifest.LoadBalancedWebService{},
+			mockWs:     func(m *mocks.MocksvcManifestReader) {},
+		},
+		"no callees for workloads without network connect": {
+			inManifest: &manifest.RequestDrivenWebService{},
+			mockWs:     func(m *mocks.MocksvcManifestReader) {},
+		},
+		"error if a callee manifest cannot be read": {
+			inManifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					Network: &manifest.NetworkConfig{
+						Connect: &manifest.ConnectConfig{
+							Services: []string{"api"},
+						},
+					},
+				},
+			},
+			mockWs: func(m *mocks.MocksvcManifestReader) {
+				m.EXPECT().ReadServiceManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read manifest of service api: some error"),
+		},
+		"returns the connect configuration of each callee in the environment": {
+			inManifest: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					Network: &manifest.NetworkConfig{
+						Connect: &manifest.ConnectConfig{
+							Services: []string{"api", "worker"},
+						},
+					},
+				},
+			},
+			mockWs: func(m *mocks.MocksvcManifestReader) {
+				m.EXPECT().ReadServiceManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+network:
+  connect: {}
+environments:
+  test:
+    network:
+      connect:
+        mesh: true
+`), nil)
+				m.EXPECT().ReadServiceManifest("worker").Return([]byte(`name: worker
+type: Backend Service
+image:
+  location: nginx
+`), nil)
+			},
+			wanted: map[string]*manifest.ConnectConfig{
+				"api": {
+					Mesh: manifest.ServiceMesh{
+						Enable: aws.Bool(true),
+					},
+				},
+				"worker": nil,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMocksvcManifestReader(ctrl)
+			tc.mockWs(mockWs)
+
+			// WHEN
+			got, err := connectCallees(mockWs, manifest.UnmarshalWorkload, "test", tc.inManifest)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
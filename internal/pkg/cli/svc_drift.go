@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDriftNamePrompt     = "Which service would you like to check for drift?"
+	svcDriftNameHelpPrompt = "Drift is detected on the service stack and on its addons."
+
+	fmtSvcDriftStart    = "Detecting drift for service %s in environment %s."
+	fmtSvcDriftFailed   = "Failed to detect drift for service %s in environment %s.\n"
+	fmtSvcDriftComplete = "Detected drift for service %s in environment %s.\n"
+)
+
+type svcDriftVars struct {
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	appName          string
+}
+
+type svcDriftOpts struct {
+	svcDriftVars
+
+	w                  io.Writer
+	store              store
+	describer          describer
+	sel                deploySelector
+	prog               progress
+	initDriftDescriber func() error
+}
+
+func newSvcDriftOpts(vars svcDriftVars) (*svcDriftOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcDriftOpts{
+		svcDriftVars: vars,
+		store:        configStore,
+		w:            log.OutputWriter,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	opts.initDriftDescriber = func() error {
+		d, err := describe.NewDriftDescriber(describe.NewDriftDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Workloads:   []string{opts.svcName},
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create drift describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcDriftOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcDriftOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute detects drift on the service stack and its addons, and writes the drifted resources.
+func (o *svcDriftOpts) Execute() error {
+	if err := o.initDriftDescriber(); err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcDriftStart, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	drift, err := o.describer.Describe()
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcDriftFailed, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+		return fmt.Errorf("detect drift for service %s: %w", o.svcName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcDriftComplete, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)))
+	if o.shouldOutputJSON {
+		data, err := drift.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, drift.HumanString())
+	}
+	return nil
+}
+
+func (o *svcDriftOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDriftOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcDriftNamePrompt, svcDriftNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcDriftCmd builds the command for detecting drift in a deployed service.
+func buildSvcDriftCmd() *cobra.Command {
+	vars := svcDriftVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects resources of a deployed service that were changed outside of Copilot.",
+		Long:  "Detects resources of a deployed service and of its addons that were changed outside of Copilot.",
+
+		Example: `
+  Shows the resources of the service "my-svc" in the environment "test" that drifted from their expected configuration.
+  /code $ copilot svc drift -n my-svc -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDrift_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp     string
+		mockSelector func(m *mocks.MockdeploySelector)
+
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcDriftNamePrompt, svcDriftNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select deployed services for application phonetool: some error"),
+		},
+		"success": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcDriftNamePrompt, svcDriftNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "test",
+						Svc: "frontend",
+					}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &svcDriftOpts{
+				svcDriftVars: svcDriftVars{
+					appName: tc.inputApp,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "frontend", opts.svcName)
+				require.Equal(t, "test", opts.envName)
+			}
+		})
+	}
+}
+
+func TestSvcDrift_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(d *mocks.Mockdescriber, p *mocks.Mockprogress)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to detect drift": {
+			setupMocks: func(d *mocks.Mockdescriber, p *mocks.Mockprogress) {
+				p.EXPECT().Start(gomock.Any())
+				d.EXPECT().Describe().Return(nil, errors.New("some error"))
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: fmt.Errorf("detect drift for service frontend: some error"),
+		},
+		"writes the drift": {
+			setupMocks: func(d *mocks.Mockdescriber, p *mocks.Mockprogress) {
+				p.EXPECT().Start(gomock.Any())
+				d.EXPECT().Describe().Return(&describe.DriftDescription{
+					Stacks: []*describe.StackDrift{
+						{
+							Name: "phonetool-test-frontend",
+							Resources: []*stack.ResourceDrift{
+								{
+									Resource:  stack.Resource{Type: "AWS::Logs::LogGroup"},
+									LogicalID: "LogGroup",
+									Status:    "DELETED",
+								},
+							},
+						},
+					},
+				}, nil)
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedContent: "phonetool-test-frontend\n\n  DELETED LogGroup (AWS::Logs::LogGroup)\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockdescriber(ctrl)
+			mockProgress := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockDescriber, mockProgress)
+			b := &bytes.Buffer{}
+			opts := &svcDriftOpts{
+				svcDriftVars: svcDriftVars{
+					appName: "phonetool",
+					envName: "test",
+					svcName: "frontend",
+				},
+				w:                  b,
+				describer:          mockDescriber,
+				prog:               mockProgress,
+				initDriftDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
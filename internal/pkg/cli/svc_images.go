@@ -0,0 +1,189 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcImagesNamePrompt     = "Which service's images would you like to list?"
+	svcImagesNameHelpPrompt = "The images pushed to the ECR repository of the service will be listed."
+	svcImagesEnvNamePrompt  = "Which environment's region would you like to list the images from?"
+	svcImagesEnvHelpPrompt  = "Copilot creates an ECR repository for your service in each region where you have an environment."
+)
+
+type svcImagesVars struct {
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	appName          string
+}
+
+type svcImagesOpts struct {
+	svcImagesVars
+
+	w                   io.Writer
+	store               store
+	describer           describer
+	sel                 configSelector
+	initImagesDescriber func() error
+}
+
+func newSvcImagesOpts(vars svcImagesVars) (*svcImagesOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	opts := &svcImagesOpts{
+		svcImagesVars: vars,
+		store:         configStore,
+		w:             log.OutputWriter,
+		sel:           selector.NewConfigSelect(prompt.New(), configStore),
+	}
+	opts.initImagesDescriber = func() error {
+		d, err := describe.NewImagesDescriber(describe.NewImagesDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create images describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcImagesOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcImagesOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askSvcName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute writes the images pushed to the ECR repository of the service.
+func (o *svcImagesOpts) Execute() error {
+	if err := o.initImagesDescriber(); err != nil {
+		return err
+	}
+	images, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("list images of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := images.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, images.HumanString())
+	}
+	return nil
+}
+
+func (o *svcImagesOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcImagesOpts) askSvcName() error {
+	if o.svcName != "" {
+		return nil
+	}
+	svc, err := o.sel.Service(svcImagesNamePrompt, svcImagesNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select service for application %s: %w", o.appName, err)
+	}
+	o.svcName = svc
+	return nil
+}
+
+func (o *svcImagesOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(svcImagesEnvNamePrompt, svcImagesEnvHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.envName = env
+	return nil
+}
+
+// buildSvcImagesCmd builds the command for listing the images of a service.
+func buildSvcImagesCmd() *cobra.Command {
+	vars := svcImagesVars{}
+	cmd := &cobra.Command{
+		Use:   "images",
+		Short: "Lists the container images pushed for a service.",
+		Long:  "Lists the container images pushed to the ECR repository of a service, with their tags, digests, push times and scan findings.",
+
+		Example: `
+  Lists the images of the service "my-svc" in the region of the environment "test".
+  /code $ copilot svc images -n my-svc -e test
+  Lists the images in JSON format.
+  /code $ copilot svc images -n my-svc -e test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcImagesOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
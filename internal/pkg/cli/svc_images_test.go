@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcImages_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp     string
+		inputSvc     string
+		inputEnv     string
+		mockSelector func(m *mocks.MockconfigSelector)
+
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select service": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Service(svcImagesNamePrompt, svcImagesNameHelpPrompt, "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select service for application phonetool: some error"),
+		},
+		"errors if failed to select environment": {
+			inputApp: "phonetool",
+			inputSvc: "frontend",
+			mockSelector: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(svcImagesEnvNamePrompt, svcImagesEnvHelpPrompt, "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select environment for application phonetool: some error"),
+		},
+		"success": {
+			inputApp: "phonetool",
+			mockSelector: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Service(svcImagesNamePrompt, svcImagesNameHelpPrompt, "phonetool").Return("frontend", nil)
+				m.EXPECT().Environment(svcImagesEnvNamePrompt, svcImagesEnvHelpPrompt, "phonetool").Return("test", nil)
+			},
+		},
+		"does not prompt if the flags are set": {
+			inputApp:     "phonetool",
+			inputSvc:     "frontend",
+			inputEnv:     "test",
+			mockSelector: func(m *mocks.MockconfigSelector) {},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockconfigSelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &svcImagesOpts{
+				svcImagesVars: svcImagesVars{
+					appName: tc.inputApp,
+					svcName: tc.inputSvc,
+					envName: tc.inputEnv,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "frontend", opts.svcName)
+				require.Equal(t, "test", opts.envName)
+			}
+		})
+	}
+}
+
+func TestSvcImages_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(d *mocks.Mockdescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to list the images": {
+			setupMocks: func(d *mocks.Mockdescriber) {
+				d.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("list images of service frontend: some error"),
+		},
+		"writes the images in JSON format": {
+			shouldOutputJSON: true,
+			setupMocks: func(d *mocks.Mockdescriber) {
+				d.EXPECT().Describe().Return(&describe.ImagesDescription{
+					Repository: "phonetool/frontend",
+				}, nil)
+			},
+			wantedContent: "{\"repository\":\"phonetool/frontend\",\"images\":null}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockdescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+			b := &bytes.Buffer{}
+			opts := &svcImagesOpts{
+				svcImagesVars: svcImagesVars{
+					appName:          "phonetool",
+					envName:          "test",
+					svcName:          "frontend",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:                   b,
+				describer:           mockDescriber,
+				initImagesDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -57,6 +57,12 @@ Deployed resources (such as your ECR repository, logs) will contain this %[1]s's
 	fmtWkldInitDockerfilePathPrompt  = "What is the path to the " + color.Emphasize("Dockerfile") + " for %s?"
 	wkldInitDockerfilePathHelpPrompt = "Path to Dockerfile to use for building your container image."
 
+	fmtSvcInitBuildFromSourcePrompt  = "Would you like App Runner to build %s from a " + color.Emphasize("source code repository") + " instead of a Dockerfile or an image?"
+	svcInitBuildFromSourceHelpPrompt = `App Runner can build and deploy your service directly from a source code repository
+with a managed runtime for Python, Node.js or Java, so that you don't need to maintain a Dockerfile.`
+	svcInitSourceRepoPrompt     = "What is the URL of the " + color.Emphasize("source code repository") + "?"
+	svcInitSourceRepoHelpPrompt = "URL of the repository that App Runner builds your service from, for example https://github.com/user/repo."
+
 	svcInitSvcPortPrompt     = "Which %s do you want customer traffic sent to?"
 	svcInitSvcPortHelpPrompt = `The port will be used by the load balancer to route incoming traffic to this service.
 You should set this to the port which your Dockerfile uses to communicate with the internet.`
@@ -79,7 +85,8 @@ type initWkldVars struct {
 type initSvcVars struct {
 	initWkldVars
 
-	port uint16
+	port       uint16
+	sourceRepo string
 }
 
 type initSvcOpts struct {
@@ -137,7 +144,7 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 		init:         initSvc,
 		prompt:       prompter,
 		sel:          sel,
-		dockerEngine: exec.NewDockerCommand(),
+		dockerEngine: newDockerEngine(),
 	}
 	opts.dockerfile = func(path string) dockerfileParser {
 		if opts.df != nil {
@@ -177,6 +184,11 @@ func (o *initSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.sourceRepo != "" {
+		if err := o.validateSourceRepo(); err != nil {
+			return err
+		}
+	}
 	if o.image != "" && o.wkldType == manifest.RequestDrivenWebServiceType {
 		if err := validateAppRunnerImage(o.image); err != nil {
 			return err
@@ -193,6 +205,16 @@ func (o *initSvcOpts) Ask() error {
 	if err := o.askSvcName(); err != nil {
 		return err
 	}
+	if err := o.askSourceRepo(); err != nil {
+		return err
+	}
+	if o.sourceRepo != "" {
+		// App Runner builds the service from source, so there is no Dockerfile or image to ask for.
+		if o.wkldType != manifest.RequestDrivenWebServiceType {
+			return fmt.Errorf("--%s can only be used with a %s", sourceRepoFlag, manifest.RequestDrivenWebServiceType)
+		}
+		return o.askSvcPort()
+	}
 	dfSelected, err := o.askDockerfile()
 	if err != nil {
 		return err
@@ -223,9 +245,11 @@ func (o *initSvcOpts) Execute() error {
 		}
 	}
 
-	o.os, o.arch, err = dockerPlatform(o.dockerEngine, o.image)
-	if err != nil {
-		return err
+	if o.sourceRepo == "" {
+		o.os, o.arch, err = dockerPlatform(o.dockerEngine, o.image)
+		if err != nil {
+			return err
+		}
 	}
 
 	manifestPath, err := o.init.Service(&initialize.ServiceProps{
@@ -240,8 +264,9 @@ func (o *initSvcOpts) Execute() error {
 				Arch: o.arch,
 			},
 		},
-		Port:        o.port,
-		HealthCheck: hc,
+		Port:             o.port,
+		HealthCheck:      hc,
+		SourceRepository: o.sourceRepo,
 	})
 	if err != nil {
 		return err
@@ -252,14 +277,30 @@ func (o *initSvcOpts) Execute() error {
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initSvcOpts) RecommendedActions() []string {
+	update := fmt.Sprintf("Update your manifest %s to change the defaults.", color.HighlightResource(o.manifestPath))
+	if o.sourceRepo != "" {
+		update = fmt.Sprintf("Set the %s, %s and %s of %s in your manifest %s.",
+			color.HighlightCode("connection"), color.HighlightCode("runtime"), color.HighlightCode("start_command"),
+			color.HighlightCode("image.source"), color.HighlightResource(o.manifestPath))
+	}
 	return []string{
-		fmt.Sprintf("Update your manifest %s to change the defaults.", color.HighlightResource(o.manifestPath)),
+		update,
 		fmt.Sprintf("Run %s to deploy your service to a %s environment.",
 			color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s --env %s", o.name, defaultEnvironmentName)),
 			defaultEnvironmentName),
 	}
 }
 
+func (o *initSvcOpts) validateSourceRepo() error {
+	if o.dockerfilePath != "" || o.image != "" {
+		return fmt.Errorf("--%s cannot be specified with --%s or --%s", sourceRepoFlag, dockerFileFlag, imageFlag)
+	}
+	if o.wkldType != "" && o.wkldType != manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("--%s can only be used with a %s", sourceRepoFlag, manifest.RequestDrivenWebServiceType)
+	}
+	return validateSourceRepoURL(o.sourceRepo)
+}
+
 func (o *initSvcOpts) askSvcType() error {
 	if o.wkldType != "" {
 		return nil
@@ -299,6 +340,34 @@ func (o *initSvcOpts) askSvcName() error {
 	return nil
 }
 
+// askSourceRepo asks whether App Runner should build a Request-Driven Web Service from a source code repository
+// when neither a Dockerfile, an image nor a repository is specified.
+func (o *initSvcOpts) askSourceRepo() error {
+	if o.wkldType != manifest.RequestDrivenWebServiceType || o.sourceRepo != "" || o.dockerfilePath != "" || o.image != "" {
+		return nil
+	}
+	fromSource, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSvcInitBuildFromSourcePrompt, color.HighlightUserInput(o.name)),
+		svcInitBuildFromSourceHelpPrompt,
+		prompt.WithFinalMessage("Build from source:"))
+	if err != nil {
+		return fmt.Errorf("confirm building from source: %w", err)
+	}
+	if !fromSource {
+		return nil
+	}
+	repo, err := o.prompt.Get(
+		svcInitSourceRepoPrompt,
+		svcInitSourceRepoHelpPrompt,
+		validateSourceRepoURL,
+		prompt.WithFinalMessage("Source repository:"))
+	if err != nil {
+		return fmt.Errorf("get source repository: %w", err)
+	}
+	o.sourceRepo = repo
+	return nil
+}
+
 func (o *initSvcOpts) askImage() error {
 	if o.image != "" {
 		return nil
@@ -470,6 +539,9 @@ This command is also run as part of "copilot init".`,
   Create a "frontend" load balanced web service.
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
+  Create a "api" request-driven web service that App Runner builds from source.
+  /code $ copilot svc init --name api --svc-type "Request-Driven Web Service" --source-repo https://github.com/user/repo
+
   Create a "subscribers" backend service.
   /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -500,5 +572,6 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().StringVar(&vars.sourceRepo, sourceRepoFlag, "", sourceRepoFlagDescription)
 	return cmd
 }
@@ -28,6 +28,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inImage          string
 		inAppName        string
 		inSvcPort        uint16
+		inSourceRepo     string
 
 		mockFileSystem func(mockFS afero.Fs)
 		wantedErr      error
@@ -54,6 +55,24 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			inSvcType: manifest.RequestDrivenWebServiceType,
 			wantedErr: fmt.Errorf("image amazon/amazon-ecs-sample is not supported by App Runner: value must be an ECR or ECR Public image URI"),
 		},
+		"fail if source repo is set with an image": {
+			inAppName:    "phonetool",
+			inImage:      "mockImage",
+			inSourceRepo: "https://github.com/user/repo",
+			wantedErr:    fmt.Errorf("--source-repo cannot be specified with --dockerfile or --image"),
+		},
+		"fail if source repo is set for a service not on App Runner": {
+			inAppName:    "phonetool",
+			inSvcType:    manifest.BackendServiceType,
+			inSourceRepo: "https://github.com/user/repo",
+			wantedErr:    fmt.Errorf("--source-repo can only be used with a Request-Driven Web Service"),
+		},
+		"fail if source repo is not an https URL": {
+			inAppName:    "phonetool",
+			inSvcType:    manifest.RequestDrivenWebServiceType,
+			inSourceRepo: "git@github.com:user/repo.git",
+			wantedErr:    fmt.Errorf("source repository git@github.com:user/repo.git must be an https URL"),
+		},
 		"invalid dockerfile directory path": {
 			inAppName:        "phonetool",
 			inDockerfilePath: "./hello/Dockerfile",
@@ -88,7 +107,8 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 						image:          tc.inImage,
 						appName:        tc.inAppName,
 					},
-					port: tc.inSvcPort,
+					port:       tc.inSvcPort,
+					sourceRepo: tc.inSourceRepo,
 				},
 				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
 			}
@@ -123,13 +143,15 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 		inDockerfilePath string
 		inImage          string
 		inSvcPort        uint16
+		inSourceRepo     string
 
 		mockPrompt       func(m *mocks.Mockprompter)
 		mockSel          func(m *mocks.MockdockerfileSelector)
 		mockDockerfile   func(m *mocks.MockdockerfileParser)
 		mockDockerEngine func(m *mocks.MockdockerEngine)
 
-		wantedErr error
+		wantedSourceRepo string
+		wantedErr        error
 	}{
 		"prompt for service type": {
 			inSvcType:        "",
@@ -174,6 +196,89 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
 			wantedErr:        fmt.Errorf("select service type: some error"),
 		},
+		"skip prompting for a Dockerfile or an image when building from source": {
+			inSvcType:    manifest.RequestDrivenWebServiceType,
+			inSvcName:    wantedSvcName,
+			inSvcPort:    wantedSvcPort,
+			inSourceRepo: "https://github.com/user/repo",
+
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+		},
+		"prompt for the source code repository of a Request-Driven Web Service": {
+			inSvcType: manifest.RequestDrivenWebServiceType,
+			inSvcName: wantedSvcName,
+			inSvcPort: wantedSvcPort,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtSvcInitBuildFromSourcePrompt, wantedSvcName), svcInitBuildFromSourceHelpPrompt, gomock.Any()).
+					Return(true, nil)
+				m.EXPECT().Get(svcInitSourceRepoPrompt, svcInitSourceRepoHelpPrompt, gomock.Any(), gomock.Any()).
+					Return("https://github.com/user/repo", nil)
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedSourceRepo: "https://github.com/user/repo",
+		},
+		"prompt for a Dockerfile if a Request-Driven Web Service is not built from source": {
+			inSvcType: manifest.RequestDrivenWebServiceType,
+			inSvcName: wantedSvcName,
+			inSvcPort: wantedSvcPort,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {},
+			mockSel: func(m *mocks.MockdockerfileSelector) {
+				m.EXPECT().Dockerfile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(wantedDockerfilePath, nil)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {
+				m.EXPECT().CheckDockerEngineRunning().Return(nil)
+			},
+		},
+		"return an error if fail to confirm building from source": {
+			inSvcType: manifest.RequestDrivenWebServiceType,
+			inSvcName: wantedSvcName,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        fmt.Errorf("confirm building from source: some error"),
+		},
+		"return an error if fail to get the source code repository": {
+			inSvcType: manifest.RequestDrivenWebServiceType,
+			inSvcName: wantedSvcName,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(svcInitSourceRepoPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        fmt.Errorf("get source repository: some error"),
+		},
+		"error if the selected service type can't be built from source": {
+			inSvcName:    wantedSvcName,
+			inSvcPort:    wantedSvcPort,
+			inSourceRepo: "https://github.com/user/repo",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(manifest.BackendServiceType, nil)
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        fmt.Errorf("--source-repo can only be used with a Request-Driven Web Service"),
+		},
 		"prompt for service name": {
 			inSvcType:        wantedSvcType,
 			inSvcName:        "",
@@ -463,7 +568,8 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 						image:          tc.inImage,
 						dockerfilePath: tc.inDockerfilePath,
 					},
-					port: tc.inSvcPort,
+					port:       tc.inSvcPort,
+					sourceRepo: tc.inSourceRepo,
 				},
 				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
 				dockerfile: func(s string) dockerfileParser {
@@ -494,6 +600,9 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 				if opts.image != "" {
 					require.Equal(t, wantedImage, opts.image)
 				}
+				if tc.wantedSourceRepo != "" {
+					require.Equal(t, tc.wantedSourceRepo, opts.sourceRepo)
+				}
 			}
 		})
 	}
@@ -510,6 +619,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 		inDockerfilePath string
 		inImage          string
 		inAppName        string
+		inSourceRepo     string
 
 		wantedErr          error
 		wantedManifestPath string
@@ -625,6 +735,29 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			wantedManifestPath: "manifest/path",
 		},
+		"doesn't detect the platform when building from source": {
+			inAppName:    "sample",
+			inSvcName:    "api",
+			inSvcType:    manifest.RequestDrivenWebServiceType,
+			inSvcPort:    8080,
+			inSourceRepo: "https://github.com/user/repo",
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:      "sample",
+						Name:     "api",
+						Type:     "Request-Driven Web Service",
+						Platform: &manifest.PlatformConfig{},
+					},
+					Port:             8080,
+					SourceRepository: "https://github.com/user/repo",
+				}).Return("manifest/path", nil)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {}, // Be sure that Docker isn't needed.
+
+			wantedManifestPath: "manifest/path",
+		},
 		"return error if OS/arch detection fails": {
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("", "", mockError)
@@ -670,7 +803,8 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 						dockerfilePath: tc.inDockerfilePath,
 						image:          tc.inImage,
 					},
-					port: tc.inSvcPort,
+					port:       tc.inSvcPort,
+					sourceRepo: tc.inSourceRepo,
 				},
 				init: mockSvcInitializer,
 				dockerfile: func(s string) dockerfileParser {
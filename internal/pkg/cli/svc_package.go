@@ -20,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -110,8 +111,12 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		var serializer stackSerializer
 		switch t := mft.(type) {
 		case *manifest.LoadBalancedWebService:
-			if app.RequiresDNSDelegation() {
-				if err := validateAlias(aws.StringValue(t.Name), aws.StringValue(t.Alias), app, env.Name, appVersionGetter); err != nil {
+			if !aws.BoolValue(t.Internal) && (app.RequiresDNSDelegation() || env.HasImportedCerts()) {
+				envSess, err := p.FromRole(env.ManagerRoleARN, env.Region)
+				if err != nil {
+					return nil, fmt.Errorf("assume environment manager role: %w", err)
+				}
+				if err := validateAlias(aws.StringValue(t.Name), aws.StringValue(t.Alias), app, env, appVersionGetter, acm.New(envSess)); err != nil {
 					return nil, err
 				}
 				serializer, err = stack.NewHTTPSLoadBalancedWebService(t, env.Name, app.Name, rc)
@@ -305,12 +310,21 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
+	callees, err := connectCallees(o.ws, manifest.UnmarshalWorkload, o.envName, envMft)
+	if err != nil {
+		return nil, err
+	}
 	rc := stack.RuntimeConfig{
 		AdditionalTags:           app.Tags,
 		ServiceDiscoveryEndpoint: endpoint,
+		ConnectCallees:           callees,
 	}
 
-	if imgNeedsBuild {
+	sidecarBuilds, err := manifest.SidecarBuilds(envMft)
+	if err != nil {
+		return nil, err
+	}
+	if imgNeedsBuild || len(sidecarBuilds) > 0 {
 		resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
 		if err != nil {
 			return nil, err
@@ -323,9 +337,21 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 				appAccountID: app.AccountID,
 			}
 		}
-		rc.Image = &stack.ECRImage{
-			RepoURL:  repoURL,
-			ImageTag: o.tag,
+		if imgNeedsBuild {
+			rc.Image = &stack.ECRImage{
+				RepoURL:  repoURL,
+				ImageTag: o.tag,
+			}
+		}
+		// The images of the sidecars are pushed with the same tag as the image of the main container.
+		if len(sidecarBuilds) > 0 {
+			rc.SidecarImages = make(map[string]stack.ECRImage, len(sidecarBuilds))
+		}
+		for name := range sidecarBuilds {
+			rc.SidecarImages[name] = stack.ECRImage{
+				RepoURL:  fmt.Sprintf("%s/%s", repoURL, name),
+				ImageTag: o.tag,
+			}
 		}
 	}
 	serializer, err := o.stackSerializer(envMft, env, app, rc)
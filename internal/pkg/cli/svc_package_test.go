@@ -296,6 +296,85 @@ count: 1`), nil)
 				}
 			},
 
+			wantedStack:  "mystack",
+			wantedParams: "myparams",
+		},
+		"writes the images of the sidecars built from a Dockerfile": {
+			inVars: packageSvcVars{
+				appName: "ecs-kudos",
+				name:    "api",
+				envName: "test",
+				tag:     "1234",
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().
+					GetEnvironment("ecs-kudos", "test").
+					Return(&config.Environment{
+						App:    "ecs-kudos",
+						Name:   "test",
+						Region: "us-west-2",
+					}, nil)
+				mockApp := &config.Application{
+					Name: "ecs-kudos",
+				}
+				mockStore.EXPECT().
+					GetApplication("ecs-kudos").
+					Return(mockApp, nil)
+
+				mockWs := mocks.NewMockwsSvcReader(ctrl)
+				mockWs.EXPECT().
+					ReadServiceManifest("api").
+					Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+sidecars:
+  proxy:
+    image:
+      build: proxy/Dockerfile`), nil)
+
+				mockCfn := mocks.NewMockappResourcesGetter(ctrl)
+				mockCfn.EXPECT().
+					GetAppResourcesByRegion(mockApp, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						RepositoryURLs: map[string]string{
+							"api": "some url",
+						},
+					}, nil)
+
+				mockAddons := mocks.NewMocktemplater(ctrl)
+				mockAddons.EXPECT().Template().
+					Return("", &addon.ErrAddonsNotFound{})
+
+				opts.store = mockStore
+				opts.ws = mockWs
+				opts.appCFN = mockCfn
+				opts.initAddonsClient = func(opts *packageSvcOpts) error {
+					opts.addonsClient = mockAddons
+					return nil
+				}
+				opts.stackSerializer = func(_ interface{}, _ *config.Environment, _ *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
+					require.Nil(t, rc.Image)
+					require.Equal(t, map[string]stack.ECRImage{
+						"proxy": {
+							RepoURL:  "some url/proxy",
+							ImageTag: "1234",
+						},
+					}, rc.SidecarImages)
+					mockStackSerializer := mocks.NewMockstackSerializer(ctrl)
+					mockStackSerializer.EXPECT().Template().Return("mystack", nil)
+					mockStackSerializer.EXPECT().SerializedParameters().Return("myparams", nil)
+					return mockStackSerializer, nil
+				}
+				opts.newEndpointGetter = func(app, env string) (endpointGetter, error) {
+					mockendpointGetter := mocks.NewMockendpointGetter(ctrl)
+					mockendpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(fmt.Sprintf("%s.%s.local", env, app), nil)
+					return mockendpointGetter, nil
+				}
+			},
+
 			wantedStack:  "mystack",
 			wantedParams: "myparams",
 		},
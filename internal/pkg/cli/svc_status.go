@@ -4,14 +4,19 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
@@ -24,6 +29,7 @@ const (
 
 type svcStatusVars struct {
 	shouldOutputJSON bool
+	watch            bool
 	svcName          string
 	envName          string
 	appName          string
@@ -37,6 +43,7 @@ type svcStatusOpts struct {
 	statusDescriber     statusDescriber
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
+	render              func(ctx context.Context, r termprogress.DynamicRenderer) error
 }
 
 func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
@@ -53,6 +60,9 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 		store:         configStore,
 		w:             log.OutputWriter,
 		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		render: func(ctx context.Context, r termprogress.DynamicRenderer) error {
+			return termprogress.Render(ctx, termprogress.NewTabbedFileWriter(os.Stdout), r)
+		},
 		initStatusDescriber: func(o *svcStatusOpts) error {
 			wkld, err := configStore.GetWorkload(o.appName, o.svcName)
 			if err != nil {
@@ -88,6 +98,9 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *svcStatusOpts) Validate() error {
+	if o.watch && o.shouldOutputJSON {
+		return fmt.Errorf("cannot specify both --%s and --%s", watchFlag, jsonFlag)
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -120,6 +133,9 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.watch {
+		return o.watchStatus()
+	}
 	svcStatus, err := o.statusDescriber.Describe()
 	if err != nil {
 		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
@@ -137,6 +153,31 @@ func (o *svcStatusOpts) Execute() error {
 	return nil
 }
 
+// watchStatus refreshes the status of the service in place until the user interrupts it.
+func (o *svcStatusOpts) watchStatus() error {
+	watcher, err := describe.NewServiceStatusWatcher(o.statusDescriber)
+	if err != nil {
+		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	go watcher.Watch(ctx)
+	if err := o.render(ctx, watcher); err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("watch status of service %s: %w", o.svcName, err)
+	}
+	return nil
+}
+
 func (o *svcStatusOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -169,7 +210,9 @@ func buildSvcStatusCmd() *cobra.Command {
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Refreshes the status of "my-svc" in the "test" environment until interrupted.
+  /code $ copilot svc status -n my-svc -e test --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -188,5 +231,6 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, watchFlagDescription)
 	return cmd
 }
@@ -5,12 +5,15 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	describemocks "github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -21,10 +24,20 @@ func TestSvcStatus_Validate(t *testing.T) {
 		inputApp         string
 		inputSvc         string
 		inputEnvironment string
+		inputWatch       bool
+		inputJSON        bool
 		mockStoreReader  func(m *mocks.Mockstore)
 
 		wantedError error
 	}{
+		"cannot watch the status in JSON": {
+			inputWatch: true,
+			inputJSON:  true,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --watch and --json"),
+		},
 		"invalid app name": {
 			inputApp: "my-app",
 
@@ -89,9 +102,11 @@ func TestSvcStatus_Validate(t *testing.T) {
 
 			svcStatus := &svcStatusOpts{
 				svcStatusVars: svcStatusVars{
-					svcName: tc.inputSvc,
-					envName: tc.inputEnvironment,
-					appName: tc.inputApp,
+					svcName:          tc.inputSvc,
+					envName:          tc.inputEnvironment,
+					appName:          tc.inputApp,
+					watch:            tc.inputWatch,
+					shouldOutputJSON: tc.inputJSON,
 				},
 				store: mockStoreReader,
 			}
@@ -185,15 +200,47 @@ func TestSvcStatus_Execute(t *testing.T) {
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputJSON    bool
-		mockStatusDescriber func(m *mocks.MockstatusDescriber)
+		watch               bool
+		mockStatusDescriber func(m *mocks.MockstatusDescriber, status *describemocks.MockHumanJSONStringer)
+		render              func(ctx context.Context, r termprogress.DynamicRenderer) error
 		wantedError         error
 	}{
 		"errors if failed to describe the status of the service": {
-			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, _ *describemocks.MockHumanJSONStringer) {
+				m.EXPECT().Describe().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe status of service mockSvc: some error"),
+		},
+		"errors if failed to describe the status of the service before watching it": {
+			watch: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, _ *describemocks.MockHumanJSONStringer) {
 				m.EXPECT().Describe().Return(nil, mockError)
 			},
 			wantedError: fmt.Errorf("describe status of service mockSvc: some error"),
 		},
+		"errors if failed to render the status while watching it": {
+			watch: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, status *describemocks.MockHumanJSONStringer) {
+				m.EXPECT().Describe().Return(status, nil)
+			},
+			render: func(ctx context.Context, r termprogress.DynamicRenderer) error {
+				return mockError
+			},
+			wantedError: fmt.Errorf("watch status of service mockSvc: some error"),
+		},
+		"success": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, status *describemocks.MockHumanJSONStringer) {
+				m.EXPECT().Describe().Return(status, nil)
+				status.EXPECT().HumanString().Return("some status\n")
+			},
+		},
+		"stops watching the status without error when interrupted": {
+			watch: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, status *describemocks.MockHumanJSONStringer) {
+				m.EXPECT().Describe().Return(status, nil)
+				status.EXPECT().HumanString().Return("some status\n")
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -202,17 +249,28 @@ func TestSvcStatus_Execute(t *testing.T) {
 
 			b := &bytes.Buffer{}
 			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
-			tc.mockStatusDescriber(mockStatusDescriber)
+			tc.mockStatusDescriber(mockStatusDescriber, describemocks.NewMockHumanJSONStringer(ctrl))
+			render := tc.render
+			if render == nil {
+				render = func(ctx context.Context, r termprogress.DynamicRenderer) error {
+					if _, err := r.Render(b); err != nil {
+						return err
+					}
+					return context.Canceled
+				}
+			}
 
 			svcStatus := &svcStatusOpts{
 				svcStatusVars: svcStatusVars{
 					svcName:          "mockSvc",
 					envName:          "mockEnv",
 					shouldOutputJSON: tc.shouldOutputJSON,
+					watch:            tc.watch,
 					appName:          "mockApp",
 				},
 				statusDescriber:     mockStatusDescriber,
 				initStatusDescriber: func(*svcStatusOpts) error { return nil },
+				render:              render,
 				w:                   b,
 			}
 
@@ -224,7 +282,7 @@ func TestSvcStatus_Execute(t *testing.T) {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.NotEmpty(t, b.String(), "expected output content to not be empty")
+				require.Contains(t, b.String(), "some status")
 			}
 		})
 	}
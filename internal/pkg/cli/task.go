@@ -17,8 +17,10 @@ func BuildTaskCmd() *cobra.Command {
 One-off Amazon ECS tasks that terminate once their work is done.`,
 	}
 
+	cmd.AddCommand(buildTaskInitCmd())
 	cmd.AddCommand(BuildTaskRunCmd())
 	cmd.AddCommand(buildTaskExecCmd())
+	cmd.AddCommand(buildTaskListCmd())
 	cmd.AddCommand(BuildTaskDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
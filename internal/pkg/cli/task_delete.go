@@ -32,7 +32,7 @@ const (
 	taskDeleteEnvPrompt               = "Which environment would you like to delete a task from?"
 	fmtTaskDeleteDefaultConfirmPrompt = "Are you sure you want to delete %s from the default cluster?"
 	fmtTaskDeleteFromEnvConfirmPrompt = "Are you sure you want to delete %s from application %s and environment %s?"
-	taskDeleteConfirmHelp             = "This will delete the task's stack and stop all current executions."
+	taskDeleteConfirmHelp             = "This will delete the task's stack and schedule, and stop all current executions."
 )
 
 var errTaskDeleteCancelled = errors.New("task delete cancelled - no changes made")
@@ -438,6 +438,11 @@ func (o *deleteTaskOpts) deleteStack() error {
 		return fmt.Errorf("delete stack for task %s: %w", o.name, err)
 	}
 
+	// The schedule of a recurring task is removed along with the EventBridge rule in its stack.
+	if info.Schedule != "" {
+		o.spinner.Stop(log.Ssuccessf("Deleted resources and schedule of task %s.\n", color.HighlightUserInput(o.name)))
+		return nil
+	}
 	o.spinner.Stop(log.Ssuccessf("Deleted resources of task %s.\n", color.HighlightUserInput(o.name)))
 	return nil
 }
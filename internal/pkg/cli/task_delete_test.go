@@ -14,6 +14,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -379,6 +381,29 @@ func TestDeleteTaskOpts_Execute(t *testing.T) {
 				)
 			},
 		},
+		"success with scheduled task": {
+			inApp:  mockApp,
+			inEnv:  mockEnvName,
+			inName: mockTaskName,
+
+			setupMocks: func(m deleteTaskMocks) {
+				mockScheduledTask := *mockAppEnvTask
+				mockScheduledTask.Schedule = "rate(1 hour)"
+				gomock.InOrder(
+					m.store.EXPECT().GetEnvironment(mockApp, mockEnvName).Return(mockEnv, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.ecs.EXPECT().StopOneOffTasks(mockApp, mockEnvName, mockTaskName).Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.ecr.EXPECT().ClearRepository(mockTaskRepoName).Return(nil),
+					m.spinner.EXPECT().Stop(gomock.Any()),
+					m.cfn.EXPECT().GetTaskStack(mockTaskName).Return(&mockScheduledTask, nil),
+					m.spinner.EXPECT().Start(gomock.Any()),
+					m.cfn.EXPECT().DeleteTask(mockScheduledTask).Return(nil),
+					m.spinner.EXPECT().Stop(log.Ssuccessf("Deleted resources and schedule of task %s.\n", color.HighlightUserInput(mockTaskName))),
+				)
+			},
+		},
 		"success with default cluster": {
 			inDefault: true,
 			inName:    mockTaskName,
@@ -0,0 +1,280 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var (
+	taskInitNamePrompt     = fmt.Sprintf("What do you want to %s this %s?", color.Emphasize("name"), color.Emphasize("task"))
+	taskInitNamePromptHelp = `The name of the task is also used as its task group name.
+Tasks with the same group name share the same set of resources.`
+)
+
+type initTaskVars struct {
+	name           string
+	dockerfilePath string
+	image          string
+	platform       string
+}
+
+type initTaskOpts struct {
+	initTaskVars
+
+	// Interfaces to interact with dependencies.
+	fs           afero.Fs
+	ws           wsTaskWriter
+	prompt       prompter
+	sel          dockerfileSelector
+	dockerEngine dockerEngine
+
+	// Outputs stored on successful actions.
+	manifestPath string
+}
+
+func newInitTaskOpts(vars initTaskVars) (*initTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	prompter := prompt.New()
+	return &initTaskOpts{
+		initTaskVars: vars,
+
+		fs:           &afero.Afero{Fs: afero.NewOsFs()},
+		ws:           ws,
+		prompt:       prompter,
+		sel:          selector.NewWorkspaceSelect(prompter, store, ws),
+		dockerEngine: newDockerEngine(),
+	}, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *initTaskOpts) Validate() error {
+	if o.name != "" {
+		if err := basicNameValidation(o.name); err != nil {
+			return err
+		}
+	}
+	if o.dockerfilePath != "" && o.image != "" {
+		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
+	}
+	if o.dockerfilePath != "" {
+		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
+			return err
+		}
+	}
+	if o.platform != "" {
+		if err := validateTaskPlatform(o.platform); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *initTaskOpts) Ask() error {
+	if err := o.askName(); err != nil {
+		return err
+	}
+	dfSelected, err := o.askDockerfile()
+	if err != nil {
+		return err
+	}
+	if !dfSelected {
+		if err := o.askImage(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Execute writes the task's manifest file under the copilot/tasks/ directory.
+func (o *initTaskOpts) Execute() error {
+	var dfPath string
+	if o.dockerfilePath != "" {
+		path, err := o.relativeDockerfilePath()
+		if err != nil {
+			return err
+		}
+		dfPath = path
+	}
+	mft := manifest.NewTask(&manifest.TaskProps{
+		Name:       o.name,
+		Dockerfile: dfPath,
+		Image:      o.image,
+		Platform:   o.platform,
+	})
+	manifestExists := false
+	manifestPath, err := o.ws.WriteTaskManifest(mft, o.name)
+	if err != nil {
+		var errExists *workspace.ErrFileExists
+		if !errors.As(err, &errExists) {
+			return fmt.Errorf("write task %s manifest: %w", o.name, err)
+		}
+		manifestExists = true
+		manifestPath = errExists.FileName
+	}
+	manifestPath, err = relPath(manifestPath)
+	if err != nil {
+		return err
+	}
+	manifestMsgFmt := "Wrote the manifest for %s %s at %s\n"
+	if manifestExists {
+		manifestMsgFmt = "Manifest file for %s %s already exists at %s, skipping writing it.\n"
+	}
+	log.Successf(manifestMsgFmt, "task", color.HighlightUserInput(o.name), color.HighlightResource(manifestPath))
+	o.manifestPath = manifestPath
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *initTaskOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Update your manifest %s to change the defaults.", color.HighlightResource(o.manifestPath)),
+		fmt.Sprintf("Run %s to run your task.",
+			color.HighlightCode(fmt.Sprintf("copilot task run --%s %s", taskManifestFlag, o.name))),
+	}
+}
+
+// relativeDockerfilePath returns the path from the workspace root to the Dockerfile,
+// since the Dockerfile of the task manifest is resolved against the workspace root.
+func (o *initTaskOpts) relativeDockerfilePath() (string, error) {
+	copilotDirPath, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return "", fmt.Errorf("get copilot directory: %w", err)
+	}
+	absDfPath, err := filepath.Abs(o.dockerfilePath)
+	if err != nil {
+		return "", fmt.Errorf("get absolute path: %v", err)
+	}
+	relDfPath, err := filepath.Rel(filepath.Dir(copilotDirPath), absDfPath)
+	if err != nil {
+		return "", fmt.Errorf("find relative path from workspace root to Dockerfile: %v", err)
+	}
+	return relDfPath, nil
+}
+
+func (o *initTaskOpts) askName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(taskInitNamePrompt, taskInitNamePromptHelp, basicNameValidation,
+		prompt.WithFinalMessage("Task name:"))
+	if err != nil {
+		return fmt.Errorf("get task name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *initTaskOpts) askImage() error {
+	if o.image != "" {
+		return nil
+	}
+	image, err := o.prompt.Get(wkldInitImagePrompt, wkldInitImagePromptHelp, nil,
+		prompt.WithFinalMessage("Image:"))
+	if err != nil {
+		return fmt.Errorf("get image location: %w", err)
+	}
+	o.image = image
+	return nil
+}
+
+// askDockerfile returns true if the task is built from a Dockerfile or uses an existing image.
+func (o *initTaskOpts) askDockerfile() (isDfSelected bool, err error) {
+	if o.dockerfilePath != "" || o.image != "" {
+		return true, nil
+	}
+	if err = o.dockerEngine.CheckDockerEngineRunning(); err != nil {
+		var errDaemon *exec.ErrDockerDaemonNotResponsive
+		switch {
+		case errors.Is(err, exec.ErrDockerCommandNotFound):
+			log.Info("Docker command is not found; Copilot won't build from a Dockerfile.\n")
+			return false, nil
+		case errors.As(err, &errDaemon):
+			log.Info("Docker daemon is not responsive; Copilot won't build from a Dockerfile.\n")
+			return false, nil
+		default:
+			return false, fmt.Errorf("check if docker engine is running: %w", err)
+		}
+	}
+	df, err := o.sel.Dockerfile(
+		fmt.Sprintf(fmtWkldInitDockerfilePrompt, color.HighlightUserInput(o.name)),
+		fmt.Sprintf(fmtWkldInitDockerfilePathPrompt, color.HighlightUserInput(o.name)),
+		wkldInitDockerfileHelpPrompt,
+		wkldInitDockerfilePathHelpPrompt,
+		func(v interface{}) error {
+			return validatePath(afero.NewOsFs(), v)
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("select Dockerfile: %w", err)
+	}
+	if df == selector.DockerfilePromptUseImage {
+		return false, nil
+	}
+	o.dockerfilePath = df
+	return true, nil
+}
+
+// buildTaskInitCmd builds the command for creating a new task manifest.
+func buildTaskInitCmd() *cobra.Command {
+	vars := initTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Creates a manifest for a one-off task.",
+		Long: `Creates a manifest for a one-off task under copilot/tasks/<name>/manifest.yml.
+Run the task with "copilot task run --task-manifest <name>".`,
+		Example: `
+  Create a "db-migrate" task built from a local Dockerfile.
+  /code $ copilot task init --name db-migrate --dockerfile ./migrations/Dockerfile
+
+  Create a "reindex" task that runs on ARM64.
+  /code $ copilot task init --name reindex --dockerfile ./reindex/Dockerfile --platform linux/arm64`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newInitTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", taskFlagDescription)
+	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
+	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
+	cmd.Flags().StringVar(&vars.platform, platformFlag, "", taskPlatformFlagDescription)
+	return cmd
+}
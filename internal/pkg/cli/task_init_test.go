@@ -0,0 +1,274 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskInitOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars         initTaskVars
+		mockFileSystem func(mockFS afero.Fs)
+
+		wantedErr error
+	}{
+		"invalid task name": {
+			inVars:    initTaskVars{name: "DB_migrate"},
+			wantedErr: errValueBadFormat,
+		},
+		"both dockerfile and image are specified": {
+			inVars:    initTaskVars{name: "db-migrate", dockerfilePath: "Dockerfile", image: "flyway/flyway"},
+			wantedErr: errors.New("--dockerfile and --image cannot be specified together"),
+		},
+		"dockerfile does not exist": {
+			inVars:    initTaskVars{name: "db-migrate", dockerfilePath: "migrations/Dockerfile"},
+			wantedErr: errors.New("open migrations/Dockerfile: file does not exist"),
+		},
+		"invalid platform": {
+			inVars:    initTaskVars{name: "db-migrate", platform: "arm64"},
+			wantedErr: errors.New(`platform arm64 is not supported: must be one of "linux/x86_64", "linux/arm64" or "windows/x86_64"`),
+		},
+		"platform not supported by task run": {
+			inVars:    initTaskVars{name: "db-migrate", platform: "linux/arm/v7"},
+			wantedErr: errors.New(`platform linux/arm/v7 is not supported: must be one of "linux/x86_64", "linux/arm64" or "windows/x86_64"`),
+		},
+		"valid flags": {
+			inVars: initTaskVars{name: "db-migrate", dockerfilePath: "migrations/Dockerfile", platform: "linux/arm64"},
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("migrations", 0755)
+				afero.WriteFile(mockFS, "migrations/Dockerfile", []byte("FROM flyway/flyway"), 0644)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := initTaskOpts{
+				initTaskVars: tc.inVars,
+				fs:           &afero.Afero{Fs: afero.NewMemMapFs()},
+			}
+			if tc.mockFileSystem != nil {
+				tc.mockFileSystem(opts.fs)
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskInitOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars           initTaskVars
+		mockPrompt       func(m *mocks.Mockprompter)
+		mockSel          func(m *mocks.MockdockerfileSelector)
+		mockDockerEngine func(m *mocks.MockdockerEngine)
+
+		wantedVars initTaskVars
+		wantedErr  error
+	}{
+		"error if fail to get task name": {
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(taskInitNamePrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedErr: errors.New("get task name: some error"),
+		},
+		"skip selecting Dockerfile if image flag is set": {
+			inVars:           initTaskVars{name: "db-migrate", image: "flyway/flyway"},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedVars: initTaskVars{name: "db-migrate", image: "flyway/flyway"},
+		},
+		"prompt for the task name and the Dockerfile": {
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(taskInitNamePrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("db-migrate", nil)
+			},
+			mockSel: func(m *mocks.MockdockerfileSelector) {
+				m.EXPECT().Dockerfile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("migrations/Dockerfile", nil)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {
+				m.EXPECT().CheckDockerEngineRunning().Return(nil)
+			},
+
+			wantedVars: initTaskVars{name: "db-migrate", dockerfilePath: "migrations/Dockerfile"},
+		},
+		"prompt for an image if the user doesn't select a Dockerfile": {
+			inVars: initTaskVars{name: "db-migrate"},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(wkldInitImagePrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("flyway/flyway", nil)
+			},
+			mockSel: func(m *mocks.MockdockerfileSelector) {
+				m.EXPECT().Dockerfile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(selector.DockerfilePromptUseImage, nil)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {
+				m.EXPECT().CheckDockerEngineRunning().Return(nil)
+			},
+
+			wantedVars: initTaskVars{name: "db-migrate", image: "flyway/flyway"},
+		},
+		"prompt for an image if docker is not installed": {
+			inVars: initTaskVars{name: "db-migrate"},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(wkldInitImagePrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("flyway/flyway", nil)
+			},
+			mockSel: func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {
+				m.EXPECT().CheckDockerEngineRunning().Return(exec.ErrDockerCommandNotFound)
+			},
+
+			wantedVars: initTaskVars{name: "db-migrate", image: "flyway/flyway"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockSel := mocks.NewMockdockerfileSelector(ctrl)
+			mockDockerEngine := mocks.NewMockdockerEngine(ctrl)
+			tc.mockPrompt(mockPrompt)
+			tc.mockSel(mockSel)
+			tc.mockDockerEngine(mockDockerEngine)
+			opts := initTaskOpts{
+				initTaskVars: tc.inVars,
+				prompt:       mockPrompt,
+				sel:          mockSel,
+				dockerEngine: mockDockerEngine,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVars, opts.initTaskVars)
+		})
+	}
+}
+
+func TestTaskInitOpts_Execute(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsTaskWriter)
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"errors if the copilot directory cannot be found": {
+			setupMocks: func(m *mocks.MockwsTaskWriter) {
+				m.EXPECT().CopilotDirPath().Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get copilot directory: some error"),
+		},
+		"errors if the manifest cannot be written": {
+			setupMocks: func(m *mocks.MockwsTaskWriter) {
+				m.EXPECT().CopilotDirPath().Return(filepath.Join(wd, "copilot"), nil)
+				m.EXPECT().WriteTaskManifest(gomock.Any(), "db-migrate").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("write task db-migrate manifest: some error"),
+		},
+		"does not overwrite an existing manifest": {
+			setupMocks: func(m *mocks.MockwsTaskWriter) {
+				m.EXPECT().CopilotDirPath().Return(filepath.Join(wd, "copilot"), nil)
+				m.EXPECT().WriteTaskManifest(gomock.Any(), "db-migrate").Return("", &workspace.ErrFileExists{FileName: "/ws/copilot/tasks/db-migrate/manifest.yml"})
+			},
+			wantedPath: "/ws/copilot/tasks/db-migrate/manifest.yml",
+		},
+		"writes the manifest of the task": {
+			setupMocks: func(m *mocks.MockwsTaskWriter) {
+				m.EXPECT().CopilotDirPath().Return(filepath.Join(wd, "copilot"), nil)
+				m.EXPECT().WriteTaskManifest(&taskManifestMatcher{name: "db-migrate", dockerfile: "migrations/Dockerfile"}, "db-migrate").Return("/ws/copilot/tasks/db-migrate/manifest.yml", nil)
+			},
+			wantedPath: "/ws/copilot/tasks/db-migrate/manifest.yml",
+		},
+		"writes the path of the Dockerfile relative to the workspace root": {
+			setupMocks: func(m *mocks.MockwsTaskWriter) {
+				m.EXPECT().CopilotDirPath().Return(filepath.Join(filepath.Dir(wd), "copilot"), nil)
+				m.EXPECT().WriteTaskManifest(&taskManifestMatcher{name: "db-migrate", dockerfile: filepath.Join(filepath.Base(wd), "migrations", "Dockerfile")}, "db-migrate").Return("/ws/copilot/tasks/db-migrate/manifest.yml", nil)
+			},
+			wantedPath: "/ws/copilot/tasks/db-migrate/manifest.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsTaskWriter(ctrl)
+			tc.setupMocks(m)
+			opts := initTaskOpts{
+				initTaskVars: initTaskVars{
+					name:           "db-migrate",
+					dockerfilePath: "migrations/Dockerfile",
+				},
+				ws: m,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			wantedPath, err := relPath(tc.wantedPath)
+			require.NoError(t, err)
+			require.Equal(t, wantedPath, opts.manifestPath)
+		})
+	}
+}
+
+type taskManifestMatcher struct {
+	name       string
+	dockerfile string
+}
+
+func (m *taskManifestMatcher) Matches(x interface{}) bool {
+	mft, ok := x.(*manifest.Task)
+	if !ok {
+		return false
+	}
+	return mft.Name != nil && *mft.Name == m.name &&
+		mft.ImageConfig.Build.BuildArgs.Dockerfile != nil && *mft.ImageConfig.Build.BuildArgs.Dockerfile == m.dockerfile
+}
+
+func (m *taskManifestMatcher) String() string {
+	return "is a task manifest named " + m.name + " built from " + m.dockerfile
+}
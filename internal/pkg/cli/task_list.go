@@ -0,0 +1,514 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+const (
+	taskListAppPrompt = "Which application's tasks would you like to list?"
+	taskListEnvPrompt = "Which environment's tasks would you like to list?"
+
+	taskListNotScheduled = "-"
+	taskListExpired      = "Expired"
+
+	taskListMinCellWidth     = 10 // minimum number of characters in a cell of the tasks table.
+	taskListTabWidth         = 4  // number of characters in a tab.
+	taskListCellPaddingWidth = 2  // number of padding characters added to a cell.
+
+	fmtTaskListFamilyName = "copilot-%s"
+)
+
+type listTaskVars struct {
+	appName          string
+	env              string
+	defaultCluster   bool
+	all              bool
+	shouldOutputJSON bool
+}
+
+type listTaskOpts struct {
+	listTaskVars
+
+	store store
+	sess  sessionProvider
+	sel   appEnvSelector
+	w     io.Writer
+	now   func() time.Time
+
+	newStackLister func(sess *session.Session) taskStackLister
+	newTaskLister  func(sess *session.Session) oneOffTaskLister
+}
+
+func newListTaskOpts(vars listTaskVars) (*listTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	return &listTaskOpts{
+		listTaskVars: vars,
+
+		store: store,
+		sess:  sessions.NewProvider(),
+		sel:   selector.NewSelect(prompt.New(), store),
+		w:     os.Stdout,
+		now:   time.Now,
+		newStackLister: func(sess *session.Session) taskStackLister {
+			return cloudformation.New(sess)
+		},
+		newTaskLister: func(sess *session.Session) oneOffTaskLister {
+			return ecs.New(sess)
+		},
+	}, nil
+}
+
+// taskGroup is a group of one-off tasks that share the resources of a task stack.
+type taskGroup struct {
+	Name        string        `json:"name"`
+	Environment string        `json:"environment,omitempty"`
+	Schedule    string        `json:"schedule,omitempty"`
+	NextRun     *time.Time    `json:"nextRun,omitempty"`
+	Expired     bool          `json:"expired,omitempty"`
+	Tasks       []taskSummary `json:"tasks"`
+}
+
+// taskSummary is a running or recently stopped task of a task group.
+type taskSummary struct {
+	ID            string     `json:"id"`
+	Status        string     `json:"status"`
+	ExitCode      *int64     `json:"exitCode,omitempty"` // ExitCode is nil if the task hasn't exited.
+	StartedAt     *time.Time `json:"startedAt,omitempty"`
+	StoppedAt     *time.Time `json:"stoppedAt,omitempty"`
+	StoppedReason string     `json:"stoppedReason,omitempty"`
+	StartedBy     string     `json:"startedBy,omitempty"`
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *listTaskOpts) Validate() error {
+	if o.defaultCluster {
+		// The app flag defaults to the workspace app, so only error if it was changed.
+		if o.appName != tryReadingAppName() {
+			return errors.New("cannot specify both `--app` and `--default`")
+		}
+		if o.env != "" {
+			return errors.New("cannot specify both `--env` and `--default`")
+		}
+		if o.all {
+			return errors.New("cannot specify both `--all` and `--default`")
+		}
+		o.appName = ""
+		return nil
+	}
+	if o.all && o.env != "" {
+		return errors.New("cannot specify both `--all` and `--env`")
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application: %w", err)
+		}
+	}
+	if o.env != "" {
+		if o.appName == "" {
+			return errNoAppInWorkspace
+		}
+		if _, err := o.store.GetEnvironment(o.appName, o.env); err != nil {
+			return fmt.Errorf("get environment: %w", err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the application and the environment to list the tasks of if they are not provided.
+func (o *listTaskOpts) Ask() error {
+	if o.defaultCluster {
+		return nil
+	}
+	if o.all {
+		if o.appName != "" {
+			return nil
+		}
+		app, err := o.sel.Application(taskListAppPrompt, "")
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+		return nil
+	}
+	if o.appName == "" {
+		app, err := o.sel.Application(taskListAppPrompt, "", appEnvOptionNone)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		if app == appEnvOptionNone {
+			o.defaultCluster = true
+			return nil
+		}
+		o.appName = app
+	}
+	if o.env == "" {
+		env, err := o.sel.Environment(taskListEnvPrompt, "", o.appName, appEnvOptionNone)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		if env == appEnvOptionNone {
+			o.appName = ""
+			o.defaultCluster = true
+			return nil
+		}
+		o.env = env
+	}
+	return nil
+}
+
+// Execute lists the one-off task groups with their schedules, and their running and recently stopped tasks.
+func (o *listTaskOpts) Execute() error {
+	groups, err := o.listTaskGroups()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Environment != groups[j].Environment {
+			return groups[i].Environment < groups[j].Environment
+		}
+		return groups[i].Name < groups[j].Name
+	})
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(groups)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(groups)
+	return nil
+}
+
+func (o *listTaskOpts) listTaskGroups() ([]*taskGroup, error) {
+	if o.defaultCluster {
+		return o.listDefaultClusterTaskGroups()
+	}
+	envs := []string{o.env}
+	if o.all {
+		appEnvs, err := o.store.ListEnvironments(o.appName)
+		if err != nil {
+			return nil, fmt.Errorf("list environments for application %s: %w", o.appName, err)
+		}
+		envs = make([]string, len(appEnvs))
+		for i, env := range appEnvs {
+			envs[i] = env.Name
+		}
+	}
+	var groups []*taskGroup
+	for _, env := range envs {
+		envGroups, err := o.listEnvTaskGroups(env)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, envGroups...)
+	}
+	return groups, nil
+}
+
+func (o *listTaskOpts) listDefaultClusterTaskGroups() ([]*taskGroup, error) {
+	sess, err := o.sess.Default()
+	if err != nil {
+		return nil, fmt.Errorf("get default session: %w", err)
+	}
+	stacks, err := o.newStackLister(sess).ListDefaultTaskStacks()
+	if err != nil {
+		return nil, fmt.Errorf("list tasks in the default cluster: %w", err)
+	}
+	if len(stacks) == 0 {
+		return nil, nil
+	}
+	lister := o.newTaskLister(sess)
+	running, err := lister.ListActiveDefaultClusterTasks(ecs.ListTasksFilter{
+		CopilotOnly: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list running tasks in the default cluster: %w", err)
+	}
+	stopped, err := lister.ListStoppedDefaultClusterTasks()
+	if err != nil {
+		return nil, fmt.Errorf("list stopped tasks in the default cluster: %w", err)
+	}
+	return o.taskGroups("", stacks, append(running, stopped...)), nil
+}
+
+func (o *listTaskOpts) listEnvTaskGroups(envName string) ([]*taskGroup, error) {
+	env, err := o.store.GetEnvironment(o.appName, envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s config: %w", envName, err)
+	}
+	sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	stacks, err := o.newStackLister(sess).ListTaskStacks(o.appName, envName)
+	if err != nil {
+		return nil, fmt.Errorf("list tasks in environment %s: %w", envName, err)
+	}
+	if len(stacks) == 0 {
+		return nil, nil
+	}
+	lister := o.newTaskLister(sess)
+	running, err := lister.ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+		App: o.appName,
+		Env: envName,
+		ListTasksFilter: ecs.ListTasksFilter{
+			CopilotOnly: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list running tasks in environment %s: %w", envName, err)
+	}
+	stopped, err := lister.ListStoppedAppEnvTasks(o.appName, envName)
+	if err != nil {
+		return nil, fmt.Errorf("list stopped tasks in environment %s: %w", envName, err)
+	}
+	var groupEnv string
+	if o.all {
+		groupEnv = envName
+	}
+	return o.taskGroups(groupEnv, stacks, append(running, stopped...)), nil
+}
+
+// taskGroups matches the tasks to the stacks of their task groups by task definition family.
+func (o *listTaskOpts) taskGroups(env string, stacks []deploy.TaskStackInfo, tasks []*awsecs.Task) []*taskGroup {
+	tasksByFamily := make(map[string][]*awsecs.Task)
+	for _, t := range tasks {
+		family, err := awsecs.TaskDefinitionFamily(aws.StringValue(t.TaskDefinitionArn))
+		if err != nil {
+			continue
+		}
+		tasksByFamily[family] = append(tasksByFamily[family], t)
+	}
+	groups := make([]*taskGroup, len(stacks))
+	for i, stack := range stacks {
+		group := &taskGroup{
+			Name:        stack.TaskName(),
+			Environment: env,
+			Schedule:    stack.Schedule,
+			Tasks:       []taskSummary{},
+		}
+		if stack.Schedule != "" {
+			group.NextRun, group.Expired = o.nextRun(stack)
+		}
+		groupTasks := tasksByFamily[fmt.Sprintf(fmtTaskListFamilyName, group.Name)]
+		sort.SliceStable(groupTasks, func(i, j int) bool {
+			return aws.TimeValue(groupTasks[i].CreatedAt).After(aws.TimeValue(groupTasks[j].CreatedAt))
+		})
+		for _, t := range groupTasks {
+			group.Tasks = append(group.Tasks, newTaskSummary(t, group.Name))
+		}
+		groups[i] = group
+	}
+	return groups
+}
+
+func (o *listTaskOpts) nextRun(stack deploy.TaskStackInfo) (next *time.Time, expired bool) {
+	now := o.now()
+	if stack.ScheduleUntil != nil && !stack.ScheduleUntil.After(now) {
+		return nil, true
+	}
+	t, ok := stack.NextRun(now)
+	if !ok {
+		return nil, false
+	}
+	return &t, false
+}
+
+func newTaskSummary(t *awsecs.Task, container string) taskSummary {
+	id, _ := awsecs.TaskID(aws.StringValue(t.TaskArn))
+	return taskSummary{
+		ID:            id,
+		Status:        aws.StringValue(t.LastStatus),
+		ExitCode:      taskExitCode(t, container),
+		StartedAt:     t.StartedAt,
+		StoppedAt:     t.StoppedAt,
+		StoppedReason: aws.StringValue(t.StoppedReason),
+		StartedBy:     taskStartedBy(t),
+	}
+}
+
+// taskStartedBy returns the identity recorded in the "copilot-started-by" tag of the task if any,
+// otherwise the "startedBy" value of the task.
+func taskStartedBy(t *awsecs.Task) string {
+	for _, tag := range t.Tags {
+		if aws.StringValue(tag.Key) == deploy.StartedByTagKey {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return aws.StringValue(t.StartedBy)
+}
+
+// taskExitCode returns the exit code of the task's main container, which is named after the task group.
+// If the container can't be found, it falls back to the first container that exited.
+func taskExitCode(t *awsecs.Task, container string) *int64 {
+	var exitCode *int64
+	for _, c := range t.Containers {
+		if c.ExitCode == nil {
+			continue
+		}
+		if aws.StringValue(c.Name) == container {
+			return c.ExitCode
+		}
+		if exitCode == nil {
+			exitCode = c.ExitCode
+		}
+	}
+	return exitCode
+}
+
+func (o *listTaskOpts) jsonOutput(groups []*taskGroup) (string, error) {
+	type out struct {
+		TaskGroups []*taskGroup `json:"taskGroups"`
+	}
+	if groups == nil {
+		groups = []*taskGroup{}
+	}
+	b, err := json.Marshal(out{TaskGroups: groups})
+	if err != nil {
+		return "", fmt.Errorf("marshal task groups: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+func (o *listTaskOpts) humanOutput(groups []*taskGroup) {
+	w := tabwriter.NewWriter(o.w, taskListMinCellWidth, taskListTabWidth, taskListCellPaddingWidth, ' ', 0)
+	o.writeHeaders(w, []string{"Name", "Schedule", "Next Run"})
+	for _, g := range groups {
+		schedule, nextRun := taskListNotScheduled, taskListNotScheduled
+		if g.Schedule != "" {
+			schedule = g.Schedule
+		}
+		if g.Expired {
+			nextRun = taskListExpired
+		}
+		if g.NextRun != nil {
+			nextRun = g.NextRun.UTC().Format(time.RFC3339)
+		}
+		o.writeRow(w, g, g.Name, schedule, nextRun)
+	}
+
+	var hasTasks bool
+	for _, g := range groups {
+		if len(g.Tasks) != 0 {
+			hasTasks = true
+			break
+		}
+	}
+	if !hasTasks {
+		w.Flush()
+		return
+	}
+	fmt.Fprintln(w) // An empty line ends the columns of the task groups table.
+	o.writeHeaders(w, []string{"Task Group", "ID", "Status", "Exit Code", "Started At", "Stopped At", "Started By"})
+	for _, g := range groups {
+		for _, t := range g.Tasks {
+			id := t.ID
+			if len(id) > shortTaskIDLength {
+				id = id[:shortTaskIDLength]
+			}
+			exitCode := "-"
+			if t.ExitCode != nil {
+				exitCode = strconv.FormatInt(aws.Int64Value(t.ExitCode), 10)
+			}
+			startedBy := "-"
+			if t.StartedBy != "" {
+				startedBy = t.StartedBy
+			}
+			o.writeRow(w, g, g.Name, id, t.Status, exitCode, o.humanizeTime(t.StartedAt), o.humanizeTime(t.StoppedAt), startedBy)
+		}
+	}
+	w.Flush()
+}
+
+func (o *listTaskOpts) writeHeaders(w io.Writer, headers []string) {
+	if o.all {
+		headers = append([]string{headers[0], "Environment"}, headers[1:]...)
+	}
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		underlines[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(w, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "%s\n", strings.Join(underlines, "\t"))
+}
+
+// writeRow writes the cells of a task group or of one of its tasks, with the environment of the group if listing all the environments.
+func (o *listTaskOpts) writeRow(w io.Writer, g *taskGroup, cells ...string) {
+	if o.all {
+		cells = append([]string{cells[0], g.Environment}, cells[1:]...)
+	}
+	fmt.Fprintf(w, "%s\n", strings.Join(cells, "\t"))
+}
+
+func (o *listTaskOpts) humanizeTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return humanize.RelTime(*t, o.now(), "ago", "from now")
+}
+
+// buildTaskListCmd builds the command for listing the one-off tasks of an environment or the default cluster.
+func buildTaskListCmd() *cobra.Command {
+	vars := listTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the one-off tasks of an environment or the default cluster, with their schedules and recent runs.",
+		Example: `
+  Lists the tasks in the "test" environment, and their running and recently stopped tasks.
+  /code $ copilot task ls --env test
+  Lists the tasks in the default cluster.
+  /code $ copilot task ls --default
+  Lists the tasks in all the environments of the "myapp" application in JSON format.
+  /code $ copilot task ls --app myapp --all --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.defaultCluster, taskDefaultFlag, false, taskListDefaultFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, taskListAllFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,489 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type listTaskMocks struct {
+	store       *mocks.Mockstore
+	stackLister *mocks.MocktaskStackLister
+	taskLister  *mocks.MockoneOffTaskLister
+}
+
+func TestListTaskOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp     string
+		inEnv     string
+		inDefault bool
+		inAll     bool
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid app and env": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+		"env without app": {
+			inEnv:       "test",
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"env does not exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment: some error"),
+		},
+		"default with env": {
+			inEnv:       "test",
+			inDefault:   true,
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errors.New("cannot specify both `--env` and `--default`"),
+		},
+		"all with env": {
+			inApp:       "phonetool",
+			inEnv:       "test",
+			inAll:       true,
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errors.New("cannot specify both `--all` and `--env`"),
+		},
+		"all with default": {
+			inAll:       true,
+			inDefault:   true,
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errors.New("cannot specify both `--all` and `--default`"),
+		},
+		"default with app": {
+			inApp:       "phonetool",
+			inDefault:   true,
+			setupMocks:  func(m *mocks.Mockstore) {},
+			wantedError: errors.New("cannot specify both `--app` and `--default`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := listTaskOpts{
+				listTaskVars: listTaskVars{
+					appName:        tc.inApp,
+					env:            tc.inEnv,
+					defaultCluster: tc.inDefault,
+					all:            tc.inAll,
+				},
+				store: mockStore,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListTaskOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inAll bool
+
+		setupMocks func(m *mocks.MockappEnvSelector)
+
+		wantedApp     string
+		wantedEnv     string
+		wantedDefault bool
+		wantedError   error
+	}{
+		"prompts for app and env": {
+			setupMocks: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(taskListAppPrompt, "", appEnvOptionNone).Return("phonetool", nil)
+				m.EXPECT().Environment(taskListEnvPrompt, "", "phonetool", appEnvOptionNone).Return("test", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"uses the default cluster if no app is selected": {
+			setupMocks: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(taskListAppPrompt, "", appEnvOptionNone).Return(appEnvOptionNone, nil)
+			},
+			wantedDefault: true,
+		},
+		"uses the default cluster if no env is selected": {
+			inApp: "phonetool",
+			setupMocks: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(taskListEnvPrompt, "", "phonetool", appEnvOptionNone).Return(appEnvOptionNone, nil)
+			},
+			wantedDefault: true,
+		},
+		"prompts only for app when listing all environments": {
+			inAll: true,
+			setupMocks: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(taskListAppPrompt, "").Return("phonetool", nil)
+			},
+			wantedApp: "phonetool",
+		},
+		"error selecting env": {
+			inApp: "phonetool",
+			setupMocks: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockappEnvSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := listTaskOpts{
+				listTaskVars: listTaskVars{
+					appName: tc.inApp,
+					env:     tc.inEnv,
+					all:     tc.inAll,
+				},
+				sel: mockSel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.env)
+			require.Equal(t, tc.wantedDefault, opts.defaultCluster)
+		})
+	}
+}
+
+func TestListTaskOpts_Execute(t *testing.T) {
+	now := time.Date(2021, time.March, 14, 15, 9, 26, 0, time.UTC)
+	expired := now.Add(-time.Hour)
+	startedAt := now.Add(-2 * time.Hour)
+	stoppedAt := now.Add(-90 * time.Minute)
+	mockStoppedTask := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:2"),
+		LastStatus:        aws.String("STOPPED"),
+		CreatedAt:         &startedAt,
+		StartedAt:         &startedAt,
+		StoppedAt:         &stoppedAt,
+		StoppedReason:     aws.String("Essential container in task exited"),
+		StartedBy:         aws.String("copilot-task"),
+		Containers: []*ecsapi.Container{
+			{
+				Name:     aws.String("firelens_log_router"),
+				ExitCode: aws.Int64(0),
+			},
+			{
+				Name:     aws.String("db-migrate"),
+				ExitCode: aws.Int64(1),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inDefault bool
+		inAll     bool
+		inJSON    bool
+
+		setupMocks func(m listTaskMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"lists the tasks of the environment with their next run and recent runs": {
+			setupMocks: func(m listTaskMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+					Region:         "us-west-2",
+				}, nil)
+				m.stackLister.EXPECT().ListTaskStacks("phonetool", "test").Return([]deploy.TaskStackInfo{
+					{
+						StackName: "task-reconcile",
+						Schedule:  "cron(0 9 ? * 2-6 *)",
+					},
+					{
+						StackName:     "task-backfill",
+						Schedule:      "rate(1 hour)",
+						ScheduleUntil: &expired,
+					},
+					{
+						StackName: "task-db-migrate",
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+					App: "phonetool",
+					Env: "test",
+					ListTasksFilter: ecs.ListTasksFilter{
+						CopilotOnly: true,
+					},
+				}).Return([]*awsecs.Task{
+					{
+						TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/9c36f4a1ab8a4c1e8d4a2d2b50f2e6c0"),
+						TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3"),
+						LastStatus:        aws.String("PROVISIONING"),
+						CreatedAt:         &now,
+					},
+					{
+						TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/0f5b3f8d1e2a4b6c9d7e8f9a0b1c2d3e"),
+						TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-deleted:1"),
+						LastStatus:        aws.String("RUNNING"),
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListStoppedAppEnvTasks("phonetool", "test").Return([]*awsecs.Task{mockStoppedTask}, nil)
+			},
+			wantedContent: `Name        Schedule             Next Run
+----        --------             --------
+backfill    rate(1 hour)         Expired
+db-migrate  -                    -
+reconcile   cron(0 9 ? * 2-6 *)  2021-03-15T09:00:00Z
+
+Task Group  ID        Status        Exit Code  Started At   Stopped At  Started By
+----------  --        ------        ---------  ----------   ----------  ----------
+db-migrate  9c36f4a1  PROVISIONING  -          -            -           -
+db-migrate  4082490e  STOPPED       1          2 hours ago  1 hour ago  copilot-task
+`,
+		},
+		"lists the tasks of all the environments in JSON": {
+			inAll:  true,
+			inJSON: true,
+			setupMocks: func(m listTaskMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.stackLister.EXPECT().ListTaskStacks("phonetool", "test").Return([]deploy.TaskStackInfo{
+					{
+						StackName: "task-db-migrate",
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return(nil, nil)
+				m.taskLister.EXPECT().ListStoppedAppEnvTasks("phonetool", "test").Return([]*awsecs.Task{mockStoppedTask}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+				m.stackLister.EXPECT().ListTaskStacks("phonetool", "prod").Return([]deploy.TaskStackInfo{
+					{
+						StackName: "task-backfill",
+						Schedule:  "rate(1 hour)",
+						UpdatedAt: now.Add(-10 * time.Minute),
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return(nil, nil)
+				m.taskLister.EXPECT().ListStoppedAppEnvTasks("phonetool", "prod").Return(nil, nil)
+			},
+			wantedContent: `{"taskGroups":[{"name":"backfill","environment":"prod","schedule":"rate(1 hour)","nextRun":"2021-03-14T15:59:26Z","tasks":[]},` +
+				`{"name":"db-migrate","environment":"test","tasks":[{"id":"4082490ee6c245e09d2145010aa1ba8d","status":"STOPPED","exitCode":1,` +
+				`"startedAt":"2021-03-14T13:09:26Z","stoppedAt":"2021-03-14T13:39:26Z","stoppedReason":"Essential container in task exited","startedBy":"copilot-task"}]}]}` + "\n",
+		},
+		"lists the tasks of all the environments with their environment": {
+			inAll: true,
+			setupMocks: func(m listTaskMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.stackLister.EXPECT().ListTaskStacks("phonetool", "test").Return([]deploy.TaskStackInfo{
+					{
+						StackName: "task-db-migrate",
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return(nil, nil)
+				m.taskLister.EXPECT().ListStoppedAppEnvTasks("phonetool", "test").Return([]*awsecs.Task{mockStoppedTask}, nil)
+			},
+			wantedContent: `Name        Environment  Schedule  Next Run
+----        -----------  --------  --------
+db-migrate  test         -         -
+
+Task Group  Environment  ID        Status    Exit Code  Started At   Stopped At  Started By
+----------  -----------  --        ------    ---------  ----------   ----------  ----------
+db-migrate  test         4082490e  STOPPED   1          2 hours ago  1 hour ago  copilot-task
+`,
+		},
+		"error listing the stopped tasks of an environment": {
+			setupMocks: func(m listTaskMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.stackLister.EXPECT().ListTaskStacks("phonetool", "test").Return([]deploy.TaskStackInfo{
+					{
+						StackName: "task-db-migrate",
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return(nil, nil)
+				m.taskLister.EXPECT().ListStoppedAppEnvTasks("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list stopped tasks in environment test: some error"),
+		},
+		"error listing the environments of the application": {
+			inAll: true,
+			setupMocks: func(m listTaskMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments for application phonetool: some error"),
+		},
+		"lists the running tasks of the default cluster": {
+			inDefault: true,
+			setupMocks: func(m listTaskMocks) {
+				m.stackLister.EXPECT().ListDefaultTaskStacks().Return([]deploy.TaskStackInfo{
+					{
+						StackName: "task-db-migrate",
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListActiveDefaultClusterTasks(ecs.ListTasksFilter{
+					CopilotOnly: true,
+				}).Return([]*awsecs.Task{
+					{
+						TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/9c36f4a1ab8a4c1e8d4a2d2b50f2e6c0"),
+						TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3"),
+						LastStatus:        aws.String("RUNNING"),
+						StartedAt:         &stoppedAt,
+						StartedBy:         aws.String("copilot-task"),
+					},
+				}, nil)
+				m.taskLister.EXPECT().ListStoppedDefaultClusterTasks().Return(nil, nil)
+			},
+			wantedContent: `Name        Schedule  Next Run
+----        --------  --------
+db-migrate  -         -
+
+Task Group  ID        Status    Exit Code  Started At  Stopped At  Started By
+----------  --        ------    ---------  ----------  ----------  ----------
+db-migrate  9c36f4a1  RUNNING   -          1 hour ago  -           copilot-task
+`,
+		},
+		"error listing the tasks of the default cluster": {
+			inDefault: true,
+			setupMocks: func(m listTaskMocks) {
+				m.stackLister.EXPECT().ListDefaultTaskStacks().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list tasks in the default cluster: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := listTaskMocks{
+				store:       mocks.NewMockstore(ctrl),
+				stackLister: mocks.NewMocktaskStackLister(ctrl),
+				taskLister:  mocks.NewMockoneOffTaskLister(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := listTaskOpts{
+				listTaskVars: listTaskVars{
+					appName:          "phonetool",
+					env:              "test",
+					defaultCluster:   tc.inDefault,
+					all:              tc.inAll,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				sess:  sessions.NewProvider(),
+				w:     b,
+				now: func() time.Time {
+					return now
+				},
+				newStackLister: func(_ *session.Session) taskStackLister {
+					return m.stackLister
+				},
+				newTaskLister: func(_ *session.Session) oneOffTaskLister {
+					return m.taskLister
+				},
+			}
+			if tc.inDefault {
+				opts.appName, opts.env = "", ""
+			}
+			if tc.inAll {
+				opts.env = ""
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestTaskStartedBy(t *testing.T) {
+	testCases := map[string]struct {
+		in     *awsecs.Task
+		wanted string
+	}{
+		"returns the caller recorded in the tags of the task": {
+			in: &awsecs.Task{
+				StartedBy: aws.String("copilot-task"),
+				Tags: []*ecsapi.Tag{
+					{
+						Key:   aws.String("copilot-task"),
+						Value: aws.String("db-migrate"),
+					},
+					{
+						Key:   aws.String("copilot-started-by"),
+						Value: aws.String("arn:aws:sts::123456789012:assumed-role/Admin/alice@example.com"),
+					},
+				},
+			},
+			wanted: "arn:aws:sts::123456789012:assumed-role/Admin/alice@example.com",
+		},
+		"falls back to the startedBy value of the task": {
+			in: &awsecs.Task{
+				StartedBy: aws.String("events-rule/copilot-db-migrate"),
+			},
+			wanted: "events-rule/copilot-db-migrate",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, taskStartedBy(tc.in))
+		})
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -21,18 +24,21 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/dustin/go-humanize/english"
 	"github.com/google/shlex"
@@ -54,15 +60,74 @@ const (
 )
 
 const (
-	fmtImageURI = "%s:%s"
+	fmtImageURI              = "%s:%s"
+	fmtTaskTimeoutStopReason = "Task stopped because it was still running after the timeout of %s."
+)
+
+const (
+	copilotManagedFileSystemID = "copilot"
+	volumeReadOnlyOption       = "ro"
+	ephemeralMinValueGiB       = 20
+	ephemeralMaxValueGiB       = 200
+)
+
+// taskPlatform holds the runtime platform of the task definition and the platform to build the image for.
+type taskPlatform struct {
+	os          string
+	arch        string
+	buildTarget string
+}
+
+var (
+	linuxX86Platform = taskPlatform{os: deploy.TaskOSLinux, arch: deploy.TaskArchX86, buildTarget: "linux/amd64"}
+	taskPlatforms    = map[string]taskPlatform{
+		"linux/x86_64":   linuxX86Platform,
+		"linux/amd64":    linuxX86Platform,
+		"linux/arm64":    {os: deploy.TaskOSLinux, arch: deploy.TaskArchARM64, buildTarget: "linux/arm64"},
+		"windows/x86_64": {os: deploy.TaskOSWindows, arch: deploy.TaskArchX86, buildTarget: "windows/amd64"},
+		"windows/amd64":  {os: deploy.TaskOSWindows, arch: deploy.TaskArchX86, buildTarget: "windows/amd64"},
+	}
+	// fargateMemoryRangesMiB maps the CPU units supported by Fargate to the range of memory they can be paired with.
+	// Memory must be a multiple of 1024 MiB, except for 512 MiB with 256 CPU units.
+	fargateMemoryRangesMiB = map[int][2]int{
+		256:  {512, 2048},
+		512:  {1024, 4096},
+		1024: {2048, 8192},
+		2048: {4096, 16384},
+		4096: {8192, 30720},
+	}
+	fargateCPUUnits    = []int{256, 512, 1024, 2048, 4096}
+	windowsMinCPUUnits = 1024
 )
 
 var (
 	errNumNotPositive = errors.New("number of tasks must be positive")
 	errCPUNotPositive = errors.New("CPU units must be positive")
 	errMemNotPositive = errors.New("memory must be positive")
+	errEphemeralSize  = fmt.Errorf("ephemeral storage must be between %d and %d GiB", ephemeralMinValueGiB, ephemeralMaxValueGiB)
 )
 
+// errTaskExit is returned when a followed task fails, so that the command exits with the exit code of the task.
+type errTaskExit struct {
+	taskID   string
+	exitCode int
+}
+
+func (e *errTaskExit) Error() string {
+	return fmt.Sprintf("task %s exited with code %d", e.taskID, e.exitCode)
+}
+
+// ExitCode returns the exit code of the process for an error returned by a command.
+// Commands that follow a task, such as "task run --follow", exit with the exit code of the essential container of the task
+// when it fails, and any other error exits with 1.
+func ExitCode(err error) int {
+	var exitErr *errTaskExit
+	if errors.As(err, &exitErr) {
+		return exitErr.exitCode
+	}
+	return 1
+}
+
 var (
 	taskRunAppPrompt = fmt.Sprintf("In which %s would you like to run this %s?", color.Emphasize("application"), color.Emphasize("task"))
 	taskRunEnvPrompt = fmt.Sprintf("In which %s would you like to run this %s?", color.Emphasize("environment"), color.Emphasize("task"))
@@ -100,17 +165,41 @@ type runTaskVars struct {
 	entrypoint   string
 	resourceTags map[string]string
 
+	volumes          []string
+	ephemeralStorage int
+
+	platform string
+	spot     bool
+
+	schedule string
+	until    string
+
 	follow                bool
+	timeout               time.Duration
 	generateCommandTarget string
+	taskManifest          string
 }
 
 type runTaskOpts struct {
 	runTaskVars
 	isDockerfileSet bool
 	nFlag           int
+	isFlagSet       func(name string) bool // Reports whether a flag is explicitly set by the user.
+
+	// Configuration read from the task manifest that can't be expressed with the flags.
+	buildArgs           *manifest.DockerBuildArgs
+	manifestEntrypoint  []string
+	manifestCommand     []string
+	privateSubnets      bool
+	extraSecurityGroups []string
+	storage             *manifest.Storage
+
+	scheduleUntil *time.Time           // Parsed value of the --until flag.
+	taskSchedule  *deploy.TaskSchedule // Set at runtime if the task runs on a schedule.
 
 	// Interfaces to interact with dependencies.
 	fs      afero.Fs
+	ws      wsTaskReader
 	store   store
 	sel     appEnvSelector
 	spinner progress
@@ -120,6 +209,7 @@ type runTaskOpts struct {
 	repository           repositoryService
 	runner               taskRunner
 	eventsWriter         eventsWriter
+	taskStopper          ecsTaskStopper
 	defaultClusterGetter defaultClusterGetter
 	publicIPGetter       publicIPGetter
 
@@ -143,11 +233,16 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
 
 	opts := runTaskOpts{
 		runTaskVars: vars,
 
 		fs:      &afero.Afero{Fs: afero.NewOsFs()},
+		ws:      ws,
 		store:   store,
 		sel:     selector.NewSelect(prompt.New(), store),
 		spinner: termprogress.NewSpinner(log.DiagnosticWriter),
@@ -159,6 +254,7 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 			return fmt.Errorf("configure task runner: %w", err)
 		}
 		opts.deployer = cloudformation.New(opts.sess)
+		opts.taskStopper = awsecs.New(opts.sess)
 		opts.defaultClusterGetter = awsecs.New(opts.sess)
 		opts.publicIPGetter = ec2.New(opts.sess)
 		return nil
@@ -176,7 +272,11 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 	}
 
 	opts.configureEventsWriter = func(tasks []*task.Task) {
-		opts.eventsWriter = logging.NewTaskClient(opts.sess, opts.groupName, tasks)
+		var clientOpts []logging.TaskClientOpts
+		if opts.timeout != 0 {
+			clientOpts = append(clientOpts, logging.WithTimeout(opts.timeout))
+		}
+		opts.eventsWriter = logging.NewTaskClient(opts.sess, opts.groupName, tasks, clientOpts...)
 	}
 
 	opts.runTaskRequestFromECSService = ecs.RunTaskRequestFromECSService
@@ -188,22 +288,16 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 	vpcGetter := ec2.New(o.sess)
 	ecsService := awsecs.New(o.sess)
+	// The caller is only recorded in a tag of the tasks, so don't fail the run if it can't be retrieved.
+	caller, err := identity.New(o.sess).Get()
+	if err != nil {
+		log.Debugf("get identity of the caller: %v\n", err)
+	}
 
 	if o.env != "" {
-		deployStore, err := deploy.NewStore(o.store)
+		d, err := o.envDescriber()
 		if err != nil {
-			return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
-		}
-
-		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
-			App:             o.appName,
-			Env:             o.env,
-			ConfigStore:     o.store,
-			DeployStore:     deployStore,
-			EnableResources: false, // We don't need to show detailed resources.
-		})
-		if err != nil {
-			return nil, fmt.Errorf("create describer for environment %s in application %s: %w", o.env, o.appName, err)
+			return nil, err
 		}
 
 		return &task.EnvRunner{
@@ -213,6 +307,14 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 			App: o.appName,
 			Env: o.env,
 
+			PrivateSubnets: o.privateSubnets,
+			SecurityGroups: o.extraSecurityGroups,
+
+			Spot: o.spot,
+			OS:   o.runtimePlatform().os,
+
+			Caller: caller.ARN,
+
 			VPCGetter:            vpcGetter,
 			ClusterGetter:        ecs.New(o.sess),
 			Starter:              ecsService,
@@ -228,6 +330,11 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 		Subnets:        o.subnets,
 		SecurityGroups: o.securityGroups,
 
+		Spot: o.spot,
+		OS:   o.runtimePlatform().os,
+
+		Caller: caller.ARN,
+
 		VPCGetter:     vpcGetter,
 		ClusterGetter: ecsService,
 		Starter:       ecsService,
@@ -235,6 +342,25 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 
 }
 
+func (o *runTaskOpts) envDescriber() (*describe.EnvDescriber, error) {
+	deployStore, err := deploy.NewStore(o.store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+
+	d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+		App:             o.appName,
+		Env:             o.env,
+		ConfigStore:     o.store,
+		DeployStore:     deployStore,
+		EnableResources: false, // We don't need to show detailed resources.
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create describer for environment %s in application %s: %w", o.env, o.appName, err)
+	}
+	return d, nil
+}
+
 func (o *runTaskOpts) configureSessAndEnv() error {
 	var sess *session.Session
 	var env *config.Environment
@@ -272,6 +398,16 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if o.taskManifest != "" {
+		if err := o.applyTaskManifest(); err != nil {
+			return err
+		}
+	}
+
+	if err := o.applyStorageFlags(); err != nil {
+		return err
+	}
+
 	if o.count <= 0 {
 		return errNumNotPositive
 	}
@@ -300,6 +436,22 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if err := o.validatePlatform(); err != nil {
+		return err
+	}
+
+	if err := o.validateStorage(); err != nil {
+		return err
+	}
+
+	if err := o.validateSchedule(); err != nil {
+		return err
+	}
+
+	if err := o.validateTimeout(); err != nil {
+		return err
+	}
+
 	if err := o.validateFlagsWithCluster(); err != nil {
 		return err
 	}
@@ -331,6 +483,283 @@ func (o *runTaskOpts) Validate() error {
 	return nil
 }
 
+// applyTaskManifest reads the task manifest and uses its values for the flags that aren't explicitly set.
+func (o *runTaskOpts) applyTaskManifest() error {
+	raw, err := o.ws.ReadTaskManifest(o.taskManifest)
+	if err != nil {
+		return err
+	}
+	mft, err := manifest.UnmarshalTask(raw)
+	if err != nil {
+		return fmt.Errorf("read task %s manifest: %w", o.taskManifest, err)
+	}
+
+	if !o.isFlagSet(taskGroupNameFlag) {
+		o.groupName = o.taskManifest
+		if mft.Name != nil {
+			o.groupName = aws.StringValue(mft.Name)
+		}
+	}
+	if !o.isFlagSet(imageFlag) && !o.isFlagSet(dockerFileFlag) {
+		if err := o.applyTaskManifestImage(mft); err != nil {
+			return err
+		}
+	}
+	if !o.isFlagSet(countFlag) && mft.Count != nil {
+		o.count = aws.IntValue(mft.Count)
+	}
+	if !o.isFlagSet(cpuFlag) && mft.CPU != nil {
+		o.cpu = aws.IntValue(mft.CPU)
+	}
+	if !o.isFlagSet(memoryFlag) && mft.Memory != nil {
+		o.memory = aws.IntValue(mft.Memory)
+	}
+	if !o.isFlagSet(taskRoleFlag) && mft.TaskRole != nil {
+		o.taskRole = aws.StringValue(mft.TaskRole)
+	}
+	if !o.isFlagSet(executionRoleFlag) && mft.ExecutionRole != nil {
+		o.executionRole = aws.StringValue(mft.ExecutionRole)
+	}
+	if !o.isFlagSet(entrypointFlag) && mft.EntryPoint != nil {
+		if o.manifestEntrypoint, err = mft.EntryPoint.ToStringSlice(); err != nil {
+			return fmt.Errorf("convert entrypoint of task %s to a string slice: %w", o.taskManifest, err)
+		}
+	}
+	if !o.isFlagSet(commandFlag) && mft.Command != nil {
+		if o.manifestCommand, err = mft.Command.ToStringSlice(); err != nil {
+			return fmt.Errorf("convert command of task %s to a string slice: %w", o.taskManifest, err)
+		}
+	}
+	if !o.isFlagSet(platformFlag) && mft.Platform != nil {
+		o.platform = aws.StringValue(mft.Platform)
+	}
+	o.envVars = mergeStringMaps(mft.Variables, o.envVars)
+	o.secrets = mergeStringMaps(mft.Secrets, o.secrets)
+	o.resourceTags = mergeStringMaps(mft.Tags, o.resourceTags)
+	if mft.Network != nil && mft.Network.VPC != nil {
+		o.privateSubnets = aws.StringValue(mft.Network.VPC.Placement) == manifest.PrivateSubnetPlacement
+		o.extraSecurityGroups = mft.Network.VPC.SecurityGroups
+	}
+	o.storage = mft.Storage
+	return nil
+}
+
+func (o *runTaskOpts) applyTaskManifestImage(mft *manifest.Task) error {
+	buildRequired, err := mft.BuildRequired()
+	if err != nil {
+		return fmt.Errorf("task %s: %w", o.taskManifest, err)
+	}
+	if !buildRequired {
+		o.image = mft.ImageConfig.GetLocation()
+		return nil
+	}
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	o.buildArgs = mft.BuildArgs(filepath.Dir(copilotDir))
+	o.dockerfilePath = aws.StringValue(o.buildArgs.Dockerfile)
+	o.isDockerfileSet = true
+	return nil
+}
+
+// mergeStringMaps returns the union of the maps, where the values of overrides take precedence.
+func mergeStringMaps(base, overrides map[string]string) map[string]string {
+	if len(base) == 0 {
+		return overrides
+	}
+	merged := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
+
+// applyStorageFlags adds the volumes and the ephemeral storage passed with flags to the storage of the task.
+func (o *runTaskOpts) applyStorageFlags() error {
+	if len(o.volumes) == 0 && o.ephemeralStorage == 0 {
+		return nil
+	}
+	if o.storage == nil {
+		o.storage = &manifest.Storage{}
+	}
+	if o.ephemeralStorage != 0 {
+		o.storage.Ephemeral = aws.Int(o.ephemeralStorage)
+	}
+	for _, v := range o.volumes {
+		name, volume, err := parseVolume(v)
+		if err != nil {
+			return err
+		}
+		if o.storage.Volumes == nil {
+			o.storage.Volumes = make(map[string]manifest.Volume)
+		}
+		o.storage.Volumes[name] = volume
+	}
+	return nil
+}
+
+// parseVolume parses a volume of the form name:fsid:/container/path[:ro].
+func parseVolume(in string) (string, manifest.Volume, error) {
+	parts := strings.Split(in, ":")
+	if len(parts) < 3 || len(parts) > 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", manifest.Volume{}, fmt.Errorf(`volume %s is invalid: must be of the form "name:fsid:/container/path[:ro]"`, in)
+	}
+	readOnly := false
+	if len(parts) == 4 {
+		if parts[3] != volumeReadOnlyOption {
+			return "", manifest.Volume{}, fmt.Errorf(`volume %s is invalid: the only supported option is "%s"`, in, volumeReadOnlyOption)
+		}
+		readOnly = true
+	}
+	efs := &manifest.EFSConfigOrBool{
+		Advanced: manifest.EFSVolumeConfiguration{
+			FileSystemID: aws.String(parts[1]),
+		},
+	}
+	if parts[1] == copilotManagedFileSystemID {
+		efs = &manifest.EFSConfigOrBool{
+			Enabled: aws.Bool(true),
+		}
+	}
+	return parts[0], manifest.Volume{
+		EFS: efs,
+		MountPointOpts: manifest.MountPointOpts{
+			ContainerPath: aws.String(parts[2]),
+			ReadOnly:      aws.Bool(readOnly),
+		},
+	}, nil
+}
+
+// runtimePlatform returns the platform the task runs on, which defaults to linux/x86_64.
+func (o *runTaskOpts) runtimePlatform() taskPlatform {
+	if o.platform == "" {
+		return linuxX86Platform
+	}
+	return taskPlatforms[o.platform]
+}
+
+func (o *runTaskOpts) validatePlatform() error {
+	if o.platform != "" {
+		if err := validateTaskPlatform(o.platform); err != nil {
+			return err
+		}
+	}
+	platform := o.runtimePlatform()
+	if o.spot && platform != linuxX86Platform {
+		return fmt.Errorf("cannot run tasks on Fargate Spot with platform %s", o.platform)
+	}
+	if platform.os == deploy.TaskOSWindows {
+		if o.cpu < windowsMinCPUUnits {
+			return fmt.Errorf("platform %s requires at least %d CPU units", o.platform, windowsMinCPUUnits)
+		}
+		if o.storage != nil {
+			return fmt.Errorf("volumes and ephemeral storage are not supported with platform %s", o.platform)
+		}
+	}
+	return validateFargateResources(o.cpu, o.memory)
+}
+
+// validateFargateResources returns an error if the combination of CPU units and memory is not supported by Fargate.
+func validateFargateResources(cpu, memory int) error {
+	memRange, ok := fargateMemoryRangesMiB[cpu]
+	if !ok {
+		return fmt.Errorf("%d CPU units are not supported: must be one of %s", cpu, english.OxfordWordSeries(intsToStrings(fargateCPUUnits), "or"))
+	}
+	if cpu == 256 && memory == 512 {
+		return nil
+	}
+	if memory < memRange[0] || memory > memRange[1] || memory%1024 != 0 {
+		return fmt.Errorf("memory %d MiB is not supported with %d CPU units: must be between %d and %d MiB in 1024 MiB increments", memory, cpu, memRange[0], memRange[1])
+	}
+	return nil
+}
+
+func intsToStrings(in []int) []string {
+	out := make([]string, len(in))
+	for i, v := range in {
+		out[i] = strconv.Itoa(v)
+	}
+	return out
+}
+
+func (o *runTaskOpts) validateStorage() error {
+	if o.storage == nil {
+		return nil
+	}
+	if o.storage.Ephemeral != nil {
+		size := aws.IntValue(o.storage.Ephemeral)
+		if size < ephemeralMinValueGiB || size > ephemeralMaxValueGiB {
+			return errEphemeralSize
+		}
+	}
+	for name, volume := range o.storage.Volumes {
+		if volume.EmptyVolume() || !volume.EFS.UseManagedFS() {
+			continue
+		}
+		if o.env == "" {
+			return fmt.Errorf("volume %s uses the Copilot-managed EFS file system, which requires `--env`", name)
+		}
+	}
+	return nil
+}
+
+func (o *runTaskOpts) validateTimeout() error {
+	if o.timeout == 0 {
+		return nil
+	}
+	if o.timeout < 0 {
+		return errors.New("timeout must be positive")
+	}
+	if !o.follow {
+		return errors.New("cannot specify `--timeout` without `--follow`")
+	}
+	return nil
+}
+
+func (o *runTaskOpts) validateSchedule() error {
+	if o.schedule == "" {
+		if o.until != "" {
+			return errors.New("cannot specify `--until` without `--schedule`")
+		}
+		return nil
+	}
+	if err := validateSchedule(o.schedule); err != nil {
+		return err
+	}
+	if o.follow {
+		return errors.New("cannot specify both `--schedule` and `--follow`")
+	}
+	if o.until == "" {
+		return nil
+	}
+	until, err := parseUntil(o.until, time.Now())
+	if err != nil {
+		return err
+	}
+	o.scheduleUntil = &until
+	return nil
+}
+
+// parseUntil parses an RFC3339 timestamp or a duration from now into a time in the future.
+func parseUntil(in string, now time.Time) (time.Time, error) {
+	until, err := time.Parse(time.RFC3339, in)
+	if err != nil {
+		d, durationErr := time.ParseDuration(in)
+		if durationErr != nil {
+			return time.Time{}, fmt.Errorf(`until %s is invalid: must be an RFC3339 timestamp such as "2021-06-30T17:00:00Z" or a duration such as "72h"`, in)
+		}
+		until = now.Add(d)
+	}
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("until %s must be in the future", in)
+	}
+	return until, nil
+}
+
 func (o *runTaskOpts) validateFlagsWithCluster() error {
 	if o.cluster == "" {
 		return nil
@@ -472,6 +901,14 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
+	if o.schedule != "" {
+		if err := o.configureSchedule(); err != nil {
+			return err
+		}
+	} else if err := o.checkExistingSchedule(); err != nil {
+		return err
+	}
+
 	if err := o.deployTaskResources(); err != nil {
 		return err
 	}
@@ -497,6 +934,11 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
+	if o.taskSchedule != nil {
+		log.Successf("Scheduled task %s to run on schedule %s.\n", o.groupName, color.HighlightUserInput(o.schedule))
+		return nil
+	}
+
 	tasks, err := o.runTask()
 	if err != nil {
 		return err
@@ -506,9 +948,48 @@ func (o *runTaskOpts) Execute() error {
 
 	if o.follow {
 		o.configureEventsWriter(tasks)
-		if err := o.displayLogStream(); err != nil {
+		if err := o.displayLogStream(tasks); err != nil {
 			return err
 		}
+		return o.checkStoppedTasks()
+	}
+	return nil
+}
+
+// checkExistingSchedule returns an error if the task group already runs on a schedule,
+// since deploying its resources without a schedule would remove it.
+func (o *runTaskOpts) checkExistingSchedule() error {
+	info, err := o.deployer.GetTaskStack(o.groupName)
+	if err != nil {
+		var errStackNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get stack of task %s: %w", o.groupName, err)
+	}
+	if info.Schedule == "" {
+		return nil
+	}
+	return fmt.Errorf("task %s runs on schedule %s: specify `--%s` to update the schedule, or delete the task with %s before running it once",
+		o.groupName, info.Schedule, scheduleFlag, color.HighlightCode(fmt.Sprintf("copilot task delete -n %s", o.groupName)))
+}
+
+// configureSchedule resolves the cluster and the network configuration that the schedule runs the tasks with.
+func (o *runTaskOpts) configureSchedule() error {
+	input, err := o.runner.RunTaskInput()
+	if err != nil {
+		return fmt.Errorf("get configuration to schedule task %s: %w", o.groupName, err)
+	}
+	o.taskSchedule = &deploy.TaskSchedule{
+		Expression:      o.schedule,
+		Until:           o.scheduleUntil,
+		Cluster:         input.Cluster,
+		Count:           input.Count,
+		Subnets:         input.Subnets,
+		SecurityGroups:  input.SecurityGroups,
+		AssignPublicIP:  !input.DisablePublicIP,
+		Spot:            input.Spot,
+		PlatformVersion: input.PlatformVersion,
 	}
 	return nil
 }
@@ -629,8 +1110,19 @@ func (o *runTaskOpts) workloadType(appName, workloadName string) (string, error)
 	return workloadTypeInvalid, fmt.Errorf("workload %s is neither a service nor a job", workloadName)
 }
 
-func (o *runTaskOpts) displayLogStream() error {
-	if err := o.eventsWriter.WriteEventsUntilStopped(); err != nil {
+func (o *runTaskOpts) displayLogStream(tasks []*task.Task) error {
+	err := o.eventsWriter.WriteEventsUntilStopped()
+	if errors.Is(err, logging.ErrTaskTimeout) {
+		log.Warningf("%s still running after %s, stopping %s.\n",
+			english.PluralWord(o.count, "Task is", "Tasks are"), o.timeout,
+			english.PluralWord(o.count, "it", "them"))
+		if err := o.stopTasks(tasks); err != nil {
+			return err
+		}
+		// Keep writing the events of the tasks until they stop.
+		err = o.eventsWriter.WriteEventsUntilStopped()
+	}
+	if err != nil {
 		return fmt.Errorf("write events: %w", err)
 	}
 
@@ -640,6 +1132,56 @@ func (o *runTaskOpts) displayLogStream() error {
 	return nil
 }
 
+func (o *runTaskOpts) stopTasks(tasks []*task.Task) error {
+	taskARNs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskARNs[i] = t.TaskARN
+	}
+	// NOTE: all tasks are run in the same cluster.
+	err := o.taskStopper.StopTasks(taskARNs,
+		awsecs.WithStopTaskCluster(tasks[0].ClusterARN),
+		awsecs.WithStopTaskReason(fmt.Sprintf(fmtTaskTimeoutStopReason, o.timeout)))
+	if err != nil {
+		return fmt.Errorf("stop tasks %s after timeout: %w", o.groupName, err)
+	}
+	return nil
+}
+
+// checkStoppedTasks prints the stop reason of each task, and returns an error with the exit code of the
+// essential container of the first task that failed.
+func (o *runTaskOpts) checkStoppedTasks() error {
+	var exitErr *errTaskExit
+	for _, t := range o.eventsWriter.StoppedTasks() {
+		taskID, _ := awsecs.TaskID(aws.StringValue(t.TaskArn))
+		if len(taskID) > shortTaskIDLength {
+			taskID = taskID[:shortTaskIDLength]
+		}
+		reason := aws.StringValue(t.StoppedReason)
+		exitCode := taskExitCode(t, o.groupName)
+		if exitCode != nil && *exitCode == 0 {
+			log.Successf("Task %s exited with code 0: %s\n", taskID, reason)
+			continue
+		}
+		err := &errTaskExit{
+			taskID:   taskID,
+			exitCode: 1, // Tasks that stopped before the container exited, for example because the image couldn't be pulled, failed.
+		}
+		if exitCode != nil {
+			err.exitCode = int(*exitCode)
+			log.Errorf("Task %s exited with code %d: %s\n", taskID, err.exitCode, reason)
+		} else {
+			log.Errorf("Task %s stopped without an exit code: %s\n", taskID, reason)
+		}
+		if exitErr == nil {
+			exitErr = err
+		}
+	}
+	if exitErr != nil {
+		return exitErr
+	}
+	return nil
+}
+
 func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	o.spinner.Start(fmt.Sprintf("Waiting for %s to be running for %s.", english.Plural(o.count, "task", ""), o.groupName))
 	tasks, err := o.runner.Run()
@@ -686,11 +1228,22 @@ func (o *runTaskOpts) buildAndPushImage() error {
 		additionalTags = append(additionalTags, o.imageTag)
 	}
 
-	if _, err := o.repository.BuildAndPush(exec.NewDockerCommand(), &exec.BuildArguments{
+	builder, err := newLocalContainerBuilder()
+	if err != nil {
+		return err
+	}
+	args := &exec.BuildArguments{
 		Dockerfile: o.dockerfilePath,
 		Context:    filepath.Dir(o.dockerfilePath),
-		Tags:       append([]string{imageTagLatest}, additionalTags...),
-	}); err != nil {
+	}
+	if o.buildArgs != nil {
+		args = toBuildArguments(o.buildArgs, "")
+	}
+	args.Tags = append([]string{imageTagLatest}, additionalTags...)
+	if o.platform != "" {
+		args.Platform = o.runtimePlatform().buildTarget
+	}
+	if _, err := o.repository.BuildAndPush(builder, args); err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
 	return nil
@@ -720,11 +1273,17 @@ func (o *runTaskOpts) deploy() error {
 	if err != nil {
 		return fmt.Errorf("split entrypoint %s into tokens using shell-style rules: %w", o.entrypoint, err)
 	}
+	if o.entrypoint == "" && o.manifestEntrypoint != nil {
+		entrypoint = o.manifestEntrypoint
+	}
 
 	command, err := shlex.Split(o.command)
 	if err != nil {
 		return fmt.Errorf("split command %s into tokens using shell-style rules: %w", o.command, err)
 	}
+	if o.command == "" && o.manifestCommand != nil {
+		command = o.manifestCommand
+	}
 
 	input := &deploy.CreateTaskResourcesInput{
 		Name:           o.groupName,
@@ -739,8 +1298,14 @@ func (o *runTaskOpts) deploy() error {
 		Secrets:        o.secrets,
 		App:            o.appName,
 		Env:            o.env,
+		Storage:        o.storage,
+		Schedule:       o.taskSchedule,
 		AdditionalTags: o.resourceTags,
 	}
+	if o.platform != "" {
+		platform := o.runtimePlatform()
+		input.OS, input.Arch = platform.os, platform.arch
+	}
 	return o.deployer.DeployTask(os.Stderr, input, deployOpts...)
 }
 
@@ -832,13 +1397,26 @@ Run a task with environment variables.
 Run a task using the current workspace with specific subnets and security groups.
 /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
 Run a task with a command.
-/code $ copilot task run --command "python migrate-script.py"`,
+/code $ copilot task run --command "python migrate-script.py"
+Run the task defined in copilot/tasks/db-migrate/manifest.yml in the "test" environment.
+/code $ copilot task run --task-manifest db-migrate --env test
+Run a task that mounts the Copilot-managed EFS file system of the "test" environment at /var/data.
+/code $ copilot task run --env test --volume data:copilot:/var/data --ephemeral-storage 50
+Run 10 retryable tasks on Fargate Spot.
+/code $ copilot task run --count 10 --spot --command "python process-batch.py"
+Run a task on ARM64 with 1 vCPU and 2GB memory.
+/code $ copilot task run --platform linux/arm64 --cpu 1024 --memory 2048
+Run a task every 15 minutes in the "test" environment for the next 3 days.
+/code $ copilot task run -n reconcile --env test --schedule "@every 15m" --until 72h
+Run a database migration, stop it if it runs for more than 30 minutes, and exit with its exit code.
+/code $ copilot task run -n db-migrate --env test --follow --timeout 30m`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
 				return err
 			}
 			opts.nFlag = cmd.Flags().NFlag()
+			opts.isFlagSet = cmd.Flags().Changed
 			if cmd.Flags().Changed(dockerFileFlag) {
 				opts.isDockerfileSet = true
 			}
@@ -883,9 +1461,18 @@ Run a task with a command.
 	cmd.Flags().StringVar(&vars.command, commandFlag, "", runCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.entrypoint, entrypointFlag, "", entrypointFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.volumes, volumeFlag, nil, volumeFlagDescription)
+	cmd.Flags().IntVar(&vars.ephemeralStorage, ephemeralFlag, 0, ephemeralFlagDescription)
+	cmd.Flags().StringVar(&vars.platform, platformFlag, "", taskPlatformFlagDescription)
+	cmd.Flags().BoolVar(&vars.spot, spotFlag, false, spotFlagDescription)
+
+	cmd.Flags().StringVar(&vars.schedule, scheduleFlag, "", taskScheduleFlagDescription)
+	cmd.Flags().StringVar(&vars.until, untilFlag, "", untilFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, 0, taskTimeoutFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
+	cmd.Flags().StringVar(&vars.taskManifest, taskManifestFlag, "", taskManifestFlagDescription)
 
 	return cmd
 }
@@ -8,14 +8,21 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/golang/mock/gomock"
@@ -65,6 +72,16 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 		inDefault               bool
 		inGenerateCommandTarget string
 
+		inVolumes          []string
+		inEphemeralStorage int
+		inPlatform         string
+		inSpot             bool
+
+		inSchedule string
+		inUntil    string
+		inFollow   bool
+		inTimeout  time.Duration
+
 		appName         string
 		isDockerfileSet bool
 
@@ -312,6 +329,130 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("cannot specify `--generate-cmd` with any other flag"),
 		},
+		"valid with volumes and ephemeral storage": {
+			basicOpts:          defaultOpts,
+			inVolumes:          []string{"data:fs-1234:/var/data", "config:fs-5678:/etc/config:ro"},
+			inEphemeralStorage: 50,
+		},
+		"invalid volume": {
+			basicOpts:   defaultOpts,
+			inVolumes:   []string{"data:fs-1234"},
+			wantedError: errors.New(`volume data:fs-1234 is invalid: must be of the form "name:fsid:/container/path[:ro]"`),
+		},
+		"invalid volume option": {
+			basicOpts:   defaultOpts,
+			inVolumes:   []string{"data:fs-1234:/var/data:rw"},
+			wantedError: errors.New(`volume data:fs-1234:/var/data:rw is invalid: the only supported option is "ro"`),
+		},
+		"invalid ephemeral storage": {
+			basicOpts:          defaultOpts,
+			inEphemeralStorage: 300,
+			wantedError:        errEphemeralSize,
+		},
+		"valid Windows task": {
+			basicOpts: basicOpts{
+				inCount:  1,
+				inCPU:    1024,
+				inMemory: 2048,
+			},
+			inPlatform: "windows/x86_64",
+		},
+		"unsupported platform": {
+			basicOpts:   defaultOpts,
+			inPlatform:  "linux/arm/v7",
+			wantedError: errors.New(`platform linux/arm/v7 is not supported: must be one of "linux/x86_64", "linux/arm64" or "windows/x86_64"`),
+		},
+		"Fargate Spot with an ARM64 platform": {
+			basicOpts:   defaultOpts,
+			inPlatform:  "linux/arm64",
+			inSpot:      true,
+			wantedError: errors.New("cannot run tasks on Fargate Spot with platform linux/arm64"),
+		},
+		"not enough CPU units for Windows": {
+			basicOpts:   defaultOpts,
+			inPlatform:  "windows/x86_64",
+			wantedError: errors.New("platform windows/x86_64 requires at least 1024 CPU units"),
+		},
+		"ephemeral storage with Windows": {
+			basicOpts: basicOpts{
+				inCount:  1,
+				inCPU:    1024,
+				inMemory: 2048,
+			},
+			inPlatform:         "windows/x86_64",
+			inEphemeralStorage: 50,
+			wantedError:        errors.New("volumes and ephemeral storage are not supported with platform windows/x86_64"),
+		},
+		"unsupported CPU units": {
+			basicOpts: basicOpts{
+				inCount:  1,
+				inCPU:    300,
+				inMemory: 512,
+			},
+			wantedError: errors.New("300 CPU units are not supported: must be one of 256, 512, 1024, 2048, or 4096"),
+		},
+		"unsupported memory for the CPU units": {
+			basicOpts: basicOpts{
+				inCount:  1,
+				inCPU:    1024,
+				inMemory: 1024,
+			},
+			wantedError: errors.New("memory 1024 MiB is not supported with 1024 CPU units: must be between 2048 and 8192 MiB in 1024 MiB increments"),
+		},
+		"managed volume without an environment": {
+			basicOpts:   defaultOpts,
+			inVolumes:   []string{"data:copilot:/var/data"},
+			wantedError: errors.New("volume data uses the Copilot-managed EFS file system, which requires `--env`"),
+		},
+		"valid schedule with an expiry": {
+			basicOpts:  defaultOpts,
+			inSchedule: "@every 15m",
+			inUntil:    "72h",
+		},
+		"invalid schedule": {
+			basicOpts:   defaultOpts,
+			inSchedule:  "every day",
+			wantedError: errors.New("schedule every day is invalid: " + errScheduleInvalid.Error()),
+		},
+		"until without schedule": {
+			basicOpts:   defaultOpts,
+			inUntil:     "72h",
+			wantedError: errors.New("cannot specify `--until` without `--schedule`"),
+		},
+		"schedule with follow": {
+			basicOpts:   defaultOpts,
+			inSchedule:  "@daily",
+			inFollow:    true,
+			wantedError: errors.New("cannot specify both `--schedule` and `--follow`"),
+		},
+		"timeout with follow": {
+			basicOpts: defaultOpts,
+			inFollow:  true,
+			inTimeout: 30 * time.Minute,
+		},
+		"timeout without follow": {
+			basicOpts:   defaultOpts,
+			inTimeout:   30 * time.Minute,
+			wantedError: errors.New("cannot specify `--timeout` without `--follow`"),
+		},
+		"negative timeout": {
+			basicOpts:   defaultOpts,
+			inFollow:    true,
+			inTimeout:   -time.Minute,
+			wantedError: errors.New("timeout must be positive"),
+		},
+		"invalid until": {
+			basicOpts:   defaultOpts,
+			inSchedule:  "@daily",
+			inUntil:     "next week",
+			wantedError: errors.New(`until next week is invalid: must be an RFC3339 timestamp such as "2021-06-30T17:00:00Z" or a duration such as "72h"`),
+		},
+		"until in the past": {
+			basicOpts:   defaultOpts,
+			inSchedule:  "@daily",
+			inUntil:     "2021-03-14T15:09:26Z",
+			wantedError: errors.New("until 2021-03-14T15:09:26Z must be in the future"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -341,6 +482,14 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					entrypoint:                  tc.inEntryPoint,
 					useDefaultSubnetsAndCluster: tc.inDefault,
 					generateCommandTarget:       tc.inGenerateCommandTarget,
+					volumes:                     tc.inVolumes,
+					ephemeralStorage:            tc.inEphemeralStorage,
+					platform:                    tc.inPlatform,
+					spot:                        tc.inSpot,
+					schedule:                    tc.inSchedule,
+					until:                       tc.inUntil,
+					follow:                      tc.inFollow,
+					timeout:                     tc.inTimeout,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 				nFlag:           2,
@@ -366,6 +515,140 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 	}
 }
 
+func TestTaskRunOpts_applyTaskManifest(t *testing.T) {
+	mockManifest := []byte(`name: db-migrate
+image:
+  build:
+    dockerfile: migrations/Dockerfile
+    args:
+      GOPROXY: direct
+platform: linux/arm64
+cpu: 1024
+command: python migrate.py
+variables:
+  LOG_LEVEL: info
+  STAGE: test
+network:
+  vpc:
+    placement: private
+    security_groups: ["sg-123"]
+storage:
+  ephemeral: 30
+`)
+	testCases := map[string]struct {
+		inVars     runTaskVars
+		inSetFlags []string
+		setupMocks func(m *mocks.MockwsTaskReader)
+
+		wantedOpts  func(t *testing.T, o *runTaskOpts)
+		wantedError error
+	}{
+		"errors if the manifest cannot be read": {
+			inVars: runTaskVars{taskManifest: "db-migrate"},
+			setupMocks: func(m *mocks.MockwsTaskReader) {
+				m.EXPECT().ReadTaskManifest("db-migrate").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"errors if the manifest has both an image location and a build": {
+			inVars: runTaskVars{taskManifest: "db-migrate"},
+			setupMocks: func(m *mocks.MockwsTaskReader) {
+				m.EXPECT().ReadTaskManifest("db-migrate").Return([]byte(`image:
+  build: Dockerfile
+  location: flyway/flyway
+`), nil)
+			},
+			wantedError: errors.New(`task db-migrate: either "image.build" or "image.location" needs to be specified in the manifest`),
+		},
+		"uses the values of the manifest for the flags that are not set": {
+			inVars: runTaskVars{
+				taskManifest: "db-migrate",
+				count:        1,
+				cpu:          256,
+				memory:       512,
+				envVars: map[string]string{
+					"STAGE": "prod",
+				},
+			},
+			inSetFlags: []string{envVarsFlag},
+			setupMocks: func(m *mocks.MockwsTaskReader) {
+				m.EXPECT().ReadTaskManifest("db-migrate").Return(mockManifest, nil)
+				m.EXPECT().CopilotDirPath().Return(filepath.Join("ws", "copilot"), nil)
+			},
+			wantedOpts: func(t *testing.T, o *runTaskOpts) {
+				require.Equal(t, "db-migrate", o.groupName)
+				require.Equal(t, 1024, o.cpu)
+				require.Equal(t, 512, o.memory)
+				require.Equal(t, filepath.Join("ws", "migrations", "Dockerfile"), o.dockerfilePath)
+				require.True(t, o.isDockerfileSet)
+				require.Equal(t, map[string]string{"GOPROXY": "direct"}, o.buildArgs.Args)
+				require.Equal(t, "linux/arm64", o.platform)
+				require.Equal(t, []string{"python", "migrate.py"}, o.manifestCommand)
+				require.Equal(t, map[string]string{
+					"LOG_LEVEL": "info",
+					"STAGE":     "prod",
+				}, o.envVars)
+				require.True(t, o.privateSubnets)
+				require.Equal(t, []string{"sg-123"}, o.extraSecurityGroups)
+				require.Equal(t, aws.Int(30), o.storage.Ephemeral)
+			},
+		},
+		"flags take precedence over the manifest": {
+			inVars: runTaskVars{
+				taskManifest: "db-migrate",
+				groupName:    "migrate-once",
+				image:        "flyway/flyway",
+				cpu:          512,
+				command:      "migrate",
+			},
+			inSetFlags: []string{taskGroupNameFlag, imageFlag, cpuFlag, commandFlag},
+			setupMocks: func(m *mocks.MockwsTaskReader) {
+				m.EXPECT().ReadTaskManifest("db-migrate").Return(mockManifest, nil)
+				m.EXPECT().CopilotDirPath().Times(0)
+			},
+			wantedOpts: func(t *testing.T, o *runTaskOpts) {
+				require.Equal(t, "migrate-once", o.groupName)
+				require.Equal(t, "flyway/flyway", o.image)
+				require.Nil(t, o.buildArgs)
+				require.Equal(t, 512, o.cpu)
+				require.Nil(t, o.manifestCommand)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsTaskReader(ctrl)
+			tc.setupMocks(m)
+			setFlags := make(map[string]bool)
+			for _, f := range tc.inSetFlags {
+				setFlags[f] = true
+			}
+			opts := &runTaskOpts{
+				runTaskVars: tc.inVars,
+				isFlagSet: func(name string) bool {
+					return setFlags[name]
+				},
+				ws: m,
+			}
+
+			// WHEN
+			err := opts.applyTaskManifest()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			tc.wantedOpts(t, opts)
+		})
+	}
+}
+
 func TestTaskRunOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inName string
@@ -619,6 +902,7 @@ type runTaskMocks struct {
 	runner               *mocks.MocktaskRunner
 	store                *mocks.Mockstore
 	eventsWriter         *mocks.MockeventsWriter
+	taskStopper          *mocks.MockecsTaskStopper
 	defaultClusterGetter *mocks.MockdefaultClusterGetter
 	publicIPGetter       *mocks.MockpublicIPGetter
 }
@@ -648,8 +932,11 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inImage      string
 		inTag        string
 		inFollow     bool
+		inTimeout    time.Duration
 		inCommand    string
 		inEntryPoint string
+		inPlatform   string
+		inSchedule   string
 
 		inEnv string
 
@@ -680,6 +967,28 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.runner.EXPECT().Run().AnyTimes()
 			},
 		},
+		"error if the task already runs on a schedule": {
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).AnyTimes()
+				mockHasDefaultCluster(m)
+				m.deployer.EXPECT().GetTaskStack(inGroupName).Return(&deploy.TaskStackInfo{
+					StackName: "task-my-task",
+					Schedule:  "rate(15 minutes)",
+				}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf("task my-task runs on schedule rate(15 minutes): specify `--schedule` to update the schedule, or delete the task with %s before running it once",
+				color.HighlightCode("copilot task delete -n my-task")),
+		},
+		"error if the stack of the task cannot be retrieved": {
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).AnyTimes()
+				mockHasDefaultCluster(m)
+				m.deployer.EXPECT().GetTaskStack(inGroupName).Return(nil, errors.New("some error"))
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("get stack of task my-task: some error"),
+		},
 		"error deploying resources": {
 			setupMocks: func(m runTaskMocks) {
 				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).AnyTimes()
@@ -794,6 +1103,74 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				mockHasDefaultCluster(m)
 			},
 		},
+		"build the image and run the task on the platform": {
+			inPlatform: "linux/arm64",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).AnyTimes()
+				m.deployer.EXPECT().DeployTask(gomock.Any(), &deploy.CreateTaskResourcesInput{
+					Name:       inGroupName,
+					Image:      "",
+					Command:    []string{},
+					EntryPoint: []string{},
+					OS:         "LINUX",
+					Arch:       "ARM64",
+				}).Return(nil)
+				m.repository.EXPECT().BuildAndPush(gomock.Any(), gomock.Eq(&exec.BuildArguments{
+					Context:  filepath.Dir(defaultDockerfilePath),
+					Tags:     []string{imageTagLatest},
+					Platform: "linux/arm64",
+				}))
+				m.repository.EXPECT().URI().Return(mockRepoURI)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), &deploy.CreateTaskResourcesInput{
+					Name:       inGroupName,
+					Image:      "uri/repo:latest",
+					Command:    []string{},
+					EntryPoint: []string{},
+					OS:         "LINUX",
+					Arch:       "ARM64",
+				}).Return(nil)
+				m.runner.EXPECT().Run().AnyTimes()
+				mockHasDefaultCluster(m)
+			},
+		},
+		"deploy the schedule instead of running the task": {
+			inImage:    "image",
+			inSchedule: "@daily",
+			setupMocks: func(m runTaskMocks) {
+				m.runner.EXPECT().RunTaskInput().Return(&awsecs.RunTaskInput{
+					Cluster:        "arn:aws:ecs:us-west-2:123456789012:cluster/default",
+					Count:          1,
+					Subnets:        []string{"subnet-1"},
+					SecurityGroups: []string{"sg-1"},
+				}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), &deploy.CreateTaskResourcesInput{
+					Name:       inGroupName,
+					Image:      "image",
+					Command:    []string{},
+					EntryPoint: []string{},
+					Schedule: &deploy.TaskSchedule{
+						Expression:     "@daily",
+						Cluster:        "arn:aws:ecs:us-west-2:123456789012:cluster/default",
+						Count:          1,
+						Subnets:        []string{"subnet-1"},
+						SecurityGroups: []string{"sg-1"},
+						AssignPublicIP: true,
+					},
+				}).Return(nil)
+				mockRepositoryAnytime(m)
+				m.runner.EXPECT().Run().Times(0)
+				mockHasDefaultCluster(m)
+			},
+		},
+		"error getting the configuration of the schedule": {
+			inImage:    "image",
+			inSchedule: "@daily",
+			setupMocks: func(m runTaskMocks) {
+				m.runner.EXPECT().RunTaskInput().Return(nil, errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("get configuration to schedule task my-task: some error"),
+		},
 		"fail to get ENI information for some tasks": {
 			setupMocks: func(m runTaskMocks) {
 				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
@@ -847,6 +1224,117 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"exit with the exit code of the first failed task": {
+			inFollow: true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.eventsWriter.EXPECT().StoppedTasks().Return([]*awsecs.Task{
+					{
+						TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/9c36f4a1ab8a4c1e8d4a2d2b50f2e6c0"),
+						StoppedReason: aws.String("Essential container in task exited"),
+						Containers: []*ecsapi.Container{
+							{
+								Name:     aws.String(inGroupName),
+								ExitCode: aws.Int64(0),
+							},
+						},
+					},
+					{
+						TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d"),
+						StoppedReason: aws.String("Essential container in task exited"),
+						Containers: []*ecsapi.Container{
+							{
+								Name:     aws.String("firelens_log_router"),
+								ExitCode: aws.Int64(0),
+							},
+							{
+								Name:     aws.String(inGroupName),
+								ExitCode: aws.Int64(2),
+							},
+						},
+					},
+				})
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("task 4082490e exited with code 2"),
+		},
+		"exit with code 1 if a task stopped without an exit code": {
+			inFollow: true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.eventsWriter.EXPECT().StoppedTasks().Return([]*awsecs.Task{
+					{
+						TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d"),
+						StoppedReason: aws.String("CannotPullContainerError: pull image manifest has been retried 5 time(s)"),
+					},
+				})
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("task 4082490e exited with code 1"),
+		},
+		"stop the tasks after the timeout and keep writing their events": {
+			inFollow:  true,
+			inTimeout: 30 * time.Minute,
+			inImage:   "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    "task-1",
+						ClusterARN: "cluster-1",
+					},
+				}, nil)
+				gomock.InOrder(
+					m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(logging.ErrTaskTimeout),
+					m.taskStopper.EXPECT().StopTasks([]string{"task-1"}, gomock.Any(), gomock.Any()).Return(nil),
+					m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil),
+					m.eventsWriter.EXPECT().StoppedTasks().Return([]*awsecs.Task{
+						{
+							TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d"),
+							Containers: []*ecsapi.Container{
+								{
+									Name:     aws.String(inGroupName),
+									ExitCode: aws.Int64(0),
+								},
+							},
+						},
+					}),
+				)
+				mockHasDefaultCluster(m)
+			},
+		},
+		"fail to stop the tasks after the timeout": {
+			inFollow:  true,
+			inTimeout: 30 * time.Minute,
+			inImage:   "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    "task-1",
+						ClusterARN: "cluster-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(logging.ErrTaskTimeout)
+				m.taskStopper.EXPECT().StopTasks([]string{"task-1"}, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("stop tasks my-task after timeout: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -860,10 +1348,12 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				runner:               mocks.NewMocktaskRunner(ctrl),
 				store:                mocks.NewMockstore(ctrl),
 				eventsWriter:         mocks.NewMockeventsWriter(ctrl),
+				taskStopper:          mocks.NewMockecsTaskStopper(ctrl),
 				defaultClusterGetter: mocks.NewMockdefaultClusterGetter(ctrl),
 				publicIPGetter:       mocks.NewMockpublicIPGetter(ctrl),
 			}
 			tc.setupMocks(mocks)
+			mocks.deployer.EXPECT().GetTaskStack(inGroupName).Return(nil, &awscloudformation.ErrStackNotFound{}).AnyTimes()
 
 			opts := &runTaskOpts{
 				runTaskVars: runTaskVars{
@@ -873,9 +1363,12 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					imageTag:   tc.inTag,
 					env:        tc.inEnv,
 					follow:     tc.inFollow,
+					timeout:    tc.inTimeout,
 					secrets:    tc.inSecrets,
 					command:    tc.inCommand,
 					entrypoint: tc.inEntryPoint,
+					platform:   tc.inPlatform,
+					schedule:   tc.inSchedule,
 				},
 				spinner: &mockSpinner{},
 				store:   mocks.store,
@@ -883,6 +1376,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			opts.configureRuntimeOpts = func() error {
 				opts.runner = mocks.runner
 				opts.deployer = mocks.deployer
+				opts.taskStopper = mocks.taskStopper
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
 				opts.publicIPGetter = mocks.publicIPGetter
 				return nil
@@ -1067,3 +1561,39 @@ func TestTaskRunOpts_runTaskCommand(t *testing.T) {
 		})
 	}
 }
+
+type mockProcessExitError struct{}
+
+func (e *mockProcessExitError) Error() string { return "exit status 2" }
+func (e *mockProcessExitError) ExitCode() int { return 2 }
+
+func TestExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wanted int
+	}{
+		"should exit with the exit code of a followed task": {
+			inErr:  &errTaskExit{taskID: "4082490e", exitCode: 3},
+			wanted: 3,
+		},
+		"should exit with the exit code of a wrapped followed task": {
+			inErr:  fmt.Errorf("follow task: %w", &errTaskExit{taskID: "4082490e", exitCode: 3}),
+			wanted: 3,
+		},
+		"should exit with 1 on errors of child processes": {
+			inErr:  fmt.Errorf("building image: %w", &mockProcessExitError{}),
+			wanted: 1,
+		},
+		"should exit with 1 on other errors": {
+			inErr:  errors.New("some error"),
+			wanted: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ExitCode(tc.inErr))
+		})
+	}
+}
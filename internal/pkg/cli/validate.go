@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -218,6 +219,22 @@ func validateAppRunnerImage(img interface{}) error {
 	return nil
 }
 
+// validateSourceRepoURL validates that the value is the HTTPS URL of a source code repository.
+func validateSourceRepoURL(val interface{}) error {
+	repo, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if repo == "" {
+		return errValueEmpty
+	}
+	u, err := url.Parse(repo)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("source repository %s must be an https URL", repo)
+	}
+	return nil
+}
+
 func validateTimeout(timeout interface{}) error {
 	t, ok := timeout.(string)
 	if !ok {
@@ -229,6 +246,13 @@ func validateTimeout(timeout interface{}) error {
 	return nil
 }
 
+func validateTaskPlatform(platform string) error {
+	if _, ok := taskPlatforms[platform]; !ok {
+		return fmt.Errorf(`platform %s is not supported: must be one of "linux/x86_64", "linux/arm64" or "windows/x86_64"`, platform)
+	}
+	return nil
+}
+
 func validateRate(rate interface{}) error {
 	r, ok := rate.(string)
 	if !ok {
@@ -674,3 +674,37 @@ func TestValidateSecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSourceRepoURL(t *testing.T) {
+	testCases := map[string]struct {
+		input interface{}
+		want  error
+	}{
+		"not a string": {
+			input: 80,
+			want:  errValueNotAString,
+		},
+		"empty": {
+			input: "",
+			want:  errValueEmpty,
+		},
+		"not an https URL": {
+			input: "git@github.com:user/repo.git",
+			want:  errors.New("source repository git@github.com:user/repo.git must be an https URL"),
+		},
+		"valid repository URL": {
+			input: "https://github.com/user/repo",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateSourceRepoURL(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
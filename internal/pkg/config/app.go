@@ -20,6 +20,16 @@ type Application struct {
 	DomainHostedZoneID string            `json:"domainHostedZoneID"` // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
 	Version            string            `json:"version"`            // The version of the app layout in the underlying datastore (e.g. SSM).
 	Tags               map[string]string `json:"tags,omitempty"`     // Labels to apply to resources created within the app.
+
+	ImageRepository *ImageRepositoryConfig `json:"imageRepository,omitempty"` // Settings of the ECR repositories of the app's workloads.
+}
+
+// ImageRepositoryConfig holds the settings applied to the ECR repositories that store the images of the workloads.
+type ImageRepositoryConfig struct {
+	ScanOnPush         bool `json:"scanOnPush,omitempty"`         // Whether images are scanned for vulnerabilities after being pushed.
+	ImmutableTags      bool `json:"immutableTags,omitempty"`      // Whether image tags can't be overwritten.
+	MaxImageCount      int  `json:"maxImageCount,omitempty"`      // Number of most recent images to keep. Zero keeps all images.
+	UntaggedExpiryDays int  `json:"untaggedExpiryDays,omitempty"` // Days after which untagged images expire. Zero never expires them.
 }
 
 // RequiresDNSDelegation returns true if we have to set up DNS Delegation resources
@@ -27,6 +37,11 @@ func (a *Application) RequiresDNSDelegation() bool {
 	return a.Domain != ""
 }
 
+// HasImmutableImageTags returns true if the tags of the images of the app's workloads can't be overwritten.
+func (a *Application) HasImmutableImageTags() bool {
+	return a.ImageRepository != nil && a.ImageRepository.ImmutableTags
+}
+
 // CreateApplication instantiates a new application, validates its uniqueness and stores it in SSM.
 func (s *Store) CreateApplication(application *Application) error {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
@@ -24,30 +24,49 @@ type Environment struct {
 	ExecutionRoleARN string        `json:"executionRoleARN"`       // ARN used by CloudFormation to make modification to the environment stack.
 	ManagerRoleARN   string        `json:"managerRoleARN"`         // ARN for the manager role assumed to manipulate the environment and its services.
 	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
+	Telemetry        *Telemetry    `json:"telemetry,omitempty"`    // Optional environment telemetry features.
+}
+
+// Telemetry represents optional observability and monitoring configuration.
+type Telemetry struct {
+	EnableContainerInsights bool `json:"containerInsights"`
 }
 
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
-	ImportVPC *ImportVPC `json:"importVPC,omitempty"`
-	VPCConfig *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportVPC      *ImportVPC `json:"importVPC,omitempty"`
+	VPCConfig      *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportCertARNs []string   `json:"importCertARNs,omitempty"`
+	VPCEndpoints   bool       `json:"vpcEndpoints,omitempty"` // True means AWS services are reached through VPC endpoints instead of NAT gateways.
 }
 
 // NewCustomizeEnv returns a new CustomizeEnv struct.
-func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC) *CustomizeEnv {
-	if importVPC == nil && adjustVPC == nil {
+func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC, importCertARNs []string, vpcEndpoints bool) *CustomizeEnv {
+	if importVPC == nil && adjustVPC == nil && len(importCertARNs) == 0 && !vpcEndpoints {
 		return nil
 	}
 	return &CustomizeEnv{
-		ImportVPC: importVPC,
-		VPCConfig: adjustVPC,
+		ImportVPC:      importVPC,
+		VPCConfig:      adjustVPC,
+		ImportCertARNs: importCertARNs,
+		VPCEndpoints:   vpcEndpoints,
 	}
 }
 
+// HasImportedCerts returns true if the environment uses imported ACM certificates for its HTTPS listener.
+func (e *Environment) HasImportedCerts() bool {
+	return e.CustomConfig != nil && len(e.CustomConfig.ImportCertARNs) > 0
+}
+
 // ImportVPC holds the fields to import VPC resources.
 type ImportVPC struct {
 	ID               string   `json:"id"` // ID for the VPC.
 	PublicSubnetIDs  []string `json:"publicSubnetIDs"`
 	PrivateSubnetIDs []string `json:"privateSubnetIDs"`
+	// PrivateRouteTableIDs are the route tables of the private subnets, used to route traffic to gateway VPC endpoints.
+	PrivateRouteTableIDs []string `json:"privateRouteTableIDs,omitempty"`
+	// CIDR is the CIDR block of the VPC, allowed to reach the internal load balancer.
+	CIDR string `json:"cidr,omitempty"`
 }
 
 // AdjustVPC holds the fields to adjust default VPC resources.
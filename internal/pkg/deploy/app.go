@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 )
 
 const appDNSDelegationRoleName = "DNSDelegationRole"
@@ -22,6 +23,8 @@ type CreateAppInput struct {
 	DomainHostedZoneID    string            // Hosted Zone ID for the domain.
 	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
 	Version               string            // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+
+	ImageRepository *config.ImageRepositoryConfig // Settings of the ECR repositories of the workloads. If nil, uses the ECR defaults.
 }
 
 const (
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -242,10 +243,11 @@ func (cf CloudFormation) AddJobToApp(app *config.Application, jobName string) er
 
 func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string) error {
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
-		Name:           app.Name,
-		AccountID:      app.AccountID,
-		AdditionalTags: app.Tags,
-		Version:        deploy.LatestAppTemplateVersion,
+		Name:            app.Name,
+		AccountID:       app.AccountID,
+		AdditionalTags:  app.Tags,
+		Version:         deploy.LatestAppTemplateVersion,
+		ImageRepository: app.ImageRepository,
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
 	if err != nil {
@@ -273,6 +275,7 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	newDeploymentConfig := stack.AppResourcesConfig{
 		Version:  previouslyDeployedConfig.Version + 1,
 		Services: wlList,
+		Sidecars: previouslyDeployedConfig.Sidecars,
 		Accounts: previouslyDeployedConfig.Accounts,
 		App:      appConfig.Name,
 	}
@@ -283,6 +286,54 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	return nil
 }
 
+// AddSidecarReposToApp attempts to add the ECR repositories of the sidecars of a workload
+// that are built from a Dockerfile to the application resource stack.
+// The repository of a sidecar is named "<app>/<workload>/<sidecar>".
+func (cf CloudFormation) AddSidecarReposToApp(app *config.Application, wlName string, sidecars []string) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:            app.Name,
+		AccountID:       app.AccountID,
+		AdditionalTags:  app.Tags,
+		Version:         deploy.LatestAppTemplateVersion,
+		ImageRepository: app.ImageRepository,
+	})
+	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("adding sidecar repositories of %s to application %s: %w", wlName, app.Name, err)
+	}
+
+	existing := make(map[string]bool)
+	sidecarList := append([]string(nil), previouslyDeployedConfig.Sidecars...)
+	for _, sidecar := range sidecarList {
+		existing[sidecar] = true
+	}
+	shouldDeploy := false
+	for _, sidecar := range sidecars {
+		name := fmt.Sprintf("%s/%s", wlName, sidecar)
+		if existing[name] {
+			continue
+		}
+		existing[name] = true
+		sidecarList = append(sidecarList, name)
+		shouldDeploy = true
+	}
+	if !shouldDeploy {
+		return nil
+	}
+
+	newDeploymentConfig := stack.AppResourcesConfig{
+		Version:  previouslyDeployedConfig.Version + 1,
+		Services: previouslyDeployedConfig.Services,
+		Sidecars: sidecarList,
+		Accounts: previouslyDeployedConfig.Accounts,
+		App:      appConfig.Name,
+	}
+	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
+		return fmt.Errorf("adding sidecar repositories of %s to application %s: %w", wlName, app.Name, err)
+	}
+	return nil
+}
+
 // RemoveServiceFromApp attempts to remove service-specific resources (ECR repositories) from the application resource stack.
 func (cf CloudFormation) RemoveServiceFromApp(app *config.Application, svcName string) error {
 	if err := cf.removeWorkloadFromApp(app, svcName); err != nil {
@@ -301,9 +352,10 @@ func (cf CloudFormation) RemoveJobFromApp(app *config.Application, jobName strin
 
 func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName string) error {
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
-		Name:      app.Name,
-		AccountID: app.AccountID,
-		Version:   deploy.LatestAppTemplateVersion,
+		Name:            app.Name,
+		AccountID:       app.AccountID,
+		Version:         deploy.LatestAppTemplateVersion,
+		ImageRepository: app.ImageRepository,
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
 	if err != nil {
@@ -326,10 +378,19 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 	if !shouldRemoveWl {
 		return nil
 	}
+	// The repositories of the workload's sidecars are removed along with the workload.
+	var sidecarList []string
+	for _, sidecar := range previouslyDeployedConfig.Sidecars {
+		if strings.HasPrefix(sidecar, wlName+"/") {
+			continue
+		}
+		sidecarList = append(sidecarList, sidecar)
+	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
 		Version:  previouslyDeployedConfig.Version + 1,
 		Services: wlList,
+		Sidecars: sidecarList,
 		Accounts: previouslyDeployedConfig.Accounts,
 		App:      appConfig.Name,
 	}
@@ -353,10 +414,11 @@ type AddEnvToAppOpts struct {
 // sets up a new stack instance if the environment is in a new region.
 func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
-		Name:           opts.App.Name,
-		AccountID:      opts.App.AccountID,
-		AdditionalTags: opts.App.Tags,
-		Version:        deploy.LatestAppTemplateVersion,
+		Name:            opts.App.Name,
+		AccountID:       opts.App.AccountID,
+		AdditionalTags:  opts.App.Tags,
+		Version:         deploy.LatestAppTemplateVersion,
+		ImageRepository: opts.App.ImageRepository,
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
 	if err != nil {
@@ -382,6 +444,7 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 	newDeploymentConfig := stack.AppResourcesConfig{
 		Version:  previouslyDeployedConfig.Version + 1,
 		Services: previouslyDeployedConfig.Services,
+		Sidecars: previouslyDeployedConfig.Sidecars,
 		Accounts: accountList,
 		App:      appConfig.Name,
 	}
@@ -527,6 +527,77 @@ func TestCloudFormation_AddServiceToApp(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_AddSidecarReposToApp(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	testCases := map[string]struct {
+		sidecars     []string
+		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+	}{
+		"adds the repositories of new sidecars": {
+			sidecars: []string{"nginx", "envoy"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Sidecars: []string{"test/nginx"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.ElementsMatch(t, []string{"test"}, configToDeploy.Services)
+						require.ElementsMatch(t, []string{"test/envoy", "test/nginx"}, configToDeploy.Sidecars)
+						require.Equal(t, 2, configToDeploy.Version)
+					})
+				return m
+			},
+		},
+		"does not update the stack set if the repositories exist": {
+			sidecars: []string{"nginx"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Sidecars: []string{"test/nginx"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				box:         templates.Box(),
+				region:      "us-west-2",
+			}
+
+			err := cf.AddSidecarReposToApp(mockApp, "test", tc.sidecars)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 	mockApp := &config.Application{
 		Name:      "testapp",
@@ -545,6 +616,7 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 				m := mocks.NewMockstackSetClient(ctrl)
 				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
 					Services: []string{"test", "firsttest"},
+					Sidecars: []string{"test/nginx", "firsttest/nginx"},
 					Version:  1,
 				}})
 				require.NoError(t, err)
@@ -557,6 +629,7 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 						configToDeploy, err := stack.AppConfigFrom(&template)
 						require.NoError(t, err)
 						require.ElementsMatch(t, []string{"firsttest"}, configToDeploy.Services)
+						require.ElementsMatch(t, []string{"firsttest/nginx"}, configToDeploy.Sidecars)
 						require.Empty(t, configToDeploy.Accounts, "config account list should be empty")
 						require.Equal(t, 2, configToDeploy.Version)
 					})
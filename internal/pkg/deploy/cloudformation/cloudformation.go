@@ -62,6 +62,7 @@ type cfnClient interface {
 	Update(*cloudformation.Stack) (string, error)
 	UpdateAndWait(*cloudformation.Stack) error
 	WaitForUpdate(ctx context.Context, stackName string) error
+	CancelUpdate(stackName string) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
 	DeleteAndWaitWithRoleARN(stackName, roleARN string) error
@@ -158,6 +158,20 @@ func (m *MockcfnClient) EXPECT() *MockcfnClientMockRecorder {
 	return m.recorder
 }
 
+// CancelUpdate mocks base method.
+func (m *MockcfnClient) CancelUpdate(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpdate", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUpdate indicates an expected call of CancelUpdate.
+func (mr *MockcfnClientMockRecorder) CancelUpdate(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpdate", reflect.TypeOf((*MockcfnClient)(nil).CancelUpdate), stackName)
+}
+
 // Create mocks base method.
 func (m *MockcfnClient) Create(arg0 *cloudformation0.Stack) (string, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -12,7 +13,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"gopkg.in/yaml.v3"
 )
@@ -28,10 +31,18 @@ type DeployedAppMetadata struct {
 type AppResourcesConfig struct {
 	Accounts []string `yaml:"Accounts,flow"`
 	Services []string `yaml:"Services,flow"`
+	Sidecars []string `yaml:"Sidecars,flow,omitempty"` // Sidecars built from a Dockerfile, as "<workload>/<sidecar>".
 	App      string   `yaml:"App"`
 	Version  int      `yaml:"Version"`
 }
 
+// ecrRepository is an ECR repository created by the application StackSet.
+type ecrRepository struct {
+	LogicalID string // Logical ID of the repository resource.
+	Name      string // Name of the repository without the application prefix.
+	Workload  string // Name of the workload that pushes images to the repository.
+}
+
 // AppStackConfig is for providing all the values to set up an
 // environment stack and to interpret the outputs from it.
 type AppStackConfig struct {
@@ -56,6 +67,7 @@ const (
 	appOutputKMSKey               = "KMSKeyARN"
 	appOutputS3Bucket             = "PipelineBucket"
 	appOutputECRRepoPrefix        = "ECRRepo"
+	appSidecarECRRepoPrefix       = "ECRSidecarRepo"
 	appDNSDelegatedAccountsKey    = "AppDNSDelegatedAccounts"
 	appDomainNameKey              = "AppDomainName"
 	appDomainHostedZoneIDKey      = "AppDomainHostedZoneID"
@@ -103,18 +115,35 @@ func (c *AppStackConfig) Template() (string, error) {
 
 // ResourceTemplate generates a StackSet template with all the Application-wide resources (ECR Repos, KMS keys, S3 buckets)
 func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, error) {
-	// Sort the account IDs and Services so that the template we generate is deterministic
+	// Sort the account IDs, Services and Sidecars so that the template we generate is deterministic
 	sort.Strings(config.Accounts)
 	sort.Strings(config.Services)
+	sort.Strings(config.Sidecars)
 
+	var scanOnPush, immutableTags bool
+	if repo := c.ImageRepository; repo != nil {
+		scanOnPush, immutableTags = repo.ScanOnPush, repo.ImmutableTags
+	}
+	lifecyclePolicy, err := ecrLifecyclePolicy(c.ImageRepository)
+	if err != nil {
+		return "", err
+	}
 	content, err := c.parser.Parse(appResourcesTemplatePath, struct {
 		*AppResourcesConfig
+		Repositories    []ecrRepository
 		ServiceTagKey   string
 		TemplateVersion string
+		ScanOnPush      bool
+		ImmutableTags   bool
+		LifecyclePolicy string
 	}{
 		config,
+		ecrRepositories(config),
 		deploy.ServiceTagKey,
 		c.Version,
+		scanOnPush,
+		immutableTags,
+		lifecyclePolicy,
 	}, template.WithFuncs(cfTemplateFunctions))
 	if err != nil {
 		return "", err
@@ -122,6 +151,112 @@ func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, e
 	return content.String(), err
 }
 
+// ecrRepositories returns the repositories of the workloads followed by the repositories of the sidecars.
+// The repository of a sidecar is namespaced under the repository of its workload.
+func ecrRepositories(config *AppResourcesConfig) []ecrRepository {
+	var repos []ecrRepository
+	for _, wl := range config.Services {
+		repos = append(repos, ecrRepository{
+			LogicalID: appOutputECRRepoPrefix + template.ReplaceDashesFunc(wl),
+			Name:      wl,
+			Workload:  wl,
+		})
+	}
+	for _, sidecar := range config.Sidecars {
+		parts := strings.SplitN(sidecar, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		repos = append(repos, ecrRepository{
+			LogicalID: appSidecarECRRepoPrefix + template.ReplaceDashesFunc(strings.Join(parts, "SLASH")),
+			Name:      sidecar,
+			Workload:  parts[0],
+		})
+	}
+	return repos
+}
+
+type ecrLifecycleRule struct {
+	RulePriority int                   `json:"rulePriority"`
+	Description  string                `json:"description"`
+	Selection    ecrLifecycleSelection `json:"selection"`
+	Action       ecrLifecycleAction    `json:"action"`
+}
+
+type ecrLifecycleSelection struct {
+	TagStatus     string   `json:"tagStatus"`
+	TagPrefixList []string `json:"tagPrefixList,omitempty"`
+	CountType     string   `json:"countType"`
+	CountUnit     string   `json:"countUnit,omitempty"`
+	CountNumber   int      `json:"countNumber"`
+}
+
+// Maximum number of build caches kept in a repository. The build caches are tagged per build target,
+// so this limit is never reached in practice.
+const ecrMaxBuildCacheCount = 100
+
+type ecrLifecycleAction struct {
+	Type string `json:"type"`
+}
+
+// ecrLifecyclePolicy returns the lifecycle policy text of the ECR repositories,
+// or an empty string if images never expire.
+func ecrLifecyclePolicy(repo *config.ImageRepositoryConfig) (string, error) {
+	if repo == nil {
+		return "", nil
+	}
+	var rules []ecrLifecycleRule
+	if repo.UntaggedExpiryDays > 0 {
+		rules = append(rules, ecrLifecycleRule{
+			RulePriority: len(rules) + 1,
+			Description:  fmt.Sprintf("Expire untagged images older than %d days", repo.UntaggedExpiryDays),
+			Selection: ecrLifecycleSelection{
+				TagStatus:   "untagged",
+				CountType:   "sinceImagePushed",
+				CountUnit:   "days",
+				CountNumber: repo.UntaggedExpiryDays,
+			},
+			Action: ecrLifecycleAction{Type: "expire"},
+		})
+	}
+	// A rule that selects any image must have the lowest priority.
+	if repo.MaxImageCount > 0 {
+		// Images matched by a rule can't be expired by a rule with a lower priority,
+		// so the build caches don't count towards the images to keep.
+		rules = append(rules, ecrLifecycleRule{
+			RulePriority: len(rules) + 1,
+			Description:  "Keep the build caches",
+			Selection: ecrLifecycleSelection{
+				TagStatus:     "tagged",
+				TagPrefixList: []string{exec.BuildCacheTagPrefix},
+				CountType:     "imageCountMoreThan",
+				CountNumber:   ecrMaxBuildCacheCount,
+			},
+			Action: ecrLifecycleAction{Type: "expire"},
+		})
+		rules = append(rules, ecrLifecycleRule{
+			RulePriority: len(rules) + 1,
+			Description:  fmt.Sprintf("Keep the last %d images", repo.MaxImageCount),
+			Selection: ecrLifecycleSelection{
+				TagStatus:   "any",
+				CountType:   "imageCountMoreThan",
+				CountNumber: repo.MaxImageCount,
+			},
+			Action: ecrLifecycleAction{Type: "expire"},
+		})
+	}
+	if len(rules) == 0 {
+		return "", nil
+	}
+	policy, err := json.Marshal(struct {
+		Rules []ecrLifecycleRule `json:"rules"`
+	}{rules})
+	if err != nil {
+		return "", fmt.Errorf("marshal ECR lifecycle policy: %w", err)
+	}
+	return string(policy), nil
+}
+
 // Parameters returns a list of parameters which accompany the app CloudFormation template.
 func (c *AppStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
@@ -123,6 +124,7 @@ func TestDNSDelegationAccounts(t *testing.T) {
 func TestAppResourceTemplate(t *testing.T) {
 	testCases := map[string]struct {
 		given            *AppResourcesConfig
+		imageRepository  *config.ImageRepositoryConfig
 		mockDependencies func(ctrl *gomock.Controller, c *AppStackConfig)
 
 		wantedTemplate string
@@ -142,6 +144,7 @@ func TestAppResourceTemplate(t *testing.T) {
 			given: &AppResourcesConfig{
 				Accounts: []string{"4567", "1234"},
 				Services: []string{"app-2", "app-1"},
+				Sidecars: []string{"app-2/nginx", "app-1/envoy-proxy"},
 				Version:  1,
 				App:      "testapp",
 			},
@@ -149,17 +152,74 @@ func TestAppResourceTemplate(t *testing.T) {
 				m := mocks.NewMockReadParser(ctrl)
 				m.EXPECT().Parse(appResourcesTemplatePath, struct {
 					*AppResourcesConfig
+					Repositories    []ecrRepository
 					ServiceTagKey   string
 					TemplateVersion string
+					ScanOnPush      bool
+					ImmutableTags   bool
+					LifecyclePolicy string
 				}{
 					&AppResourcesConfig{
 						Accounts: []string{"1234", "4567"},
 						Services: []string{"app-1", "app-2"},
+						Sidecars: []string{"app-1/envoy-proxy", "app-2/nginx"},
 						Version:  1,
 						App:      "testapp",
 					},
+					[]ecrRepository{
+						{LogicalID: "ECRRepoappDASH1", Name: "app-1", Workload: "app-1"},
+						{LogicalID: "ECRRepoappDASH2", Name: "app-2", Workload: "app-2"},
+						{LogicalID: "ECRSidecarRepoappDASH1SLASHenvoyDASHproxy", Name: "app-1/envoy-proxy", Workload: "app-1"},
+						{LogicalID: "ECRSidecarRepoappDASH2SLASHnginx", Name: "app-2/nginx", Workload: "app-2"},
+					},
+					deploy.ServiceTagKey,
+					"",
+					false,
+					false,
+					"",
+				}, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("template"),
+				}, nil)
+				c.parser = m
+			},
+
+			wantedTemplate: "template",
+		},
+		"should render the settings of the image repositories": {
+			given: &AppResourcesConfig{
+				Services: []string{"api"},
+				Version:  1,
+				App:      "testapp",
+			},
+			imageRepository: &config.ImageRepositoryConfig{
+				ScanOnPush:    true,
+				ImmutableTags: true,
+				MaxImageCount: 30,
+			},
+			mockDependencies: func(ctrl *gomock.Controller, c *AppStackConfig) {
+				m := mocks.NewMockReadParser(ctrl)
+				m.EXPECT().Parse(appResourcesTemplatePath, struct {
+					*AppResourcesConfig
+					Repositories    []ecrRepository
+					ServiceTagKey   string
+					TemplateVersion string
+					ScanOnPush      bool
+					ImmutableTags   bool
+					LifecyclePolicy string
+				}{
+					&AppResourcesConfig{
+						Services: []string{"api"},
+						Version:  1,
+						App:      "testapp",
+					},
+					[]ecrRepository{
+						{LogicalID: "ECRRepoapi", Name: "api", Workload: "api"},
+					},
 					deploy.ServiceTagKey,
 					"",
+					true,
+					true,
+					`{"rules":[{"rulePriority":1,"description":"Keep the build caches","selection":{"tagStatus":"tagged","tagPrefixList":["buildcache-"],"countType":"imageCountMoreThan","countNumber":100},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the last 30 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":30},"action":{"type":"expire"}}]}`,
 				}, gomock.Any()).Return(&template.Content{
 					Buffer: bytes.NewBufferString("template"),
 				}, nil)
@@ -175,7 +235,7 @@ func TestAppResourceTemplate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			appStack := &AppStackConfig{
-				CreateAppInput: &deploy.CreateAppInput{Name: "testapp", AccountID: "1234"},
+				CreateAppInput: &deploy.CreateAppInput{Name: "testapp", AccountID: "1234", ImageRepository: tc.imageRepository},
 			}
 			tc.mockDependencies(ctrl, appStack)
 
@@ -187,6 +247,36 @@ func TestAppResourceTemplate(t *testing.T) {
 	}
 }
 
+func TestECRLifecyclePolicy(t *testing.T) {
+	testCases := map[string]struct {
+		in     *config.ImageRepositoryConfig
+		wanted string
+	}{
+		"returns no policy if there are no repository settings": {},
+		"returns no policy if images never expire": {
+			in: &config.ImageRepositoryConfig{
+				ScanOnPush: true,
+			},
+		},
+		"expires untagged images before keeping the last images": {
+			in: &config.ImageRepositoryConfig{
+				MaxImageCount:      30,
+				UntaggedExpiryDays: 7,
+			},
+			wanted: `{"rules":[{"rulePriority":1,"description":"Expire untagged images older than 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the build caches","selection":{"tagStatus":"tagged","tagPrefixList":["buildcache-"],"countType":"imageCountMoreThan","countNumber":100},"action":{"type":"expire"}},{"rulePriority":3,"description":"Keep the last 30 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":30},"action":{"type":"expire"}}]}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ecrLifecyclePolicy(tc.in)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestAppParameters(t *testing.T) {
 	expectedParams := []*cloudformation.Parameter{
 		{
@@ -387,6 +477,8 @@ Metadata:
   Services:
   - testsvc1
   - testsvc2
+  Sidecars:
+  - testsvc1/nginx
   Accounts:
   - 0000000000
 `
@@ -396,5 +488,6 @@ Metadata:
 		Accounts: []string{"0000000000"},
 		Version:  7,
 		Services: []string{"testsvc1", "testsvc2"},
+		Sidecars: []string{"testsvc1/nginx"},
 	}, *config)
 }
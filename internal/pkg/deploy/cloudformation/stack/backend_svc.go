@@ -81,15 +81,26 @@ func (s *BackendService) Template() (string, error) {
 		sidecarConfig: s.manifest.Sidecars,
 		imageConfig:   &s.manifest.ImageConfig.Image,
 		workloadName:  aws.StringValue(s.manifest.Name),
+		observability: s.manifest.Observability,
+		sidecarImages: s.rc.SidecarImages,
 	}
 	sidecars, err := convertSidecar(convSidecarOpts)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	observability, err := convertObservability(s.manifest.Observability)
+	if err != nil {
+		return "", fmt.Errorf("convert the observability configuration for service %s: %w", s.name, err)
+	}
 	dependencies, err := convertImageDependsOn(convSidecarOpts)
 	if err != nil {
 		return "", fmt.Errorf("convert the container dependency for service %s: %w", s.name, err)
 	}
+	connect, err := convertConnect(s.name, s.manifest.ImageConfig.Port, s.manifest.Network, s.manifest.Sidecars, s.rc.ConnectCallees)
+	if err != nil {
+		return "", fmt.Errorf("convert the network connect configuration for service %s: %w", s.name, err)
+	}
+	dependencies = addEnvoyDependency(connect, dependencies)
 
 	advancedCount, err := convertAdvancedCount(&s.manifest.Count.AdvancedCount)
 	if err != nil {
@@ -129,11 +140,13 @@ func (s *BackendService) Template() (string, error) {
 		WorkloadType:         manifest.BackendServiceType,
 		HealthCheck:          s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(),
 		LogConfig:            convertLogging(s.manifest.Logging),
+		Observability:        observability,
 		DockerLabels:         s.manifest.ImageConfig.DockerLabels,
 		DesiredCountLambda:   desiredCountLambda.String(),
 		EnvControllerLambda:  envControllerLambda.String(),
 		Storage:              storage,
 		Network:              convertNetworkConfig(s.manifest.Network),
+		Connect:              connect,
 		EntryPoint:           entrypoint,
 		Command:              command,
 		DependsOn:            dependencies,
@@ -36,14 +36,17 @@ const (
 	envParamAppDNSKey                = "AppDNSName"
 	envParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
 	EnvParamAliasesKey               = "Aliases"
+	EnvParamInternalALBWorkloadsKey  = "InternalALBWorkloads"
 
 	// Output keys.
-	EnvOutputVPCID                   = "VpcId"
-	EnvOutputPublicSubnets           = "PublicSubnets"
-	EnvOutputPrivateSubnets          = "PrivateSubnets"
-	envOutputCFNExecutionRoleARN     = "CFNExecutionRoleARN"
-	envOutputManagerRoleKey          = "EnvironmentManagerRoleARN"
-	EnvParamServiceDiscoveryEndpoint = "ServiceDiscoveryEndpoint"
+	EnvOutputVPCID                       = "VpcId"
+	EnvOutputPublicSubnets               = "PublicSubnets"
+	EnvOutputPrivateSubnets              = "PrivateSubnets"
+	EnvOutputInternalLoadBalancerDNSName = "InternalLoadBalancerDNSName"
+	EnvOutputDashboardURL                = "EnvironmentDashboardURL"
+	envOutputCFNExecutionRoleARN         = "CFNExecutionRoleARN"
+	envOutputManagerRoleKey              = "EnvironmentManagerRoleARN"
+	EnvParamServiceDiscoveryEndpoint     = "ServiceDiscoveryEndpoint"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
@@ -101,6 +104,9 @@ func (e *EnvStackConfig) Template() (string, error) {
 		ScriptBucketName:          bucket,
 		ImportVPC:                 e.in.ImportVPCConfig,
 		VPCConfig:                 vpcConf,
+		ImportCertARNs:            e.in.ImportCertARNs,
+		VPCEndpoints:              e.in.VPCEndpoints,
+		Telemetry:                 e.in.Telemetry,
 		Version:                   e.in.Version,
 		LatestVersion:             deploy.LatestEnvTemplateVersion,
 	}, template.WithFuncs(map[string]interface{}{
@@ -80,7 +80,8 @@ func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, a
 	if err != nil {
 		return nil, err
 	}
-	webSvc.httpsEnabled = true
+	// Services behind the internal load balancer only accept HTTP traffic.
+	webSvc.httpsEnabled = !aws.BoolValue(mft.Internal)
 	return webSvc, nil
 }
 
@@ -106,15 +107,26 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		sidecarConfig: s.manifest.Sidecars,
 		imageConfig:   &s.manifest.ImageConfig.Image,
 		workloadName:  aws.StringValue(s.manifest.Name),
+		observability: s.manifest.Observability,
+		sidecarImages: s.rc.SidecarImages,
 	}
 	sidecars, err := convertSidecar(convSidecarOpts)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	observability, err := convertObservability(s.manifest.Observability)
+	if err != nil {
+		return "", fmt.Errorf("convert the observability configuration for service %s: %w", s.name, err)
+	}
 	dependencies, err := convertImageDependsOn(convSidecarOpts)
 	if err != nil {
 		return "", fmt.Errorf("convert the container dependency for service %s: %w", s.name, err)
 	}
+	connect, err := convertConnect(s.name, s.manifest.ImageConfig.Port, s.manifest.Network, s.manifest.Sidecars, s.rc.ConnectCallees)
+	if err != nil {
+		return "", fmt.Errorf("convert the network connect configuration for service %s: %w", s.name, err)
+	}
+	dependencies = addEnvoyDependency(connect, dependencies)
 
 	advancedCount, err := convertAdvancedCount(&s.manifest.Count.AdvancedCount)
 	if err != nil {
@@ -144,6 +156,9 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		return "", err
 	}
 
+	if aws.BoolValue(s.manifest.Internal) && aws.StringValue(s.manifest.Alias) != "" {
+		return "", fmt.Errorf("alias is not supported for service %s behind an internal load balancer", s.name)
+	}
 	var aliases []string
 	if s.httpsEnabled {
 		albAlias := aws.StringValue(s.manifest.Alias)
@@ -168,6 +183,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		NestedStack:              outputs,
 		Sidecars:                 sidecars,
 		LogConfig:                convertLogging(s.manifest.Logging),
+		Observability:            observability,
 		DockerLabels:             s.manifest.ImageConfig.DockerLabels,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
@@ -178,11 +194,13 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.HealthCheck),
 		DeregistrationDelay:      deregistrationDelay,
 		AllowedSourceIps:         allowedSourceIPs,
+		InternalALB:              aws.BoolValue(s.manifest.Internal),
 		RulePriorityLambda:       rulePriorityLambda.String(),
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
 		Storage:                  storage,
 		Network:                  convertNetworkConfig(s.manifest.Network),
+		Connect:                  connect,
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                dependencies,
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/deploy/cloudformation/stack/task.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	template "github.com/aws/copilot-cli/internal/pkg/template"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MocktaskReadParser is a mock of taskReadParser interface.
+type MocktaskReadParser struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskReadParserMockRecorder
+}
+
+// MocktaskReadParserMockRecorder is the mock recorder for MocktaskReadParser.
+type MocktaskReadParserMockRecorder struct {
+	mock *MocktaskReadParser
+}
+
+// NewMocktaskReadParser creates a new mock instance.
+func NewMocktaskReadParser(ctrl *gomock.Controller) *MocktaskReadParser {
+	mock := &MocktaskReadParser{ctrl: ctrl}
+	mock.recorder = &MocktaskReadParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskReadParser) EXPECT() *MocktaskReadParserMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MocktaskReadParser) Parse(path string, data interface{}, options ...template.ParseOption) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{path, data}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Parse", varargs...)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MocktaskReadParserMockRecorder) Parse(path, data interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{path, data}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MocktaskReadParser)(nil).Parse), varargs...)
+}
+
+// ParseTask mocks base method.
+func (m *MocktaskReadParser) ParseTask(arg0 template.TaskOpts) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTask", arg0)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTask indicates an expected call of ParseTask.
+func (mr *MocktaskReadParserMockRecorder) ParseTask(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTask", reflect.TypeOf((*MocktaskReadParser)(nil).ParseTask), arg0)
+}
+
+// Read mocks base method.
+func (m *MocktaskReadParser) Read(path string) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", path)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MocktaskReadParserMockRecorder) Read(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MocktaskReadParser)(nil).Read), path)
+}
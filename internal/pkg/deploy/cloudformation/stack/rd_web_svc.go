@@ -47,7 +47,8 @@ func NewRequestDrivenWebService(mft *manifest.RequestDrivenWebService, env strin
 				parser: parser,
 			},
 			instanceConfig:    mft.InstanceConfig,
-			imageConfig:       mft.ImageConfig,
+			imageConfig:       mft.ImageConfig.ImageWithPort,
+			sourceConfig:      mft.ImageConfig.Source,
 			healthCheckConfig: mft.HealthCheckConfiguration,
 		},
 		app:      app,
@@ -63,11 +64,37 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		return "", err
 	}
 
+	enableVPCConnector, err := convertAppRunnerNetworkConfig(s.manifest.Network)
+	if err != nil {
+		return "", fmt.Errorf("convert the network configuration for service %s: %w", s.name, err)
+	}
+	autoscaling, err := convertAppRunnerAutoscaling(s.manifest.Autoscaling)
+	if err != nil {
+		return "", fmt.Errorf("convert the autoscaling configuration for service %s: %w", s.name, err)
+	}
+	source, err := convertAppRunnerSource(s.manifest.ImageConfig.Source)
+	if err != nil {
+		return "", fmt.Errorf("convert the source configuration for service %s: %w", s.name, err)
+	}
+	var envControllerLambda string
+	if enableVPCConnector {
+		lambda, err := s.parser.Read(envControllerPath)
+		if err != nil {
+			return "", fmt.Errorf("read env controller lambda: %w", err)
+		}
+		envControllerLambda = lambda.String()
+	}
+
 	content, err := s.parser.ParseRequestDrivenWebService(template.ParseRequestDrivenWebServiceInput{
-		Variables:         s.manifest.Variables,
-		Tags:              s.manifest.Tags,
-		NestedStack:       outputs,
-		EnableHealthCheck: !s.healthCheckConfig.IsEmpty(),
+		Variables:           s.manifest.Variables,
+		Secrets:             convertAppRunnerSecrets(s.manifest.Secrets),
+		Tags:                s.manifest.Tags,
+		NestedStack:         outputs,
+		EnableHealthCheck:   !s.healthCheckConfig.IsEmpty(),
+		EnableVPCConnector:  enableVPCConnector,
+		Source:              source,
+		Autoscaling:         autoscaling,
+		EnvControllerLambda: envControllerLambda,
 	})
 	if err != nil {
 		return "", err
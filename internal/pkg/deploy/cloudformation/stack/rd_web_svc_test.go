@@ -27,8 +27,10 @@ var testRDWebServiceManifest = &manifest.RequestDrivenWebService{
 		Type: aws.String(manifest.RequestDrivenWebServiceType),
 	},
 	RequestDrivenWebServiceConfig: manifest.RequestDrivenWebServiceConfig{
-		ImageConfig: manifest.ImageWithPort{
-			Port: aws.Uint16(80),
+		ImageConfig: manifest.RequestDrivenWebServiceImageConfig{
+			ImageWithPort: manifest.ImageWithPort{
+				Port: aws.Uint16(80),
+			},
 		},
 		InstanceConfig: manifest.AppRunnerInstanceConfig{
 			CPU:    aws.Int(256),
@@ -84,7 +86,7 @@ func TestRequestDrivenWebService_NewRequestDrivenWebService(t *testing.T) {
 						image: testRDWebServiceManifest.ImageConfig,
 					},
 					instanceConfig: testRDWebServiceManifest.InstanceConfig,
-					imageConfig:    testRDWebServiceManifest.ImageConfig,
+					imageConfig:    testRDWebServiceManifest.ImageConfig.ImageWithPort,
 				},
 				manifest: testRDWebServiceManifest,
 				app: deploy.AppInformation{
@@ -176,6 +178,47 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"should register the vpc connector with the env controller": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
+				addons := mockTemplater{err: &addon.ErrAddonsNotFound{}}
+				mft := *c.manifest
+				mft.Network = manifest.RequestDrivenWebServiceNetworkConfig{
+					VPC: manifest.RequestDrivenWebServiceVpcConfig{
+						Placement: aws.String(manifest.PrivateSubnetPlacement),
+					},
+				}
+				mockParser.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				mockParser.EXPECT().ParseRequestDrivenWebService(template.ParseRequestDrivenWebServiceInput{
+					Variables:           c.manifest.Variables,
+					Tags:                c.manifest.Tags,
+					EnableHealthCheck:   true,
+					EnableVPCConnector:  true,
+					EnvControllerLambda: "something",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				c.parser = mockParser
+				c.addons = addons
+				c.manifest = &mft
+			},
+			wantedTemplate: "template",
+		},
+		"should return an error if the env controller lambda cannot be read": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
+				addons := mockTemplater{err: &addon.ErrAddonsNotFound{}}
+				mft := *c.manifest
+				mft.Network = manifest.RequestDrivenWebServiceNetworkConfig{
+					VPC: manifest.RequestDrivenWebServiceVpcConfig{
+						Placement: aws.String(manifest.PrivateSubnetPlacement),
+					},
+				}
+				mockParser.EXPECT().Read(envControllerPath).Return(nil, errors.New("some error"))
+				c.parser = mockParser
+				c.addons = addons
+				c.manifest = &mft
+			},
+			wantedError: errors.New("read env controller lambda: some error"),
+		},
 		"should return parsing error": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
 				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
@@ -276,7 +319,7 @@ func TestRequestDrivenWebService_SerializedParameters(t *testing.T) {
 						},
 					},
 					instanceConfig: testRDWebServiceManifest.InstanceConfig,
-					imageConfig:    testRDWebServiceManifest.ImageConfig,
+					imageConfig:    testRDWebServiceManifest.ImageConfig.ImageWithPort,
 				},
 				manifest: testRDWebServiceManifest,
 			}
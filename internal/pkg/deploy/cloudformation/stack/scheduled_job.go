@@ -124,15 +124,24 @@ func (j *ScheduledJob) Template() (string, error) {
 		sidecarConfig: j.manifest.Sidecars,
 		imageConfig:   &j.manifest.ImageConfig.Image,
 		workloadName:  aws.StringValue(j.manifest.Name),
+		observability: j.manifest.Observability,
+		sidecarImages: j.rc.SidecarImages,
 	}
 	sidecars, err := convertSidecar(convSidecarOpts)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
 	}
+	observability, err := convertObservability(j.manifest.Observability)
+	if err != nil {
+		return "", fmt.Errorf("convert the observability configuration for job %s: %w", j.name, err)
+	}
 	dependencies, err := convertImageDependsOn(convSidecarOpts)
 	if err != nil {
 		return "", fmt.Errorf("convert container dependency for job %s: %w", j.name, err)
 	}
+	if j.manifest.Network != nil && j.manifest.Network.Connect != nil {
+		return "", fmt.Errorf(`"network.connect" is not supported for job %s`, j.name)
+	}
 	schedule, err := j.awsSchedule()
 	if err != nil {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
@@ -171,6 +180,7 @@ func (j *ScheduledJob) Template() (string, error) {
 		StateMachine:         stateMachine,
 		HealthCheck:          j.manifest.ImageConfig.HealthCheckOpts(),
 		LogConfig:            convertLogging(j.manifest.Logging),
+		Observability:        observability,
 		DockerLabels:         j.manifest.ImageConfig.DockerLabels,
 		Storage:              storage,
 		Network:              convertNetworkConfig(j.manifest.Network),
@@ -212,7 +222,16 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 	return j.templateConfiguration(j)
 }
 
-// awsSchedule converts the Schedule string to the format required by Cloudwatch Events
+// awsSchedule converts the Schedule string to the format required by Cloudwatch Events.
+func (j *ScheduledJob) awsSchedule() (string, error) {
+	schedule := aws.StringValue(j.manifest.On.Schedule)
+	if schedule == "" {
+		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
+	}
+	return toAWSSchedule(schedule)
+}
+
+// toAWSSchedule converts a schedule to the format required by Cloudwatch Events
 // https://docs.aws.amazon.com/lambda/latest/dg/services-cloudwatchevents-expressions.html
 // Cron expressions must have an sixth "year" field, and must contain at least one ? (either-or)
 // in either day-of-month or day-of-week.
@@ -221,14 +240,10 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 // All others become cron expressions.
 // Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is and
 // validated server-side by CloudFormation.
-func (j *ScheduledJob) awsSchedule() (string, error) {
-	schedule := aws.StringValue(j.manifest.On.Schedule)
-	if schedule == "" {
-		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
-	}
+func toAWSSchedule(schedule string) (string, error) {
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
 	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
-		return aws.StringValue(j.manifest.On.Schedule), nil
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
 	if _, err := cron.ParseStandard(schedule); err != nil {
@@ -4,20 +4,21 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
 const (
-	taskTemplatePath = "task/cf.yml"
-
 	taskNameParamKey         = "TaskName"
 	taskCPUParamKey          = "TaskCPU"
 	taskMemoryParamKey       = "TaskMemory"
@@ -29,12 +30,32 @@ const (
 	taskCommandParamKey        = "Command"
 	taskEntryPointParamKey     = "EntryPoint"
 
+	taskAppNameParamKey      = "AppName"
+	taskEnvNameParamKey      = "EnvName"
+	taskWorkloadNameParamKey = "WorkloadName"
+
 	taskLogRetentionInDays = "1"
+
+	// Expiry of a schedule, which EventBridge evaluates in UTC with a precision of a minute.
+	fmtTaskScheduleExpiry = "cron(%d %d %d %d ? %d)"
+)
+
+// Parameter keys of a task stack that describe the schedule of a recurring task.
+const (
+	TaskScheduleParamKey      = "Schedule"
+	TaskScheduleUntilParamKey = "ScheduleUntil"
 )
 
+var errTaskManagedVolume = errors.New("managed EFS volumes require the task to run in an environment")
+
+type taskReadParser interface {
+	template.ReadParser
+	ParseTask(template.TaskOpts) (*template.Content, error)
+}
+
 type taskStackConfig struct {
 	*deploy.CreateTaskResourcesInput
-	parser template.ReadParser
+	parser taskReadParser
 }
 
 // NewTaskStackConfig sets up a struct that provides stack configurations for CloudFormation
@@ -53,12 +74,29 @@ func (t *taskStackConfig) StackName() string {
 
 // Template returns the task CloudFormation template.
 func (t *taskStackConfig) Template() (string, error) {
-	content, err := t.parser.Parse(taskTemplatePath, struct {
-		EnvVars map[string]string
-		Secrets map[string]string
-	}{
-		EnvVars: t.EnvVars,
-		Secrets: t.Secrets,
+	storage, err := convertStorageOpts(aws.String(t.StackName()), t.Storage)
+	if err != nil {
+		return "", fmt.Errorf("convert storage options for task %s: %w", t.Name, err)
+	}
+	var envControllerLambda string
+	if storage != nil && storage.ManagedVolumeInfo != nil {
+		if t.Env == "" {
+			return "", errTaskManagedVolume
+		}
+		lambda, err := t.parser.Read(envControllerPath)
+		if err != nil {
+			return "", fmt.Errorf("read env controller lambda: %w", err)
+		}
+		envControllerLambda = lambda.String()
+	}
+	content, err := t.parser.ParseTask(template.TaskOpts{
+		EnvVars:             t.EnvVars,
+		Secrets:             t.Secrets,
+		Storage:             storage,
+		OS:                  t.OS,
+		Arch:                t.Arch,
+		Schedule:            t.scheduleOpts(),
+		EnvControllerLambda: envControllerLambda,
 	})
 	if err != nil {
 		return "", fmt.Errorf("read template for task stack: %w", err)
@@ -66,8 +104,42 @@ func (t *taskStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+func (t *taskStackConfig) scheduleOpts() *template.TaskScheduleOpts {
+	if t.Schedule == nil {
+		return nil
+	}
+	opts := &template.TaskScheduleOpts{
+		Cluster:         t.Schedule.Cluster,
+		Count:           t.Schedule.Count,
+		Subnets:         t.Schedule.Subnets,
+		SecurityGroups:  t.Schedule.SecurityGroups,
+		AssignPublicIP:  t.Schedule.AssignPublicIP,
+		Spot:            t.Schedule.Spot,
+		PlatformVersion: t.Schedule.PlatformVersion,
+	}
+	if !arn.IsARN(t.Schedule.Cluster) {
+		opts.ClusterName = t.Schedule.Cluster
+	}
+	if t.Schedule.Until != nil {
+		// Round up to the next minute so that the schedule never expires early.
+		until := t.Schedule.Until.UTC().Add(time.Minute - time.Nanosecond).Truncate(time.Minute)
+		opts.Expiry = fmt.Sprintf(fmtTaskScheduleExpiry, until.Minute(), until.Hour(), until.Day(), until.Month(), until.Year())
+	}
+	return opts
+}
+
 // Parameters returns the parameter values to be passed to the task CloudFormation template.
 func (t *taskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	var schedule, until string
+	if t.Schedule != nil {
+		var err error
+		if schedule, err = toAWSSchedule(t.Schedule.Expression); err != nil {
+			return nil, fmt.Errorf("convert schedule for task %s: %w", t.Name, err)
+		}
+		if t.Schedule.Until != nil {
+			until = t.Schedule.Until.UTC().Format(time.RFC3339)
+		}
+	}
 	return []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(taskNameParamKey),
@@ -105,6 +177,26 @@ func (t *taskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterKey:   aws.String(taskEntryPointParamKey),
 			ParameterValue: aws.String(strings.Join(t.EntryPoint, ",")),
 		},
+		{
+			ParameterKey:   aws.String(TaskScheduleParamKey),
+			ParameterValue: aws.String(schedule),
+		},
+		{
+			ParameterKey:   aws.String(TaskScheduleUntilParamKey),
+			ParameterValue: aws.String(until),
+		},
+		{
+			ParameterKey:   aws.String(taskAppNameParamKey),
+			ParameterValue: aws.String(t.App),
+		},
+		{
+			ParameterKey:   aws.String(taskEnvNameParamKey),
+			ParameterValue: aws.String(t.Env),
+		},
+		{
+			ParameterKey:   aws.String(taskWorkloadNameParamKey),
+			ParameterValue: aws.String(t.StackName()),
+		},
 	}, nil
 }
 
@@ -7,13 +7,15 @@ import (
 	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/template"
-	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,21 +25,151 @@ const (
 )
 
 func TestTaskStackConfig_Template(t *testing.T) {
+	mockUntil := time.Date(2021, time.March, 14, 15, 9, 26, 0, time.UTC)
+	managedVolume := &manifest.Storage{
+		Volumes: map[string]manifest.Volume{
+			"data": {
+				EFS: &manifest.EFSConfigOrBool{
+					Enabled: aws.Bool(true),
+				},
+				MountPointOpts: manifest.MountPointOpts{
+					ContainerPath: aws.String("/var/data"),
+				},
+			},
+		},
+	}
 	testCases := map[string]struct {
-		mockReadParser func(m *mocks.MockReadParser)
+		inEnv          string
+		inStorage      *manifest.Storage
+		inSchedule     *deploy.TaskSchedule
+		mockReadParser func(m *mocks.MocktaskReadParser)
 
 		wantedTemplate string
 		wantedError    error
 	}{
+		"should return error if the storage is invalid": {
+			inStorage: &manifest.Storage{
+				Volumes: map[string]manifest.Volume{
+					"data": {
+						EFS: &manifest.EFSConfigOrBool{
+							Advanced: manifest.EFSVolumeConfiguration{
+								FileSystemID: aws.String("fs-1234"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New("convert storage options for task my-task: validate container configuration for volume data: " + errNoContainerPath.Error()),
+		},
+		"should return error if a managed volume is used outside of an environment": {
+			inStorage:   managedVolume,
+			wantedError: errTaskManagedVolume,
+		},
+		"should return error if the env controller lambda cannot be read": {
+			inEnv:     "test",
+			inStorage: managedVolume,
+			mockReadParser: func(m *mocks.MocktaskReadParser) {
+				m.EXPECT().Read(envControllerPath).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read env controller lambda: some error"),
+		},
+		"should register the task as an EFS workload of the environment to mount a managed volume": {
+			inEnv:     "test",
+			inStorage: managedVolume,
+			mockReadParser: func(m *mocks.MocktaskReadParser) {
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseTask(gomock.Any()).DoAndReturn(func(data template.TaskOpts) (*template.Content, error) {
+					require.Equal(t, "lambda", data.EnvControllerLambda)
+					require.Equal(t, "data", aws.StringValue(data.Storage.ManagedVolumeInfo.Name))
+					require.Equal(t, "task-my-task", aws.StringValue(data.Storage.ManagedVolumeInfo.DirName))
+					return &template.Content{Buffer: bytes.NewBufferString("This is the task template")}, nil
+				})
+			},
+			wantedTemplate: "This is the task template",
+		},
+		"should render the volumes and ephemeral storage": {
+			inStorage: &manifest.Storage{
+				Ephemeral: aws.Int(50),
+				Volumes: map[string]manifest.Volume{
+					"data": {
+						EFS: &manifest.EFSConfigOrBool{
+							Advanced: manifest.EFSVolumeConfiguration{
+								FileSystemID: aws.String("fs-1234"),
+								AuthConfig: &manifest.AuthorizationConfig{
+									IAM:           aws.Bool(true),
+									AccessPointID: aws.String("fsap-1234"),
+								},
+							},
+						},
+						MountPointOpts: manifest.MountPointOpts{
+							ContainerPath: aws.String("/var/data"),
+							ReadOnly:      aws.Bool(false),
+						},
+					},
+				},
+			},
+			mockReadParser: func(m *mocks.MocktaskReadParser) {
+				m.EXPECT().ParseTask(gomock.Any()).DoAndReturn(func(data template.TaskOpts) (*template.Content, error) {
+					storage := data.Storage
+					require.Equal(t, &template.StorageOpts{
+						Ephemeral: aws.Int(50),
+						Volumes: []*template.Volume{
+							{
+								Name: aws.String("data"),
+								EFS: &template.EFSVolumeConfiguration{
+									Filesystem:    aws.String("fs-1234"),
+									RootDirectory: aws.String("/"),
+									IAM:           aws.String("ENABLED"),
+									AccessPointID: aws.String("fsap-1234"),
+								},
+							},
+						},
+						MountPoints: []*template.MountPoint{
+							{
+								ContainerPath: aws.String("/var/data"),
+								ReadOnly:      aws.Bool(false),
+								SourceVolume:  aws.String("data"),
+							},
+						},
+						EFSPerms: []*template.EFSPermission{
+							{
+								FilesystemID:  aws.String("fs-1234"),
+								Write:         true,
+								AccessPointID: aws.String("fsap-1234"),
+							},
+						},
+					}, storage)
+					return &template.Content{Buffer: bytes.NewBufferString("This is the task template")}, nil
+				})
+			},
+			wantedTemplate: "This is the task template",
+		},
+		"should render the schedule with its expiry rounded up to the minute": {
+			inSchedule: &deploy.TaskSchedule{
+				Expression: "@daily",
+				Until:      &mockUntil,
+				Cluster:    "my-cluster",
+				Count:      1,
+			},
+			mockReadParser: func(m *mocks.MocktaskReadParser) {
+				m.EXPECT().ParseTask(gomock.Any()).DoAndReturn(func(data template.TaskOpts) (*template.Content, error) {
+					schedule := data.Schedule
+					require.Equal(t, "my-cluster", schedule.ClusterName)
+					require.Equal(t, "cron(10 15 14 3 ? 2021)", schedule.Expiry)
+					return &template.Content{Buffer: bytes.NewBufferString("This is the task template")}, nil
+				})
+			},
+			wantedTemplate: "This is the task template",
+		},
 		"should return error if unable to read": {
-			mockReadParser: func(m *mocks.MockReadParser) {
-				m.EXPECT().Parse(taskTemplatePath, gomock.Any()).Return(nil, errors.New("error reading template"))
+			mockReadParser: func(m *mocks.MocktaskReadParser) {
+				m.EXPECT().ParseTask(gomock.Any()).Return(nil, errors.New("error reading template"))
 			},
 			wantedError: errors.New("read template for task stack: error reading template"),
 		},
 		"should return template body when present": {
-			mockReadParser: func(m *mocks.MockReadParser) {
-				m.EXPECT().Parse(taskTemplatePath, gomock.Any()).Return(&template.Content{
+			mockReadParser: func(m *mocks.MocktaskReadParser) {
+				m.EXPECT().ParseTask(gomock.Any()).Return(&template.Content{
 					Buffer: bytes.NewBufferString("This is the task template"),
 				}, nil)
 			},
@@ -50,12 +182,18 @@ func TestTaskStackConfig_Template(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockReadParser := mocks.NewMockReadParser(ctrl)
+			mockReadParser := mocks.NewMocktaskReadParser(ctrl)
 			if tc.mockReadParser != nil {
 				tc.mockReadParser(mockReadParser)
 			}
 
-			taskInput := deploy.CreateTaskResourcesInput{}
+			taskInput := deploy.CreateTaskResourcesInput{
+				Name:     testTaskName,
+				Storage:  tc.inStorage,
+				Schedule: tc.inSchedule,
+				App:      "phonetool",
+				Env:      tc.inEnv,
+			}
 
 			taskStackConfig := &taskStackConfig{
 				CreateTaskResourcesInput: &taskInput,
@@ -111,10 +249,32 @@ func TestTaskStackConfig_Parameters(t *testing.T) {
 			ParameterKey:   aws.String(taskEntryPointParamKey),
 			ParameterValue: aws.String("exec,some command"),
 		},
+		{
+			ParameterKey:   aws.String(TaskScheduleParamKey),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String(TaskScheduleUntilParamKey),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String(taskAppNameParamKey),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String(taskEnvNameParamKey),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:   aws.String(taskWorkloadNameParamKey),
+			ParameterValue: aws.String("task-my-task"),
+		},
 	}
 
 	taskInput := deploy.CreateTaskResourcesInput{
 		Name:   "my-task",
+		App:    "phonetool",
+		Env:    "test",
 		CPU:    256,
 		Memory: 512,
 
@@ -132,6 +292,65 @@ func TestTaskStackConfig_Parameters(t *testing.T) {
 	require.ElementsMatch(t, expectedParams, params)
 }
 
+func TestTaskStackConfig_ScheduleParameters(t *testing.T) {
+	mockUntil := time.Date(2021, time.March, 14, 8, 9, 26, 0, time.FixedZone("PDT", -7*60*60))
+	testCases := map[string]struct {
+		inSchedule *deploy.TaskSchedule
+
+		wantedSchedule string
+		wantedUntil    string
+		wantedError    error
+	}{
+		"converts the schedule to an AWS expression": {
+			inSchedule: &deploy.TaskSchedule{
+				Expression: "30 9 * * 1-5",
+			},
+			wantedSchedule: "cron(30 9 ? * 2-6 *)",
+		},
+		"passes through AWS expressions and formats the expiry in UTC": {
+			inSchedule: &deploy.TaskSchedule{
+				Expression: "rate(1 hour)",
+				Until:      &mockUntil,
+			},
+			wantedSchedule: "rate(1 hour)",
+			wantedUntil:    "2021-03-14T15:09:26Z",
+		},
+		"returns an error if the schedule is invalid": {
+			inSchedule: &deploy.TaskSchedule{
+				Expression: "every day",
+			},
+			wantedError: errors.New("convert schedule for task my-task: schedule is not valid cron, rate, or preset: expected exactly 5 fields, found 2: [every day]"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := &taskStackConfig{
+				CreateTaskResourcesInput: &deploy.CreateTaskResourcesInput{
+					Name:     testTaskName,
+					Schedule: tc.inSchedule,
+				},
+			}
+
+			params, err := task.Parameters()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, params, &cloudformation.Parameter{
+				ParameterKey:   aws.String(TaskScheduleParamKey),
+				ParameterValue: aws.String(tc.wantedSchedule),
+			})
+			require.Contains(t, params, &cloudformation.Parameter{
+				ParameterKey:   aws.String(TaskScheduleUntilParamKey),
+				ParameterValue: aws.String(tc.wantedUntil),
+			})
+		})
+	}
+}
+
 func TestTaskStackConfig_StackName(t *testing.T) {
 	taskInput := deploy.CreateTaskResourcesInput{
 		Name: "my-task",
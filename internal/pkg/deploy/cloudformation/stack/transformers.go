@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -34,6 +35,36 @@ const (
 	ephemeralMaxValueGiB = 200
 )
 
+// Min and Max values for the autoscaling configuration of an App Runner service.
+const (
+	appRunnerMinConcurrency = 1
+	appRunnerMaxConcurrency = 200
+	appRunnerMinInstances   = 1
+	appRunnerMaxInstances   = 25
+)
+
+// Source code repository options for an App Runner service.
+const defaultAppRunnerSourceBranch = "main"
+
+var apprunnerRuntimes = []string{"PYTHON_3", "NODEJS_12", "NODEJS_14", "CORRETTO_8", "CORRETTO_11"}
+
+// Supported tracing vendors and the X-Ray daemon sidecar that collects the traces.
+const (
+	tracingVendorAWSXRay = "awsxray"
+	tracingAWSXRay       = "AWSXRAY"
+
+	xraySidecarName  = "xray"
+	xraySidecarImage = "public.ecr.aws/xray/aws-xray-daemon:latest"
+	xraySidecarPort  = "2000"
+)
+
+// Service mesh options and the Envoy proxy sidecar that routes the traffic of a service through the mesh.
+const (
+	envoySidecarName          = "envoy"
+	defaultMeshTimeoutSeconds = 15
+	maxMeshRetries            = 10
+)
+
 // Supported capacityproviders for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
@@ -49,17 +80,36 @@ type convertSidecarOpts struct {
 	sidecarConfig map[string]*manifest.SidecarConfig
 	imageConfig   *manifest.Image
 	workloadName  string
+	observability manifest.Observability
+	sidecarImages map[string]ECRImage // Images pushed to ECR for the sidecars built from a Dockerfile.
 }
 
 // convertSidecar converts the manifest sidecar configuration into a format parsable by the templates pkg.
+// If tracing is enabled, the X-Ray daemon sidecar is added to the list of sidecars.
 func convertSidecar(s convertSidecarOpts) ([]*template.SidecarOpts, error) {
+	observability, err := convertObservability(s.observability)
+	if err != nil {
+		return nil, err
+	}
+	var sidecars []*template.SidecarOpts
+	if observability != nil && observability.Tracing == tracingAWSXRay {
+		if _, ok := s.sidecarConfig[xraySidecarName]; ok {
+			return nil, fmt.Errorf("sidecar %s conflicts with the X-Ray daemon sidecar added for tracing", xraySidecarName)
+		}
+		sidecars = append(sidecars, &template.SidecarOpts{
+			Name:      aws.String(xraySidecarName),
+			Image:     aws.String(xraySidecarImage),
+			Essential: aws.Bool(false),
+			Port:      aws.String(xraySidecarPort),
+			Protocol:  aws.String("udp"),
+		})
+	}
 	if s.sidecarConfig == nil {
-		return nil, nil
+		return sidecars, nil
 	}
 	if err := validateNoCircularDependencies(s); err != nil {
 		return nil, err
 	}
-	var sidecars []*template.SidecarOpts
 	for name, config := range s.sidecarConfig {
 		port, protocol, err := parsePortMapping(config.Port)
 		if err != nil {
@@ -83,11 +133,16 @@ func convertSidecar(s convertSidecarOpts) ([]*template.SidecarOpts, error) {
 			return nil, err
 		}
 
+		image, err := convertSidecarImage(name, config.Image, s.sidecarImages)
+		if err != nil {
+			return nil, err
+		}
+
 		mp := convertSidecarMountPoints(config.MountPoints)
 
 		sidecars = append(sidecars, &template.SidecarOpts{
 			Name:         aws.String(name),
-			Image:        config.Image,
+			Image:        image,
 			Essential:    config.Essential,
 			Port:         port,
 			Protocol:     protocol,
@@ -104,6 +159,23 @@ func convertSidecar(s convertSidecarOpts) ([]*template.SidecarOpts, error) {
 	return sidecars, nil
 }
 
+// convertSidecarImage returns the location of the sidecar image.
+// If the image is built from a Dockerfile, the location is the image pushed to ECR for the sidecar.
+func convertSidecarImage(name string, image manifest.SidecarImage, pushed map[string]ECRImage) (*string, error) {
+	required, err := image.BuildRequired()
+	if err != nil {
+		return nil, fmt.Errorf("sidecar %s: %w", name, err)
+	}
+	if !required {
+		return image.Location, nil
+	}
+	ecrImage, ok := pushed[name]
+	if !ok {
+		return nil, fmt.Errorf("image of sidecar %s must be built and pushed to ECR", name)
+	}
+	return aws.String(ecrImage.GetLocation()), nil
+}
+
 // convertDependsOnStatus converts image and sidecar depends on fields to have upper case statuses
 func convertDependsOnStatus(s *convertSidecarOpts) {
 	if s.sidecarConfig != nil {
@@ -298,6 +370,86 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
+func convertObservability(o manifest.Observability) (*template.ObservabilityOpts, error) {
+	if o.Tracing == nil {
+		return nil, nil
+	}
+	if vendor := aws.StringValue(o.Tracing); strings.ToLower(vendor) != tracingVendorAWSXRay {
+		return nil, fmt.Errorf(`tracing vendor %s is not supported, the only supported vendor is "%s"`, vendor, tracingVendorAWSXRay)
+	}
+	return &template.ObservabilityOpts{
+		Tracing: tracingAWSXRay,
+	}, nil
+}
+
+// convertConnect validates the services that a workload is allowed to call and its service mesh configuration.
+// Each callee must set "network.connect" to accept calls, and must be in the service mesh if the workload is.
+// A workload in the service mesh must expose a port for the listener of its virtual node.
+func convertConnect(name string, port *uint16, network *manifest.NetworkConfig, sidecars map[string]*manifest.SidecarConfig, callees map[string]*manifest.ConnectConfig) (*template.ConnectOpts, error) {
+	if network == nil || network.Connect == nil {
+		return nil, nil
+	}
+	connect := network.Connect
+	seen := make(map[string]bool)
+	for _, svc := range connect.Services {
+		if svc == name {
+			return nil, fmt.Errorf(`service %s cannot be listed in its own "network.connect.services"`, name)
+		}
+		if seen[svc] {
+			return nil, fmt.Errorf(`service %s is listed more than once in "network.connect.services"`, svc)
+		}
+		seen[svc] = true
+		callee := callees[svc]
+		if callee == nil {
+			return nil, fmt.Errorf(`service %s must set "network.connect" to accept calls from service %s`, svc, name)
+		}
+		if connect.Mesh.IsEnabled() && !callee.Mesh.IsEnabled() {
+			return nil, fmt.Errorf(`service %s must enable "network.connect.mesh" to accept calls from service %s through the service mesh`, svc, name)
+		}
+	}
+	opts := &template.ConnectOpts{
+		Services: connect.Services,
+	}
+	if !connect.Mesh.IsEnabled() {
+		return opts, nil
+	}
+	if port == nil {
+		return nil, fmt.Errorf(`service %s must expose a port with "image.port" to be part of the service mesh`, name)
+	}
+	if _, ok := sidecars[envoySidecarName]; ok {
+		return nil, fmt.Errorf("sidecar %s conflicts with the Envoy proxy sidecar added for the service mesh", envoySidecarName)
+	}
+	mesh := &template.MeshOpts{
+		TimeoutSeconds: defaultMeshTimeoutSeconds,
+	}
+	if retries := connect.Mesh.Config.Retries; retries != nil {
+		if *retries < 0 || *retries > maxMeshRetries {
+			return nil, fmt.Errorf(`field "network.connect.mesh.retries" is %d but must be between 0 and %d`, *retries, maxMeshRetries)
+		}
+		mesh.Retries = *retries
+	}
+	if timeout := connect.Mesh.Config.Timeout; timeout != nil {
+		if *timeout < time.Second || *timeout%time.Second != 0 {
+			return nil, fmt.Errorf(`field "network.connect.mesh.timeout" is %s but must be a whole number of seconds`, timeout)
+		}
+		mesh.TimeoutSeconds = int(timeout.Seconds())
+	}
+	opts.Mesh = mesh
+	return opts, nil
+}
+
+// addEnvoyDependency makes the main container of a workload in a service mesh wait for its Envoy proxy to be healthy.
+func addEnvoyDependency(connect *template.ConnectOpts, dependencies map[string]string) map[string]string {
+	if connect == nil || connect.Mesh == nil {
+		return dependencies
+	}
+	if dependencies == nil {
+		dependencies = make(map[string]string)
+	}
+	dependencies[envoySidecarName] = dependsOnHealthy
+	return dependencies
+}
+
 func convertLogging(lc *manifest.Logging) *template.LogConfigOpts {
 	if lc == nil {
 		return nil
@@ -563,6 +715,117 @@ func convertNetworkConfig(network *manifest.NetworkConfig) *template.NetworkOpts
 	return opts
 }
 
+// convertAppRunnerNetworkConfig returns true if the outbound traffic of an App Runner service
+// should be routed through a VPC connector into the private subnets of the environment.
+func convertAppRunnerNetworkConfig(network manifest.RequestDrivenWebServiceNetworkConfig) (bool, error) {
+	if network.VPC.IsEmpty() {
+		return false, nil
+	}
+	if placement := aws.StringValue(network.VPC.Placement); placement != manifest.PrivateSubnetPlacement {
+		return false, fmt.Errorf(`field "network.vpc.placement" is "%s" but the only supported placement is "%s"`, placement, manifest.PrivateSubnetPlacement)
+	}
+	return true, nil
+}
+
+// convertAppRunnerSecrets strips the leading slash of SSM parameter names so that they can be turned into ARNs by the template.
+// ARNs of SSM parameters and Secrets Manager secrets are left as-is.
+func convertAppRunnerSecrets(secrets map[string]string) map[string]string {
+	if len(secrets) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(secrets))
+	for name, valueFrom := range secrets {
+		if arn.IsARN(valueFrom) {
+			out[name] = valueFrom
+			continue
+		}
+		out[name] = strings.TrimPrefix(valueFrom, "/")
+	}
+	return out
+}
+
+func convertAppRunnerAutoscaling(a manifest.AppRunnerAutoscalingConfig) (*template.AppRunnerAutoscalingOpts, error) {
+	if a.IsEmpty() {
+		return nil, nil
+	}
+	if a.MaxConcurrency != nil {
+		if c := aws.IntValue(a.MaxConcurrency); c < appRunnerMinConcurrency || c > appRunnerMaxConcurrency {
+			return nil, fmt.Errorf(`field "autoscaling.max_concurrency" must be between %d and %d`, appRunnerMinConcurrency, appRunnerMaxConcurrency)
+		}
+	}
+	if err := validateAppRunnerInstances("autoscaling.min_instances", a.MinInstances); err != nil {
+		return nil, err
+	}
+	if err := validateAppRunnerInstances("autoscaling.max_instances", a.MaxInstances); err != nil {
+		return nil, err
+	}
+	if a.MinInstances != nil && a.MaxInstances != nil && aws.IntValue(a.MinInstances) > aws.IntValue(a.MaxInstances) {
+		return nil, fmt.Errorf(`field "autoscaling.min_instances" cannot be greater than "autoscaling.max_instances"`)
+	}
+	return &template.AppRunnerAutoscalingOpts{
+		MaxConcurrency: a.MaxConcurrency,
+		MinSize:        a.MinInstances,
+		MaxSize:        a.MaxInstances,
+	}, nil
+}
+
+func convertAppRunnerSource(s manifest.AppRunnerSourceConfig) (*template.AppRunnerSourceOpts, error) {
+	if s.IsEmpty() {
+		return nil, nil
+	}
+	for _, field := range []struct {
+		name string
+		val  *string
+	}{
+		{"image.source.connection", s.Connection},
+		{"image.source.repository", s.Repository},
+		{"image.source.runtime", s.Runtime},
+		{"image.source.start_command", s.StartCommand},
+	} {
+		if aws.StringValue(field.val) == "" {
+			return nil, fmt.Errorf(`field "%s" must be specified when building from source`, field.name)
+		}
+	}
+	if !arn.IsARN(aws.StringValue(s.Connection)) {
+		return nil, fmt.Errorf(`field "image.source.connection" must be the ARN of an App Runner connection`)
+	}
+	runtime := strings.ToUpper(aws.StringValue(s.Runtime))
+	if !isValidAppRunnerRuntime(runtime) {
+		return nil, fmt.Errorf(`field "image.source.runtime" is "%s" but must be one of %s`, aws.StringValue(s.Runtime), strings.Join(apprunnerRuntimes, ", "))
+	}
+	branch := aws.StringValue(s.Branch)
+	if branch == "" {
+		branch = defaultAppRunnerSourceBranch
+	}
+	return &template.AppRunnerSourceOpts{
+		ConnectionARN: aws.StringValue(s.Connection),
+		RepositoryURL: aws.StringValue(s.Repository),
+		Branch:        branch,
+		Runtime:       runtime,
+		BuildCommand:  aws.StringValue(s.BuildCommand),
+		StartCommand:  aws.StringValue(s.StartCommand),
+	}, nil
+}
+
+func isValidAppRunnerRuntime(runtime string) bool {
+	for _, r := range apprunnerRuntimes {
+		if runtime == r {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAppRunnerInstances(field string, val *int) error {
+	if val == nil {
+		return nil
+	}
+	if n := aws.IntValue(val); n < appRunnerMinInstances || n > appRunnerMaxInstances {
+		return fmt.Errorf(`field "%s" must be between %d and %d`, field, appRunnerMinInstances, appRunnerMaxInstances)
+	}
+	return nil
+}
+
 func convertEntryPoint(entrypoint *manifest.EntryPointOverride) ([]string, error) {
 	if entrypoint == nil {
 		return nil, nil
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -245,7 +246,7 @@ func Test_convertSidecar(t *testing.T) {
 			sidecar := map[string]*manifest.SidecarConfig{
 				"foo": {
 					CredsParam:    mockCredsParam,
-					Image:         mockImage,
+					Image:         manifest.SidecarImage{Location: mockImage},
 					Secrets:       mockMap,
 					Variables:     mockMap,
 					Essential:     aws.Bool(tc.inEssential),
@@ -276,6 +277,58 @@ func Test_convertSidecar(t *testing.T) {
 	}
 }
 
+func Test_convertSidecarImage(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.SidecarImage
+		pushed map[string]ECRImage
+
+		wanted    *string
+		wantedErr error
+	}{
+		"returns the location of an existing image": {
+			in:     manifest.SidecarImage{Location: aws.String("nginx")},
+			wanted: aws.String("nginx"),
+		},
+		"returns the image pushed to ECR if the image is built from a Dockerfile": {
+			in: manifest.SidecarImage{
+				Build: manifest.BuildArgsOrString{BuildString: aws.String("proxy/Dockerfile")},
+			},
+			pushed: map[string]ECRImage{
+				"foo": {
+					RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend/foo",
+					ImageTag: "g123bfc",
+				},
+			},
+			wanted: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend/foo:g123bfc"),
+		},
+		"errors if the image built from a Dockerfile was not pushed": {
+			in: manifest.SidecarImage{
+				Build: manifest.BuildArgsOrString{BuildString: aws.String("proxy/Dockerfile")},
+			},
+			wantedErr: errors.New("image of sidecar foo must be built and pushed to ECR"),
+		},
+		"errors if both build and location are specified": {
+			in: manifest.SidecarImage{
+				Build:    manifest.BuildArgsOrString{BuildString: aws.String("proxy/Dockerfile")},
+				Location: aws.String("nginx"),
+			},
+			wantedErr: errors.New(`sidecar foo: cannot specify both "build" and "location" for a sidecar image`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertSidecarImage("foo", tc.in, tc.pushed)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertAdvancedCount(t *testing.T) {
 	mockRange := manifest.IntRangeBand("1-10")
 	testCases := map[string]struct {
@@ -1216,6 +1269,490 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertConnect(t *testing.T) {
+	duration1500Milliseconds := 1500 * time.Millisecond
+	duration5Seconds := 5 * time.Second
+	testCases := map[string]struct {
+		inNoPort   bool
+		inNetwork  *manifest.NetworkConfig
+		inSidecars map[string]*manifest.SidecarConfig
+		inCallees  map[string]*manifest.ConnectConfig
+
+		wanted      *template.ConnectOpts
+		wantedError error
+	}{
+		"without connect": {
+			inNetwork: &manifest.NetworkConfig{},
+		},
+		"error if the service calls itself": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api", "frontend"},
+				},
+			},
+			inCallees: map[string]*manifest.ConnectConfig{
+				"api": {},
+			},
+			wantedError: fmt.Errorf(`service frontend cannot be listed in its own "network.connect.services"`),
+		},
+		"error if a service is listed twice": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api", "api"},
+				},
+			},
+			inCallees: map[string]*manifest.ConnectConfig{
+				"api": {},
+			},
+			wantedError: fmt.Errorf(`service api is listed more than once in "network.connect.services"`),
+		},
+		"error if a callee does not set network connect": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api"},
+				},
+			},
+			inCallees: map[string]*manifest.ConnectConfig{
+				"api": nil,
+			},
+			wantedError: fmt.Errorf(`service api must set "network.connect" to accept calls from service frontend`),
+		},
+		"error if a callee is unknown": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api"},
+				},
+			},
+			wantedError: fmt.Errorf(`service api must set "network.connect" to accept calls from service frontend`),
+		},
+		"error if a callee is not in the service mesh": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api"},
+					Mesh: manifest.ServiceMesh{
+						Enable: aws.Bool(true),
+					},
+				},
+			},
+			inCallees: map[string]*manifest.ConnectConfig{
+				"api": {},
+			},
+			wantedError: fmt.Errorf(`service api must enable "network.connect.mesh" to accept calls from service frontend through the service mesh`),
+		},
+		"error if a service in the service mesh doesn't expose a port": {
+			inNoPort: true,
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Mesh: manifest.ServiceMesh{
+						Enable: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`service frontend must expose a port with "image.port" to be part of the service mesh`),
+		},
+		"connect without mesh doesn't require a port": {
+			inNoPort: true,
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api"},
+				},
+			},
+			inCallees: map[string]*manifest.ConnectConfig{
+				"api": {},
+			},
+			wanted: &template.ConnectOpts{
+				Services: []string{"api"},
+			},
+		},
+		"error if a sidecar is named envoy": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Mesh: manifest.ServiceMesh{
+						Enable: aws.Bool(true),
+					},
+				},
+			},
+			inSidecars: map[string]*manifest.SidecarConfig{
+				"envoy": {},
+			},
+			wantedError: fmt.Errorf("sidecar envoy conflicts with the Envoy proxy sidecar added for the service mesh"),
+		},
+		"error if retries is out of range": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Mesh: manifest.ServiceMesh{
+						Config: manifest.ServiceMeshConfig{
+							Retries: aws.Int(11),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`field "network.connect.mesh.retries" is 11 but must be between 0 and 10`),
+		},
+		"error if timeout is not a whole number of seconds": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Mesh: manifest.ServiceMesh{
+						Config: manifest.ServiceMeshConfig{
+							Timeout: &duration1500Milliseconds,
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`field "network.connect.mesh.timeout" is 1.5s but must be a whole number of seconds`),
+		},
+		"connect without mesh": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api"},
+				},
+			},
+			inCallees: map[string]*manifest.ConnectConfig{
+				"api": {},
+			},
+			wanted: &template.ConnectOpts{
+				Services: []string{"api"},
+			},
+		},
+		"mesh with default timeout": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Services: []string{"api"},
+					Mesh: manifest.ServiceMesh{
+						Enable: aws.Bool(true),
+					},
+				},
+			},
+			inCallees: map[string]*manifest.ConnectConfig{
+				"api": {
+					Mesh: manifest.ServiceMesh{
+						Enable: aws.Bool(true),
+					},
+				},
+			},
+			wanted: &template.ConnectOpts{
+				Services: []string{"api"},
+				Mesh: &template.MeshOpts{
+					TimeoutSeconds: 15,
+				},
+			},
+		},
+		"mesh with config": {
+			inNetwork: &manifest.NetworkConfig{
+				Connect: &manifest.ConnectConfig{
+					Mesh: manifest.ServiceMesh{
+						Config: manifest.ServiceMeshConfig{
+							Retries: aws.Int(3),
+							Timeout: &duration5Seconds,
+						},
+					},
+				},
+			},
+			wanted: &template.ConnectOpts{
+				Mesh: &template.MeshOpts{
+					Retries:        3,
+					TimeoutSeconds: 5,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			port := aws.Uint16(80)
+			if tc.inNoPort {
+				port = nil
+			}
+			got, err := convertConnect("frontend", port, tc.inNetwork, tc.inSidecars, tc.inCallees)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertObservability(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.Observability
+
+		wanted    *template.ObservabilityOpts
+		wantedErr error
+	}{
+		"tracing not configured": {},
+		"unsupported tracing vendor": {
+			in: manifest.Observability{
+				Tracing: aws.String("datadog"),
+			},
+			wantedErr: fmt.Errorf(`tracing vendor datadog is not supported, the only supported vendor is "awsxray"`),
+		},
+		"awsxray tracing": {
+			in: manifest.Observability{
+				Tracing: aws.String("AWSXRay"),
+			},
+			wanted: &template.ObservabilityOpts{
+				Tracing: "AWSXRAY",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertObservability(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertAppRunnerNetworkConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.RequestDrivenWebServiceNetworkConfig
+
+		wanted    bool
+		wantedErr error
+	}{
+		"vpc not configured": {},
+		"public placement": {
+			in: manifest.RequestDrivenWebServiceNetworkConfig{
+				VPC: manifest.RequestDrivenWebServiceVpcConfig{
+					Placement: aws.String("public"),
+				},
+			},
+			wantedErr: fmt.Errorf(`field "network.vpc.placement" is "public" but the only supported placement is "private"`),
+		},
+		"private placement": {
+			in: manifest.RequestDrivenWebServiceNetworkConfig{
+				VPC: manifest.RequestDrivenWebServiceVpcConfig{
+					Placement: aws.String("private"),
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertAppRunnerNetworkConfig(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertAppRunnerSecrets(t *testing.T) {
+	testCases := map[string]struct {
+		in     map[string]string
+		wanted map[string]string
+	}{
+		"no secrets": {},
+		"ssm parameter names and arns": {
+			in: map[string]string{
+				"GITHUB_TOKEN": "/copilot/my-app/test/secrets/GITHUB_TOKEN",
+				"API_KEY":      "API_KEY",
+				"DB_SECRET":    "arn:aws:secretsmanager:us-west-2:123456789012:secret:mydb-abcdef",
+				"PARAM":        "arn:aws:ssm:us-west-2:123456789012:parameter/param",
+			},
+			wanted: map[string]string{
+				"GITHUB_TOKEN": "copilot/my-app/test/secrets/GITHUB_TOKEN",
+				"API_KEY":      "API_KEY",
+				"DB_SECRET":    "arn:aws:secretsmanager:us-west-2:123456789012:secret:mydb-abcdef",
+				"PARAM":        "arn:aws:ssm:us-west-2:123456789012:parameter/param",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAppRunnerSecrets(tc.in))
+		})
+	}
+}
+
+func Test_convertAppRunnerAutoscaling(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.AppRunnerAutoscalingConfig
+
+		wanted    *template.AppRunnerAutoscalingOpts
+		wantedErr error
+	}{
+		"autoscaling not configured": {},
+		"invalid max concurrency": {
+			in: manifest.AppRunnerAutoscalingConfig{
+				MaxConcurrency: aws.Int(201),
+			},
+			wantedErr: fmt.Errorf(`field "autoscaling.max_concurrency" must be between 1 and 200`),
+		},
+		"invalid min instances": {
+			in: manifest.AppRunnerAutoscalingConfig{
+				MinInstances: aws.Int(0),
+			},
+			wantedErr: fmt.Errorf(`field "autoscaling.min_instances" must be between 1 and 25`),
+		},
+		"invalid max instances": {
+			in: manifest.AppRunnerAutoscalingConfig{
+				MaxInstances: aws.Int(26),
+			},
+			wantedErr: fmt.Errorf(`field "autoscaling.max_instances" must be between 1 and 25`),
+		},
+		"min instances greater than max instances": {
+			in: manifest.AppRunnerAutoscalingConfig{
+				MinInstances: aws.Int(5),
+				MaxInstances: aws.Int(2),
+			},
+			wantedErr: fmt.Errorf(`field "autoscaling.min_instances" cannot be greater than "autoscaling.max_instances"`),
+		},
+		"success": {
+			in: manifest.AppRunnerAutoscalingConfig{
+				MaxConcurrency: aws.Int(50),
+				MinInstances:   aws.Int(2),
+				MaxInstances:   aws.Int(10),
+			},
+			wanted: &template.AppRunnerAutoscalingOpts{
+				MaxConcurrency: aws.Int(50),
+				MinSize:        aws.Int(2),
+				MaxSize:        aws.Int(10),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertAppRunnerAutoscaling(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertAppRunnerSource(t *testing.T) {
+	const connectionARN = "arn:aws:apprunner:us-west-2:123456789012:connection/github/abc"
+	testCases := map[string]struct {
+		in manifest.AppRunnerSourceConfig
+
+		wanted    *template.AppRunnerSourceOpts
+		wantedErr error
+	}{
+		"source not configured": {},
+		"missing start command": {
+			in: manifest.AppRunnerSourceConfig{
+				Connection: aws.String(connectionARN),
+				Repository: aws.String("https://github.com/user/repo"),
+				Runtime:    aws.String("PYTHON_3"),
+			},
+			wantedErr: fmt.Errorf(`field "image.source.start_command" must be specified when building from source`),
+		},
+		"connection is not an ARN": {
+			in: manifest.AppRunnerSourceConfig{
+				Connection:   aws.String("github"),
+				Repository:   aws.String("https://github.com/user/repo"),
+				Runtime:      aws.String("PYTHON_3"),
+				StartCommand: aws.String("python app.py"),
+			},
+			wantedErr: fmt.Errorf(`field "image.source.connection" must be the ARN of an App Runner connection`),
+		},
+		"unsupported runtime": {
+			in: manifest.AppRunnerSourceConfig{
+				Connection:   aws.String(connectionARN),
+				Repository:   aws.String("https://github.com/user/repo"),
+				Runtime:      aws.String("ruby"),
+				StartCommand: aws.String("ruby app.rb"),
+			},
+			wantedErr: fmt.Errorf(`field "image.source.runtime" is "ruby" but must be one of PYTHON_3, NODEJS_12, NODEJS_14, CORRETTO_8, CORRETTO_11`),
+		},
+		"success with default branch": {
+			in: manifest.AppRunnerSourceConfig{
+				Connection:   aws.String(connectionARN),
+				Repository:   aws.String("https://github.com/user/repo"),
+				Runtime:      aws.String("nodejs_14"),
+				BuildCommand: aws.String("npm install"),
+				StartCommand: aws.String("node server.js"),
+			},
+			wanted: &template.AppRunnerSourceOpts{
+				ConnectionARN: connectionARN,
+				RepositoryURL: "https://github.com/user/repo",
+				Branch:        "main",
+				Runtime:       "NODEJS_14",
+				BuildCommand:  "npm install",
+				StartCommand:  "node server.js",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertAppRunnerSource(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_convertSidecar_tracing(t *testing.T) {
+	testCases := map[string]struct {
+		inSidecars map[string]*manifest.SidecarConfig
+
+		wanted    []*template.SidecarOpts
+		wantedErr error
+	}{
+		"adds the X-Ray daemon sidecar": {
+			wanted: []*template.SidecarOpts{
+				{
+					Name:      aws.String("xray"),
+					Image:     aws.String("public.ecr.aws/xray/aws-xray-daemon:latest"),
+					Essential: aws.Bool(false),
+					Port:      aws.String("2000"),
+					Protocol:  aws.String("udp"),
+				},
+			},
+		},
+		"errors if a sidecar has the same name as the X-Ray daemon sidecar": {
+			inSidecars: map[string]*manifest.SidecarConfig{
+				"xray": {
+					Image: manifest.SidecarImage{Location: aws.String("amazon/aws-xray-daemon")},
+				},
+			},
+			wantedErr: fmt.Errorf("sidecar xray conflicts with the X-Ray daemon sidecar added for tracing"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertSidecar(convertSidecarOpts{
+				sidecarConfig: tc.inSidecars,
+				imageConfig:   &manifest.Image{},
+				workloadName:  "frontend",
+				observability: manifest.Observability{
+					Tracing: aws.String("awsxray"),
+				},
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
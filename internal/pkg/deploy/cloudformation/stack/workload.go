@@ -68,10 +68,14 @@ const maxDockerContainerPathLength = 242
 // RuntimeConfig represents configuration that's defined outside of the manifest file
 // that is needed to create a CloudFormation stack.
 type RuntimeConfig struct {
-	Image                    *ECRImage         // Optional. Image location in an ECR repository.
-	AddonsTemplateURL        string            // Optional. S3 object URL for the addons template.
-	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources in the workload stack.
-	ServiceDiscoveryEndpoint string            // Endpoint for the service discovery namespace in the environment.
+	Image                    *ECRImage           // Optional. Image location in an ECR repository.
+	SidecarImages            map[string]ECRImage // Optional. Image locations in ECR of the sidecars built from a Dockerfile, keyed by sidecar name.
+	AddonsTemplateURL        string              // Optional. S3 object URL for the addons template.
+	AdditionalTags           map[string]string   // AdditionalTags are labels applied to resources in the workload stack.
+	ServiceDiscoveryEndpoint string              // Endpoint for the service discovery namespace in the environment.
+	// Optional. The network connect configuration of the services that the workload is allowed to call, keyed by service name.
+	// A nil configuration means that the service does not set "network.connect".
+	ConnectCallees map[string]*manifest.ConnectConfig
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
@@ -291,6 +295,7 @@ type appRunnerWkld struct {
 	*wkld
 	instanceConfig    manifest.AppRunnerInstanceConfig
 	imageConfig       manifest.ImageWithPort
+	sourceConfig      manifest.AppRunnerSourceConfig
 	healthCheckConfig manifest.HealthCheckArgsOrString
 }
 
@@ -308,9 +313,13 @@ func (w *appRunnerWkld) Parameters() ([]*cloudformation.Parameter, error) {
 		img = w.rc.Image.GetLocation()
 	}
 
-	imageRepositoryType, err := apprunner.DetermineImageRepositoryType(img)
-	if err != nil {
-		return nil, fmt.Errorf("determining image repository type: %w", err)
+	// Services built from a source code repository don't have an image.
+	var imageRepositoryType string
+	if w.sourceConfig.IsEmpty() {
+		imageRepositoryType, err = apprunner.DetermineImageRepositoryType(img)
+		if err != nil {
+			return nil, fmt.Errorf("determining image repository type: %w", err)
+		}
 	}
 
 	appRunnerParameters := []*cloudformation.Parameter{
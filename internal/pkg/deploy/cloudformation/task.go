@@ -6,6 +6,7 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -53,13 +54,15 @@ func (cf CloudFormation) ListTaskStacks(appName, envName string) ([]deploy.TaskS
 	var outputTaskStacks []deploy.TaskStackInfo
 	for _, task := range tasks {
 
-		outputTaskStacks = append(outputTaskStacks, deploy.TaskStackInfo{
+		info := deploy.TaskStackInfo{
 			StackName: aws.StringValue(task.StackName),
 			App:       appName,
 			Env:       envName,
 
 			RoleARN: aws.StringValue(task.RoleARN),
-		})
+		}
+		setTaskSchedule(&info, task)
+		outputTaskStacks = append(outputTaskStacks, info)
 	}
 	return outputTaskStacks, nil
 }
@@ -76,6 +79,7 @@ func (cf CloudFormation) GetTaskStack(taskName string) (*deploy.TaskStackInfo, e
 		StackName: stackName,
 		RoleARN:   aws.StringValue(desc.RoleARN),
 	}
+	setTaskSchedule(&info, *desc)
 	var isTask bool
 	for _, tag := range desc.Tags {
 		switch aws.StringValue(tag.Key) {
@@ -114,13 +118,37 @@ func (cf CloudFormation) ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error)
 		if hasAppTag || hasEnvTag {
 			continue
 		}
-		outputTaskStacks = append(outputTaskStacks, deploy.TaskStackInfo{
+		info := deploy.TaskStackInfo{
 			StackName: aws.StringValue(task.StackName),
-		})
+		}
+		setTaskSchedule(&info, task)
+		outputTaskStacks = append(outputTaskStacks, info)
 	}
 	return outputTaskStacks, nil
 }
 
+// setTaskSchedule sets the schedule of a recurring task from the parameters of its stack.
+func setTaskSchedule(info *deploy.TaskStackInfo, desc cloudformation.StackDescription) {
+	for _, param := range desc.Parameters {
+		switch aws.StringValue(param.ParameterKey) {
+		case stack.TaskScheduleParamKey:
+			info.Schedule = aws.StringValue(param.ParameterValue)
+		case stack.TaskScheduleUntilParamKey:
+			// Best-effort parse the expiry of the schedule. If it can't be parsed, the schedule is shown without it.
+			if until, err := time.Parse(time.RFC3339, aws.StringValue(param.ParameterValue)); err == nil {
+				info.ScheduleUntil = &until
+			}
+		}
+	}
+	if info.Schedule == "" {
+		return
+	}
+	info.UpdatedAt = aws.TimeValue(desc.CreationTime)
+	if desc.LastUpdatedTime != nil {
+		info.UpdatedAt = aws.TimeValue(desc.LastUpdatedTime)
+	}
+}
+
 // DeleteTask deletes a Copilot-created one-off task stack using the RoleARN that stack was created with.
 // If there is no role arn specified, it tries to delete the stack using the default session.
 func (cf CloudFormation) DeleteTask(task deploy.TaskStackInfo) error {
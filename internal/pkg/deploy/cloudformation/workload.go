@@ -43,3 +43,8 @@ func (cf CloudFormation) handleStackError(stackName string, err error) error {
 func (cf CloudFormation) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
 }
+
+// CancelWorkloadUpdate cancels the in-progress deployment of a workload's stack, which rolls the stack back to its previous configuration.
+func (cf CloudFormation) CancelWorkloadUpdate(stackName string) error {
+	return cf.cfnClient.CancelUpdate(stackName)
+}
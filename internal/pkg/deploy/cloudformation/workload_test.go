@@ -4,6 +4,7 @@
 package cloudformation
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -133,3 +134,20 @@ func TestCloudFormation_DeleteWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_CancelWorkloadUpdate(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().CancelUpdate("kudos-test-webhook").Return(errors.New("some error"))
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	err := c.CancelWorkloadUpdate("kudos-test-webhook")
+
+	// THEN
+	require.EqualError(t, err, "some error")
+}
@@ -24,6 +24,8 @@ const (
 	ServiceTagKey = "copilot-service"
 	// TaskTagKey is tag key for Copilot task.
 	TaskTagKey = "copilot-task"
+	// StartedByTagKey is tag key for the identity that started a Copilot task.
+	StartedByTagKey = "copilot-started-by"
 )
 
 const (
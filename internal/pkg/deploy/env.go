@@ -13,7 +13,7 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.5.1"
+	LatestEnvTemplateVersion = "v1.13.0"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -28,6 +28,9 @@ type CreateEnvironmentInput struct {
 	CustomResourcesURLs map[string]string // Environment custom resource script S3 object URLs.
 	ImportVPCConfig     *config.ImportVPC // Optional configuration if users have an existing VPC.
 	AdjustVPCConfig     *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	ImportCertARNs      []string          // Optional configuration if users want to import certificates.
+	VPCEndpoints        bool              // Optional configuration if users want VPC endpoints instead of NAT gateways.
+	Telemetry           *config.Telemetry // Optional telemetry features such as Container Insights.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
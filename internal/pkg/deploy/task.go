@@ -7,12 +7,33 @@ package deploy
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/robfig/cron/v3"
 )
 
 // FmtTaskECRRepoName is the pattern used to generate the ECR repository's name
 const FmtTaskECRRepoName = "copilot-%s"
 
+// Operating system families and CPU architectures of the Fargate platforms that tasks can run on.
+const (
+	TaskOSLinux   = "LINUX"
+	TaskOSWindows = "WINDOWS_SERVER_2019_CORE"
+	TaskArchX86   = "X86_64"
+	TaskArchARM64 = "ARM64"
+)
+
+var (
+	awsRateRegexp = regexp.MustCompile(`^rate\((\d+) (minute|hour|day)s?\)$`)
+	awsCronRegexp = regexp.MustCompile(`^cron\((.*)\)$`)
+
+	awsCronDayOfWeekReplacer = strings.NewReplacer("1", "0", "2", "1", "3", "2", "4", "3", "5", "4", "6", "5", "7", "6")
+)
+
 // CreateTaskResourcesInput holds the fields required to create a task stack.
 type CreateTaskResourcesInput struct {
 	Name   string
@@ -26,6 +47,14 @@ type CreateTaskResourcesInput struct {
 	EntryPoint    []string
 	EnvVars       map[string]string
 	Secrets       map[string]string
+	// OS and Arch render the runtime platform of the task definition if they are not empty.
+	OS   string
+	Arch string
+	// Storage holds the EFS volumes and the ephemeral storage of the task.
+	// Copilot-managed volumes must be resolved to the file system ID of the environment beforehand.
+	Storage *manifest.Storage
+	// Schedule runs the task on a recurring schedule instead of once if it is not nil.
+	Schedule *TaskSchedule
 
 	App string
 	Env string
@@ -33,6 +62,21 @@ type CreateTaskResourcesInput struct {
 	AdditionalTags map[string]string
 }
 
+// TaskSchedule holds the fields required to run a task on a recurring schedule.
+type TaskSchedule struct {
+	Expression string     // Cron or rate expression, such as "@daily" or "rate(1 hour)".
+	Until      *time.Time // The schedule is disabled after this time if it is not nil.
+
+	// Configuration of the tasks started every time the schedule triggers.
+	Cluster         string
+	Count           int
+	Subnets         []string
+	SecurityGroups  []string
+	AssignPublicIP  bool
+	Spot            bool
+	PlatformVersion string
+}
+
 // TaskStackInfo contains essential information about a Copilot task stack
 type TaskStackInfo struct {
 	StackName string
@@ -40,6 +84,12 @@ type TaskStackInfo struct {
 	Env       string
 
 	RoleARN string
+
+	// Schedule is the AWS schedule expression of a recurring task. It is empty if the task is not scheduled.
+	Schedule      string
+	ScheduleUntil *time.Time
+	// UpdatedAt is the last time the stack, and hence its schedule, was deployed.
+	UpdatedAt time.Time
 }
 
 // TaskName returns the name of the one-off task. This is the same as the value of the
@@ -52,3 +102,60 @@ func (t TaskStackInfo) TaskName() string {
 func (t TaskStackInfo) ECRRepoName() string {
 	return fmt.Sprintf(FmtTaskECRRepoName, t.TaskName())
 }
+
+// NextRun returns the first time after t at which the schedule of the task starts new tasks.
+// It returns false if the task is not scheduled, if the schedule expires before then, or if the
+// schedule uses a cron syntax specific to AWS, such as "L", "W" or "#", that can't be evaluated.
+// Rate schedules are counted from the last time the stack was deployed, which is
+// approximately when EventBridge starts the interval.
+func (t TaskStackInfo) NextRun(after time.Time) (time.Time, bool) {
+	var next time.Time
+	if match := awsRateRegexp.FindStringSubmatch(t.Schedule); match != nil {
+		value, err := strconv.Atoi(match[1])
+		if err != nil || value <= 0 {
+			return time.Time{}, false
+		}
+		interval := time.Duration(value) * map[string]time.Duration{
+			"minute": time.Minute,
+			"hour":   time.Hour,
+			"day":    24 * time.Hour,
+		}[match[2]]
+		next = t.UpdatedAt.Add(interval)
+		if next.Before(after) {
+			next = next.Add(after.Sub(next).Truncate(interval) + interval)
+		}
+	} else if match := awsCronRegexp.FindStringSubmatch(t.Schedule); match != nil {
+		schedule, ok := parseAWSCron(match[1])
+		if !ok {
+			return time.Time{}, false
+		}
+		next = schedule.Next(after.UTC())
+	} else {
+		return time.Time{}, false
+	}
+	if t.ScheduleUntil != nil && next.After(*t.ScheduleUntil) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// parseAWSCron parses the six fields of an AWS cron expression into a standard cron schedule.
+// AWS crons have an additional year field, use "?" for either day-of-month or day-of-week,
+// and their day-of-week is one-indexed.
+func parseAWSCron(expr string) (cron.Schedule, bool) {
+	fields := strings.Fields(expr)
+	if len(fields) != 6 || fields[5] != "*" || strings.ContainsAny(expr, "LW#") {
+		return nil, false
+	}
+	for i, field := range fields[2:5] {
+		if field == "?" {
+			fields[i+2] = "*"
+		}
+	}
+	fields[4] = awsCronDayOfWeekReplacer.Replace(fields[4])
+	schedule, err := cron.ParseStandard(strings.Join(fields[:5], " "))
+	if err != nil {
+		return nil, false
+	}
+	return schedule, true
+}
@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStackInfo_NextRun(t *testing.T) {
+	now := time.Date(2021, time.March, 14, 15, 9, 26, 0, time.UTC) // A Sunday.
+	until := time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inSchedule  string
+		inUntil     *time.Time
+		inUpdatedAt time.Time
+
+		wantedNext time.Time
+		wantedOK   bool
+	}{
+		"not scheduled": {},
+		"rate before its first run": {
+			inSchedule:  "rate(1 hour)",
+			inUpdatedAt: now.Add(-10 * time.Minute),
+
+			wantedNext: now.Add(50 * time.Minute),
+			wantedOK:   true,
+		},
+		"rate counted from the last deployment": {
+			inSchedule:  "rate(30 minutes)",
+			inUpdatedAt: now.Add(-100 * time.Minute),
+
+			wantedNext: now.Add(20 * time.Minute),
+			wantedOK:   true,
+		},
+		"cron with one-indexed day of week": {
+			inSchedule: "cron(0 9 ? * 2-6 *)",
+
+			wantedNext: time.Date(2021, time.March, 15, 9, 0, 0, 0, time.UTC),
+			wantedOK:   true,
+		},
+		"cron with day of month": {
+			inSchedule: "cron(30 12 1 * ? *)",
+
+			wantedNext: time.Date(2021, time.April, 1, 12, 30, 0, 0, time.UTC),
+			wantedOK:   true,
+		},
+		"next run after the schedule expires": {
+			inSchedule: "cron(0 9 ? * 2-6 *)",
+			inUntil:    &until,
+		},
+		"cron with AWS-specific syntax": {
+			inSchedule: "cron(0 9 L * ? *)",
+		},
+		"cron restricted to a year": {
+			inSchedule: "cron(0 9 * * ? 2022)",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			info := TaskStackInfo{
+				StackName:     "task-reconcile",
+				Schedule:      tc.inSchedule,
+				ScheduleUntil: tc.inUntil,
+				UpdatedAt:     tc.inUpdatedAt,
+			}
+
+			next, ok := info.NextRun(now)
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedNext, next)
+		})
+	}
+}
@@ -29,6 +29,7 @@ type BackendServiceDescriber struct {
 	enableResources bool
 
 	store                DeployedEnvServicesLister
+	connDescriber        *serviceConnectionDescriber
 	svcDescriber         map[string]ecsSvcDescriber
 	initServiceDescriber func(string) error
 }
@@ -65,6 +66,19 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 		describer.svcDescriber[env] = d
 		return nil
 	}
+	describer.connDescriber = &serviceConnectionDescriber{
+		app:   opt.App,
+		svc:   opt.Svc,
+		store: opt.DeployStore,
+		newPeerDescriber: func(env, svc string) (stackOutputsDescriber, error) {
+			return NewServiceDescriber(NewServiceConfig{
+				App:         opt.App,
+				Env:         env,
+				Svc:         svc,
+				ConfigStore: opt.ConfigStore,
+			})
+		},
+	}
 	return describer, nil
 }
 
@@ -101,6 +115,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var services []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var connections []*ServiceConnection
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -139,6 +154,17 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		svcOutputs, err := d.svcDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for environment %s: %w", env, err)
+		}
+		conn, err := d.connDescriber.describe(env, svcOutputs)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service connections: %w", err)
+		}
+		if conn != nil {
+			connections = append(connections, conn)
+		}
 	}
 
 	resources := make(map[string][]*stack.Resource)
@@ -164,6 +190,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: services,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Connections:      connections,
 		Resources:        resources,
 
 		environments: environments,
@@ -179,6 +206,7 @@ type backendSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
+	Connections      serviceConnections   `json:"connections,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string `json:"-"`
@@ -216,6 +244,11 @@ func (w *backendSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Connections) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nConnections\n\n"))
+		writer.Flush()
+		w.Connections.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
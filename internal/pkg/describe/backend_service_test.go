@@ -115,6 +115,14 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						"SecurityGroup":   "sg-1234",
+						"ConnectServices": "api",
+					}, nil),
+					m.storeSvc.EXPECT().ListDeployedServices(testApp, testEnv).Return([]string{testSvc, "front"}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ConnectServices": "jobs",
+					}, nil),
 					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "5000",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -135,6 +143,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(nil, nil),
 					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.LBWebServiceContainerPortParamKey: "-1",
 						cfnstack.WorkloadTaskCountParamKey:         "2",
@@ -150,6 +159,9 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.ecsSvcDescriber.EXPECT().Secrets().Return(
 						nil, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ConnectServices": "api,worker",
+					}, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
@@ -253,6 +265,18 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						ValueFrom:   "SHHHHHHHH",
 					},
 				},
+				Connections: []*ServiceConnection{
+					{
+						Environment: "test",
+						Callers:     []string{"front"},
+						Callees:     []string{"api"},
+					},
+					{
+						Environment:    "mockEnv",
+						AllowAnyCaller: true,
+						Callees:        []string{"api", "worker"},
+					},
+				},
 				Resources: map[string][]*stack.Resource{
 					"test": {
 						{
@@ -304,6 +328,14 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 				},
 				initServiceDescriber: func(string) error { return nil },
 			}
+			d.connDescriber = &serviceConnectionDescriber{
+				app:   testApp,
+				svc:   testSvc,
+				store: mockStore,
+				newPeerDescriber: func(string, string) (stackOutputsDescriber, error) {
+					return mockSvcDescriber, nil
+				},
+			}
 
 			// WHEN
 			backendsvc, err := d.Describe()
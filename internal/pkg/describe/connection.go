@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// Outputs of a service stack that restricts its callers with "network.connect".
+	svcOutputSecurityGroup   = "SecurityGroup"
+	svcOutputConnectServices = "ConnectServices"
+
+	anyCaller = "any service"
+	noService = "-"
+)
+
+type stackOutputsDescriber interface {
+	Outputs() (map[string]string, error)
+}
+
+// serviceConnectionDescriber retrieves the services that a service is allowed to call and the services allowed to call it.
+type serviceConnectionDescriber struct {
+	app   string
+	svc   string
+	store DeployedEnvServicesLister
+
+	newPeerDescriber func(env, svc string) (stackOutputsDescriber, error)
+}
+
+// describe returns the connections of the service in an environment given the outputs of its stack.
+// If the service doesn't restrict its callers and doesn't call any service, then returns nil.
+func (d *serviceConnectionDescriber) describe(env string, svcOutputs map[string]string) (*ServiceConnection, error) {
+	conn := &ServiceConnection{
+		Environment: env,
+		Callees:     splitConnectServices(svcOutputs[svcOutputConnectServices]),
+	}
+	if _, ok := svcOutputs[svcOutputSecurityGroup]; !ok {
+		if len(conn.Callees) == 0 {
+			return nil, nil
+		}
+		conn.AllowAnyCaller = true
+		return conn, nil
+	}
+	peers, err := d.store.ListDeployedServices(d.app, env)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed to environment %s: %w", env, err)
+	}
+	for _, peer := range peers {
+		if peer == d.svc {
+			continue
+		}
+		describer, err := d.newPeerDescriber(env, peer)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := describer.Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for service %s in environment %s: %w", peer, env, err)
+		}
+		for _, callee := range splitConnectServices(outputs[svcOutputConnectServices]) {
+			if callee == d.svc {
+				conn.Callers = append(conn.Callers, peer)
+				break
+			}
+		}
+	}
+	return conn, nil
+}
+
+func splitConnectServices(services string) []string {
+	if services == "" {
+		return nil
+	}
+	return strings.Split(services, ",")
+}
+
+// ServiceConnection contains serialized information about the services that a service can call
+// and the services that can call it in an environment.
+type ServiceConnection struct {
+	Environment    string   `json:"environment"`
+	AllowAnyCaller bool     `json:"allowAnyCaller"`
+	Callers        []string `json:"callers"`
+	Callees        []string `json:"callees"`
+}
+
+type serviceConnections []*ServiceConnection
+
+func (c serviceConnections) humanString(w io.Writer) {
+	headers := []string{"Environment", "Callers", "Callees"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, conn := range c {
+		callers := joinServices(conn.Callers)
+		if conn.AllowAnyCaller {
+			callers = anyCaller
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", conn.Environment, callers, joinServices(conn.Callees))
+	}
+}
+
+func joinServices(services []string) string {
+	if len(services) == 0 {
+		return noService
+	}
+	return strings.Join(services, ", ")
+}
@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceConnectionDescriber_describe(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "api"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inOutputs  map[string]string
+		setupMocks func(store *mocks.MockDeployedEnvServicesLister, peer *mocks.MockecsSvcDescriber)
+
+		wanted      *ServiceConnection
+		wantedError error
+	}{
+		"returns nil if the service doesn't use network connect": {
+			inOutputs:  map[string]string{},
+			setupMocks: func(_ *mocks.MockDeployedEnvServicesLister, _ *mocks.MockecsSvcDescriber) {},
+		},
+		"allows any caller if the service only lists callees": {
+			inOutputs: map[string]string{
+				svcOutputConnectServices: "db,worker",
+			},
+			setupMocks: func(_ *mocks.MockDeployedEnvServicesLister, _ *mocks.MockecsSvcDescriber) {},
+			wanted: &ServiceConnection{
+				Environment:    testEnv,
+				AllowAnyCaller: true,
+				Callees:        []string{"db", "worker"},
+			},
+		},
+		"wraps error from listing deployed services": {
+			inOutputs: map[string]string{
+				svcOutputSecurityGroup: "sg-1234",
+			},
+			setupMocks: func(store *mocks.MockDeployedEnvServicesLister, _ *mocks.MockecsSvcDescriber) {
+				store.EXPECT().ListDeployedServices(testApp, testEnv).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list services deployed to environment test: some error"),
+		},
+		"wraps error from getting peer outputs": {
+			inOutputs: map[string]string{
+				svcOutputSecurityGroup: "sg-1234",
+			},
+			setupMocks: func(store *mocks.MockDeployedEnvServicesLister, peer *mocks.MockecsSvcDescriber) {
+				store.EXPECT().ListDeployedServices(testApp, testEnv).Return([]string{"frontend"}, nil)
+				peer.EXPECT().Outputs().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("get stack outputs for service frontend in environment test: some error"),
+		},
+		"finds the callers of a restricted service": {
+			inOutputs: map[string]string{
+				svcOutputSecurityGroup:   "sg-1234",
+				svcOutputConnectServices: "db",
+			},
+			setupMocks: func(store *mocks.MockDeployedEnvServicesLister, peer *mocks.MockecsSvcDescriber) {
+				store.EXPECT().ListDeployedServices(testApp, testEnv).Return([]string{"api", "frontend", "db"}, nil)
+				gomock.InOrder(
+					peer.EXPECT().Outputs().Return(map[string]string{
+						svcOutputConnectServices: "api,db",
+					}, nil),
+					peer.EXPECT().Outputs().Return(map[string]string{}, nil),
+				)
+			},
+			wanted: &ServiceConnection{
+				Environment: testEnv,
+				Callers:     []string{"frontend"},
+				Callees:     []string{"db"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
+			mockPeer := mocks.NewMockecsSvcDescriber(ctrl)
+			tc.setupMocks(mockStore, mockPeer)
+			d := &serviceConnectionDescriber{
+				app:   testApp,
+				svc:   testSvc,
+				store: mockStore,
+				newPeerDescriber: func(string, string) (stackOutputsDescriber, error) {
+					return mockPeer, nil
+				},
+			}
+
+			// WHEN
+			got, err := d.describe(testEnv, tc.inOutputs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestServiceConnections_humanString(t *testing.T) {
+	conns := serviceConnections{
+		{
+			Environment:    "test",
+			AllowAnyCaller: true,
+			Callees:        []string{"db", "worker"},
+		},
+		{
+			Environment: "prod",
+			Callers:     []string{"frontend"},
+		},
+	}
+	b := &bytes.Buffer{}
+	w := tabwriter.NewWriter(b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+
+	conns.humanString(w)
+	w.Flush()
+
+	require.Equal(t, `  Environment       Callers             Callees
+  -----------       -------             -------
+  test              any service         db, worker
+  prod              frontend            -
+`, b.String())
+}
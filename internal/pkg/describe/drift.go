@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
+type stackDriftDescriber interface {
+	Resources() ([]*stack.Resource, error)
+	Drift() ([]*stack.ResourceDrift, error)
+}
+
+// NewDriftDescriberConfig contains fields that initiates a DriftDescriber.
+type NewDriftDescriberConfig struct {
+	App         string
+	Env         string
+	IncludeEnv  bool     // Whether to detect drift on the environment stack.
+	Workloads   []string // Names of the workloads deployed in the environment to detect drift on.
+	ConfigStore ConfigStoreSvc
+}
+
+// DriftDescriber detects the drift of the environment and workload stacks, and of the stacks nested in them such as addons.
+type DriftDescriber struct {
+	stackNames []string
+
+	newStackDescriber func(stackName string) stackDriftDescriber
+}
+
+// NewDriftDescriber instantiates a drift describer for the stacks of an environment.
+func NewDriftDescriber(opt NewDriftDescriberConfig) (*DriftDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+	}
+	var stackNames []string
+	if opt.IncludeEnv {
+		stackNames = append(stackNames, cfnstack.NameForEnv(opt.App, opt.Env))
+	}
+	for _, wkld := range opt.Workloads {
+		stackNames = append(stackNames, cfnstack.NameForService(opt.App, opt.Env, wkld))
+	}
+	return &DriftDescriber{
+		stackNames: stackNames,
+		newStackDescriber: func(stackName string) stackDriftDescriber {
+			return stack.NewStackDescriber(stackName, sess)
+		},
+	}, nil
+}
+
+// Describe detects drift on each stack and its nested stacks, and returns the resources that were modified or deleted.
+func (d *DriftDescriber) Describe() (HumanJSONStringer, error) {
+	descr := &DriftDescription{}
+	for _, name := range d.stackNames {
+		drifts, err := d.describeStack(name, name)
+		if err != nil {
+			return nil, err
+		}
+		descr.Stacks = append(descr.Stacks, drifts...)
+	}
+	return descr, nil
+}
+
+// describeStack returns the drift of a stack followed by the drift of its nested stacks.
+// The id of the stack is either its name or its ARN.
+func (d *DriftDescriber) describeStack(id, name string) ([]*StackDrift, error) {
+	describer := d.newStackDescriber(id)
+	resources, err := describer.Drift()
+	if err != nil {
+		return nil, err
+	}
+	if resources == nil {
+		resources = []*stack.ResourceDrift{} // Output an empty list in JSON for stacks that didn't drift.
+	}
+	drifts := []*StackDrift{
+		{
+			Name:      name,
+			Resources: resources,
+		},
+	}
+	stackResources, err := describer.Resources()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range stackResources {
+		if r.Type != nestedStackResourceType || r.PhysicalID == "" {
+			continue
+		}
+		nested, err := d.describeStack(r.PhysicalID, nestedStackName(r.PhysicalID))
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, nested...)
+	}
+	return drifts, nil
+}
+
+// nestedStackName returns the name of a stack given its ARN, such as
+// "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-1AB2C3/abc-123".
+func nestedStackName(stackARN string) string {
+	parsed, err := arn.Parse(stackARN)
+	if err != nil {
+		return stackARN
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) < 2 {
+		return stackARN
+	}
+	return parts[1]
+}
+
+// StackDrift contains the resources of a stack that were modified or deleted outside of CloudFormation.
+type StackDrift struct {
+	Name      string                 `json:"name"`
+	Resources []*stack.ResourceDrift `json:"resources"`
+}
+
+// DriftDescription contains the drift of the stacks of an environment or a workload.
+type DriftDescription struct {
+	Stacks []*StackDrift `json:"stacks"`
+}
+
+// JSONString returns the stringified DriftDescription struct with json format.
+func (d *DriftDescription) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal drift description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified DriftDescription struct with human readable format.
+func (d *DriftDescription) HumanString() string {
+	var b bytes.Buffer
+	for i, s := range d.Stacks {
+		if i != 0 {
+			fmt.Fprint(&b, "\n")
+		}
+		fmt.Fprint(&b, color.Bold.Sprintf("%s\n\n", s.Name))
+		if len(s.Resources) == 0 {
+			fmt.Fprint(&b, "  No drift detected.\n")
+			continue
+		}
+		for _, r := range s.Resources {
+			fmt.Fprintf(&b, "  %s %s (%s)\n", color.Red.Sprint(r.Status), r.LogicalID, r.Type)
+			if r.PhysicalID != "" {
+				fmt.Fprintf(&b, "    Physical ID: %s\n", r.PhysicalID)
+			}
+			for _, p := range r.Properties {
+				fmt.Fprintf(&b, "    %s (%s)\n", p.Path, p.Type)
+				fmt.Fprintf(&b, "      expected: %s\n", p.Expected)
+				fmt.Fprintf(&b, "      actual:   %s\n", p.Actual)
+			}
+		}
+	}
+	return b.String()
+}
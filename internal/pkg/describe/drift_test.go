@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockAddonsStackARN = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-1AB2C3/abc-123"
+
+var mockSecurityGroupDrift = &stack.ResourceDrift{
+	Resource: stack.Resource{
+		Type:       "AWS::EC2::SecurityGroup",
+		PhysicalID: "sg-1234",
+	},
+	LogicalID: "EnvironmentSecurityGroup",
+	Status:    "MODIFIED",
+	Properties: []*stack.PropertyDrift{
+		{
+			Path:     "/SecurityGroupIngress/0/CidrIp",
+			Type:     "NOT_EQUAL",
+			Expected: "10.0.0.0/16",
+			Actual:   "0.0.0.0/0",
+		},
+	},
+}
+
+func TestDriftDescriber_Describe(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(stacks map[string]*mocks.MockstackDriftDescriber)
+
+		wanted      *DriftDescription
+		wantedError error
+	}{
+		"return error if fail to detect drift": {
+			setupMocks: func(stacks map[string]*mocks.MockstackDriftDescriber) {
+				stacks["phonetool-test"].EXPECT().Drift().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"detects drift on the stacks and their nested stacks": {
+			setupMocks: func(stacks map[string]*mocks.MockstackDriftDescriber) {
+				stacks["phonetool-test"].EXPECT().Drift().Return([]*stack.ResourceDrift{mockSecurityGroupDrift}, nil)
+				stacks["phonetool-test"].EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::EC2::SecurityGroup", PhysicalID: "sg-1234"},
+				}, nil)
+				stacks["phonetool-test-api"].EXPECT().Drift().Return(nil, nil)
+				stacks["phonetool-test-api"].EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::CloudFormation::Stack", PhysicalID: mockAddonsStackARN},
+				}, nil)
+				stacks[mockAddonsStackARN].EXPECT().Drift().Return([]*stack.ResourceDrift{
+					{
+						Resource:  stack.Resource{Type: "AWS::DynamoDB::Table"},
+						LogicalID: "Table",
+						Status:    "DELETED",
+					},
+				}, nil)
+				stacks[mockAddonsStackARN].EXPECT().Resources().Return(nil, nil)
+			},
+			wanted: &DriftDescription{
+				Stacks: []*StackDrift{
+					{
+						Name:      "phonetool-test",
+						Resources: []*stack.ResourceDrift{mockSecurityGroupDrift},
+					},
+					{
+						Name:      "phonetool-test-api",
+						Resources: []*stack.ResourceDrift{},
+					},
+					{
+						Name: "phonetool-test-api-AddonsStack-1AB2C3",
+						Resources: []*stack.ResourceDrift{
+							{
+								Resource:  stack.Resource{Type: "AWS::DynamoDB::Table"},
+								LogicalID: "Table",
+								Status:    "DELETED",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stacks := map[string]*mocks.MockstackDriftDescriber{
+				"phonetool-test":     mocks.NewMockstackDriftDescriber(ctrl),
+				"phonetool-test-api": mocks.NewMockstackDriftDescriber(ctrl),
+				mockAddonsStackARN:   mocks.NewMockstackDriftDescriber(ctrl),
+			}
+			tc.setupMocks(stacks)
+			d := &DriftDescriber{
+				stackNames: []string{"phonetool-test", "phonetool-test-api"},
+				newStackDescriber: func(stackName string) stackDriftDescriber {
+					return stacks[stackName]
+				},
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+func TestDriftDescription_String(t *testing.T) {
+	descr := &DriftDescription{
+		Stacks: []*StackDrift{
+			{
+				Name:      "phonetool-test",
+				Resources: []*stack.ResourceDrift{mockSecurityGroupDrift},
+			},
+			{
+				Name:      "phonetool-test-api",
+				Resources: []*stack.ResourceDrift{},
+			},
+		},
+	}
+	wantedHuman := `phonetool-test
+
+  MODIFIED EnvironmentSecurityGroup (AWS::EC2::SecurityGroup)
+    Physical ID: sg-1234
+    /SecurityGroupIngress/0/CidrIp (NOT_EQUAL)
+      expected: 10.0.0.0/16
+      actual:   0.0.0.0/0
+
+phonetool-test-api
+
+  No drift detected.
+`
+	wantedJSON := `{"stacks":[{"name":"phonetool-test","resources":[{"type":"AWS::EC2::SecurityGroup","physicalID":"sg-1234","logicalID":"EnvironmentSecurityGroup","status":"MODIFIED","properties":[{"path":"/SecurityGroupIngress/0/CidrIp","type":"NOT_EQUAL","expected":"10.0.0.0/16","actual":"0.0.0.0/0"}]}]},{"name":"phonetool-test-api","resources":[]}]}
+`
+
+	human := descr.HumanString()
+	json, err := descr.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, wantedHuman, human)
+	require.Equal(t, wantedJSON, json)
+}
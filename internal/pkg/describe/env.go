@@ -31,6 +31,10 @@ type EnvDescription struct {
 	Tags           map[string]string   `json:"tags,omitempty"`
 	Resources      []*stack.Resource   `json:"resources,omitempty"`
 	EnvironmentVPC EnvironmentVPC      `json:"environmentVPC"`
+	// InternalLoadBalancerDNSName is the DNS name of the internal load balancer, if the environment has one.
+	InternalLoadBalancerDNSName string `json:"internalLoadBalancerDNSName,omitempty"`
+	// DashboardURL links to the CloudWatch dashboard of the environment's workloads.
+	DashboardURL string `json:"dashboardURL,omitempty"`
 }
 
 // EnvironmentVPC holds the ID of the environment's VPC configuration.
@@ -88,7 +92,7 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		return nil, err
 	}
 
-	tags, environmentVPC, err := d.loadStackInfo()
+	info, err := d.loadStackInfo()
 	if err != nil {
 		return nil, err
 	}
@@ -104,9 +108,12 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 	return &EnvDescription{
 		Environment:    d.env,
 		Services:       svcs,
-		Tags:           tags,
+		Tags:           info.tags,
 		Resources:      stackResources,
-		EnvironmentVPC: environmentVPC,
+		EnvironmentVPC: info.vpc,
+
+		InternalLoadBalancerDNSName: info.internalLBDNSName,
+		DashboardURL:                info.dashboardURL,
 	}, nil
 }
 
@@ -172,26 +179,37 @@ func (d *EnvDescriber) ServiceDiscoveryEndpoint() (string, error) {
 	return fmt.Sprintf(fmtLegacySvcDiscoveryEndpoint, d.app), nil
 }
 
-func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error) {
-	var environmentVPC EnvironmentVPC
+type envStackInfo struct {
+	tags              map[string]string
+	vpc               EnvironmentVPC
+	internalLBDNSName string
+	dashboardURL      string
+}
 
+func (d *EnvDescriber) loadStackInfo() (*envStackInfo, error) {
 	envStack, err := d.cfn.Describe()
 	if err != nil {
-		return nil, environmentVPC, fmt.Errorf("retrieve environment stack: %w", err)
+		return nil, fmt.Errorf("retrieve environment stack: %w", err)
 	}
 
+	info := &envStackInfo{
+		tags: envStack.Tags,
+	}
 	for k, v := range envStack.Outputs {
 		switch k {
 		case cfnstack.EnvOutputVPCID:
-			environmentVPC.ID = v
+			info.vpc.ID = v
 		case cfnstack.EnvOutputPublicSubnets:
-			environmentVPC.PublicSubnetIDs = strings.Split(v, ",")
+			info.vpc.PublicSubnetIDs = strings.Split(v, ",")
 		case cfnstack.EnvOutputPrivateSubnets:
-			environmentVPC.PrivateSubnetIDs = strings.Split(v, ",")
+			info.vpc.PrivateSubnetIDs = strings.Split(v, ",")
+		case cfnstack.EnvOutputInternalLoadBalancerDNSName:
+			info.internalLBDNSName = v
+		case cfnstack.EnvOutputDashboardURL:
+			info.dashboardURL = v
 		}
 	}
-
-	return envStack.Tags, environmentVPC, nil
+	return info, nil
 }
 
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
@@ -233,6 +251,12 @@ func (e *EnvDescription) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%t\n", "Production", e.Environment.Prod)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Region", e.Environment.Region)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Account ID", e.Environment.AccountID)
+	if e.InternalLoadBalancerDNSName != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Internal Load Balancer", e.InternalLoadBalancerDNSName)
+	}
+	if e.DashboardURL != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Dashboard", e.DashboardURL)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
 	writer.Flush()
 	headers := []string{"Name", "Type"}
@@ -166,6 +166,40 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				},
 			},
 		},
+		"success with an internal load balancer and a dashboard": {
+			shouldOutputResources: false,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Tags: stackTags,
+						Outputs: map[string]string{
+							"VpcId":                       "vpc-012abcd345",
+							"PublicSubnets":               "subnet-0789ab,subnet-0123cd",
+							"PrivateSubnets":              "subnet-023ff,subnet-04af",
+							"InternalLoadBalancerDNSName": "internal-testApp-testEnv-1234.us-west-2.elb.amazonaws.com",
+							"EnvironmentDashboardURL":     "https://us-west-2.console.aws.amazon.com/cloudwatch/home?region=us-west-2#dashboards:name=testApp-testEnv",
+						},
+					}, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				EnvironmentVPC: EnvironmentVPC{
+					ID:               "vpc-012abcd345",
+					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
+					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
+				},
+				InternalLoadBalancerDNSName: "internal-testApp-testEnv-1234.us-west-2.elb.amazonaws.com",
+				DashboardURL:                "https://us-west-2.console.aws.amazon.com/cloudwatch/home?region=us-west-2#dashboards:name=testApp-testEnv",
+			},
+		},
 		"success with resources": {
 			shouldOutputResources: true,
 			setupMocks: func(m envDescriberMocks) {
@@ -0,0 +1,564 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of relationships between two workloads.
+const (
+	EdgeKindCalls      = "calls"      // The workload calls the other service through service discovery.
+	EdgeKindSubscribes = "subscribes" // The workload subscribes to a topic published by the other service.
+	EdgeKindAlias      = "alias"      // The two services share the same alias on the load balancer.
+)
+
+const (
+	snsSubscriptionResourceType = "AWS::SNS::Subscription"
+
+	// Service discovery endpoints of environments created before and after v1.5.0.
+	fmtLegacyServiceDiscoveryEndpoint = "%s.local"
+	fmtServiceDiscoveryEndpoint       = "%s.%s.local"
+)
+
+var mermaidInvalidIDChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// mermaidLabelEscaper escapes the characters that end a quoted Mermaid label with entity codes.
+var mermaidLabelEscaper = strings.NewReplacer(`#`, "#35;", `"`, "#quot;", `<`, "#lt;", `>`, "#gt;", `|`, "#124;")
+
+// WorkspaceManifestReader reads the manifests and the addons of the workloads in the workspace.
+type WorkspaceManifestReader interface {
+	WorkloadNames() ([]string, error)
+	ReadServiceManifest(name string) ([]byte, error)
+	ReadJobManifest(name string) ([]byte, error)
+	ReadAddonsDir(wlName string) ([]string, error)
+	ReadAddon(wlName, fileName string) ([]byte, error)
+}
+
+type graphStackDescriber interface {
+	Describe() (stack.StackDescription, error)
+	Resources() ([]*stack.Resource, error)
+	Template() (string, error)
+}
+
+// NewGraphDescriberConfig contains fields that initiates a GraphDescriber.
+type NewGraphDescriberConfig struct {
+	App               string
+	Env               string             // Optional. Environment whose deployed stacks complement the workspace manifests.
+	Workloads         []*config.Workload // Services and jobs of the application.
+	DeployedWorkloads []string           // Names of the workloads deployed in the environment.
+	ConfigStore       ConfigStoreSvc
+	Workspace         WorkspaceManifestReader // Optional. Nil if the command is not run from a workspace.
+}
+
+// GraphDescriber builds the graph of the relationships between the workloads of an application
+// from the workspace manifests and, if an environment is provided, from the stacks deployed in it.
+type GraphDescriber struct {
+	app               string
+	env               string
+	workloads         []*config.Workload
+	deployedWorkloads map[string]bool
+	ws                WorkspaceManifestReader
+
+	newStackDescriber func(stackID string) graphStackDescriber
+	workloadStackName func(wlName string) string
+}
+
+// NewGraphDescriber instantiates a graph describer for the workloads of an application.
+func NewGraphDescriber(opt NewGraphDescriberConfig) (*GraphDescriber, error) {
+	d := &GraphDescriber{
+		app:               opt.App,
+		env:               opt.Env,
+		workloads:         opt.Workloads,
+		deployedWorkloads: make(map[string]bool),
+		ws:                opt.Workspace,
+	}
+	if opt.Env == "" {
+		return d, nil
+	}
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+	}
+	for _, wl := range opt.DeployedWorkloads {
+		d.deployedWorkloads[wl] = true
+	}
+	d.newStackDescriber = func(stackID string) graphStackDescriber {
+		return stack.NewStackDescriber(stackID, sess)
+	}
+	d.workloadStackName = func(wlName string) string {
+		return cfnstack.NameForService(opt.App, opt.Env, wlName)
+	}
+	return d, nil
+}
+
+// Describe returns the workloads of the application with the addons they own and the relationships between them.
+// The relationships declared in the workspace are complemented with the ones found in the deployed stacks,
+// such as calls through service discovery. The addons declared in the workspace take precedence over the ones
+// of the deployed stacks, which are used for the workloads that are not in the workspace.
+func (d *GraphDescriber) Describe() (*WorkloadGraph, error) {
+	inWorkspace, err := d.workspaceWorkloads()
+	if err != nil {
+		return nil, err
+	}
+	graph := &WorkloadGraph{
+		App: d.app,
+	}
+	aliases := make(map[string][]string)
+	workloads := append([]*config.Workload(nil), d.workloads...)
+	sort.SliceStable(workloads, func(i, j int) bool {
+		return workloads[i].Name < workloads[j].Name
+	})
+	for _, wl := range workloads {
+		node := &WorkloadNode{
+			Name: wl.Name,
+			Type: wl.Type,
+		}
+		if inWorkspace[wl.Name] {
+			deps, err := d.manifestDependencies(wl)
+			if err != nil {
+				return nil, err
+			}
+			graph.Edges = appendEdges(graph.Edges, dependencyEdges(wl.Name, deps)...)
+			if deps.Alias != "" {
+				aliases[deps.Alias] = append(aliases[deps.Alias], wl.Name)
+			}
+			if node.Addons, err = d.workspaceAddons(wl.Name); err != nil {
+				return nil, err
+			}
+		}
+		if d.deployedWorkloads[wl.Name] {
+			edges, addons, err := d.deployedDependencies(wl.Name)
+			if err != nil {
+				return nil, err
+			}
+			graph.Edges = appendEdges(graph.Edges, edges...)
+			if !inWorkspace[wl.Name] {
+				node.Addons = addons
+			}
+		}
+		graph.Workloads = append(graph.Workloads, node)
+	}
+	graph.Edges = append(graph.Edges, aliasEdges(aliases)...)
+	return graph, nil
+}
+
+func (d *GraphDescriber) workspaceWorkloads() (map[string]bool, error) {
+	inWorkspace := make(map[string]bool)
+	if d.ws == nil {
+		return inWorkspace, nil
+	}
+	names, err := d.ws.WorkloadNames()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, name := range names {
+		inWorkspace[name] = true
+	}
+	return inWorkspace, nil
+}
+
+func (d *GraphDescriber) manifestDependencies(wl *config.Workload) (manifest.Dependencies, error) {
+	read := d.ws.ReadServiceManifest
+	if wl.Type == manifest.ScheduledJobType {
+		read = d.ws.ReadJobManifest
+	}
+	raw, err := read(wl.Name)
+	if err != nil {
+		return manifest.Dependencies{}, err
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return manifest.Dependencies{}, fmt.Errorf("unmarshal manifest of %s: %w", wl.Name, err)
+	}
+	return manifest.WorkloadDependencies(mft), nil
+}
+
+// workspaceAddons returns the resources declared in the addon templates of the workload sorted by their logical ID.
+func (d *GraphDescriber) workspaceAddons(wlName string) ([]*WorkloadAddon, error) {
+	fileNames, err := d.ws.ReadAddonsDir(wlName)
+	if err != nil {
+		return nil, nil // The workload doesn't have an addons directory.
+	}
+	var addons []*WorkloadAddon
+	for _, fileName := range fileNames {
+		if ext := filepath.Ext(fileName); ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+		raw, err := d.ws.ReadAddon(wlName, fileName)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s of %s: %w", fileName, wlName, err)
+		}
+		var tpl struct {
+			Resources map[string]struct {
+				Type string `yaml:"Type"`
+			} `yaml:"Resources"`
+		}
+		if err := yaml.Unmarshal(raw, &tpl); err != nil {
+			return nil, fmt.Errorf("unmarshal addon %s of %s: %w", fileName, wlName, err)
+		}
+		for logicalID, resource := range tpl.Resources {
+			addons = append(addons, &WorkloadAddon{
+				Name: logicalID,
+				Type: resource.Type,
+			})
+		}
+	}
+	sort.SliceStable(addons, func(i, j int) bool {
+		return addons[i].Name < addons[j].Name
+	})
+	return addons, nil
+}
+
+// deployedDependencies returns the services called by the deployed workload, the topics it subscribes to,
+// and the resources of its addons stack.
+// The workload calls a service if it's allowed to connect to it, or if its template references the service discovery endpoint of the service.
+func (d *GraphDescriber) deployedDependencies(wlName string) ([]*WorkloadEdge, []*WorkloadAddon, error) {
+	describer := d.newStackDescriber(d.workloadStackName(wlName))
+	descr, err := describer.Describe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("describe stack of %s: %w", wlName, err)
+	}
+	template, err := describer.Template()
+	if err != nil {
+		return nil, nil, fmt.Errorf("get template of the stack of %s: %w", wlName, err)
+	}
+	var edges []*WorkloadEdge
+	for _, callee := range splitConnectServices(descr.Outputs[svcOutputConnectServices]) {
+		edges = appendEdges(edges, &WorkloadEdge{
+			From: wlName,
+			To:   callee,
+			Kind: EdgeKindCalls,
+		})
+	}
+	endpoint := d.serviceDiscoveryEndpoint(template)
+	for _, wl := range d.workloads {
+		if wl.Name == wlName || !referencesHost(template, fmt.Sprintf("%s.%s", wl.Name, endpoint)) {
+			continue
+		}
+		edges = appendEdges(edges, &WorkloadEdge{
+			From: wlName,
+			To:   wl.Name,
+			Kind: EdgeKindCalls,
+		})
+	}
+	resources, err := describer.Resources()
+	if err != nil {
+		return nil, nil, fmt.Errorf("get resources of the stack of %s: %w", wlName, err)
+	}
+	var addons []*WorkloadAddon
+	for _, r := range resources {
+		if r.Type != nestedStackResourceType || !strings.Contains(nestedStackName(r.PhysicalID), addon.StackName) {
+			continue
+		}
+		addonResources, err := d.newStackDescriber(r.PhysicalID).Resources()
+		if err != nil {
+			return nil, nil, fmt.Errorf("get resources of the addons stack of %s: %w", wlName, err)
+		}
+		for _, ar := range addonResources {
+			addons = append(addons, &WorkloadAddon{
+				Name: ar.PhysicalID,
+				Type: ar.Type,
+			})
+		}
+		resources = append(resources, addonResources...)
+	}
+	for _, r := range resources {
+		if r.Type != snsSubscriptionResourceType {
+			continue
+		}
+		if publisher, topic, ok := d.parseSubscription(r.PhysicalID); ok {
+			edges = appendEdges(edges, &WorkloadEdge{
+				From:  wlName,
+				To:    publisher,
+				Kind:  EdgeKindSubscribes,
+				Label: topic,
+			})
+		}
+	}
+	return edges, addons, nil
+}
+
+// serviceDiscoveryEndpoint returns the service discovery endpoint of the environment set in the template of a workload.
+func (d *GraphDescriber) serviceDiscoveryEndpoint(template string) string {
+	endpoint := fmt.Sprintf(fmtServiceDiscoveryEndpoint, d.env, d.app)
+	if strings.Contains(template, endpoint) {
+		return endpoint
+	}
+	return fmt.Sprintf(fmtLegacyServiceDiscoveryEndpoint, d.app)
+}
+
+// referencesHost returns true if the template references the host,
+// for example in the value of an environment variable such as "http://api.test.phonetool.local:8080".
+func referencesHost(template, host string) bool {
+	for i := strings.Index(template, host); i != -1; i = nextIndex(template, host, i) {
+		// Skip the hosts that end with this host, such as "my-api.test.phonetool.local" for "api.test.phonetool.local".
+		if i == 0 || !isHostChar(template[i-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSubscription returns the name of the workload that publishes the topic of an SNS subscription and the name of the topic.
+// The topics of a workload are named "<app>-<env>-<workload>-<topic>".
+func (d *GraphDescriber) parseSubscription(subscriptionARN string) (publisher, topic string, ok bool) {
+	parsed, err := arn.Parse(subscriptionARN)
+	if err != nil {
+		return "", "", false
+	}
+	topicName := strings.Split(parsed.Resource, ":")[0]
+	prefix := fmt.Sprintf("%s-%s-", d.app, d.env)
+	if !strings.HasPrefix(topicName, prefix) {
+		return "", "", false
+	}
+	topicName = strings.TrimPrefix(topicName, prefix)
+	// Workload names can contain dashes, so pick the longest name that prefixes the topic.
+	for _, wl := range d.workloads {
+		if strings.HasPrefix(topicName, wl.Name+"-") && len(wl.Name) > len(publisher) {
+			publisher = wl.Name
+		}
+	}
+	if publisher == "" {
+		return "", "", false
+	}
+	return publisher, strings.TrimPrefix(topicName, publisher+"-"), true
+}
+
+func nextIndex(s, substr string, prev int) int {
+	i := strings.Index(s[prev+1:], substr)
+	if i == -1 {
+		return -1
+	}
+	return prev + 1 + i
+}
+
+func isHostChar(c byte) bool {
+	return c == '-' || c == '.' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// appendEdges appends the edges that aren't already in the list.
+func appendEdges(edges []*WorkloadEdge, newEdges ...*WorkloadEdge) []*WorkloadEdge {
+	for _, edge := range newEdges {
+		exists := false
+		for _, e := range edges {
+			if *e == *edge {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+func dependencyEdges(wlName string, deps manifest.Dependencies) []*WorkloadEdge {
+	var edges []*WorkloadEdge
+	for _, callee := range deps.Calls {
+		edges = append(edges, &WorkloadEdge{
+			From: wlName,
+			To:   callee,
+			Kind: EdgeKindCalls,
+		})
+	}
+	for _, sub := range deps.Subscriptions {
+		edges = append(edges, &WorkloadEdge{
+			From:  wlName,
+			To:    sub.Service,
+			Kind:  EdgeKindSubscribes,
+			Label: sub.Name,
+		})
+	}
+	return edges
+}
+
+// aliasEdges connects every pair of services that share an alias.
+func aliasEdges(aliases map[string][]string) []*WorkloadEdge {
+	var names []string
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	var edges []*WorkloadEdge
+	for _, alias := range names {
+		svcs := aliases[alias]
+		for i := 0; i < len(svcs); i++ {
+			for j := i + 1; j < len(svcs); j++ {
+				edges = append(edges, &WorkloadEdge{
+					From:  svcs[i],
+					To:    svcs[j],
+					Kind:  EdgeKindAlias,
+					Label: alias,
+				})
+			}
+		}
+	}
+	return edges
+}
+
+// WorkloadGraph contains the workloads of an application and the relationships between them.
+type WorkloadGraph struct {
+	App       string          `json:"application"`
+	Workloads []*WorkloadNode `json:"workloads"`
+	Edges     []*WorkloadEdge `json:"edges"`
+}
+
+// WorkloadNode is a workload of the application with the addon resources it owns.
+type WorkloadNode struct {
+	Name   string           `json:"name"`
+	Type   string           `json:"type"`
+	Addons []*WorkloadAddon `json:"addons"`
+}
+
+// WorkloadAddon is a resource declared in the addons of a workload.
+// The name is the logical ID of the resource in the workspace or its physical ID in a deployed stack.
+type WorkloadAddon struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// WorkloadEdge is a relationship from a workload to another one.
+type WorkloadEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Kind  string `json:"kind"`
+	Label string `json:"label,omitempty"` // Name of the topic for subscriptions or the shared alias.
+}
+
+// JSONString returns the stringified WorkloadGraph struct with json format.
+func (g *WorkloadGraph) JSONString() (string, error) {
+	b, err := json.Marshal(g)
+	if err != nil {
+		return "", fmt.Errorf("marshal workload graph: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the graph as a text tree where each workload lists its relationships and its addons.
+func (g *WorkloadGraph) HumanString() string {
+	var b bytes.Buffer
+	for _, node := range g.Workloads {
+		fmt.Fprintf(&b, "%s (%s)\n", node.Name, node.Type)
+		var lines []string
+		for _, edge := range g.Edges {
+			switch {
+			case edge.From == node.Name:
+				lines = append(lines, edge.humanString(edge.To))
+			case edge.To == node.Name && edge.Kind == EdgeKindAlias:
+				lines = append(lines, edge.humanString(edge.From))
+			}
+		}
+		for _, a := range node.Addons {
+			lines = append(lines, fmt.Sprintf("owns %s (%s)", a.Name, a.Type))
+		}
+		for i, line := range lines {
+			branch := "├── "
+			if i == len(lines)-1 {
+				branch = "└── "
+			}
+			fmt.Fprintf(&b, "%s%s\n", branch, line)
+		}
+	}
+	return b.String()
+}
+
+func (e *WorkloadEdge) humanString(peer string) string {
+	switch e.Kind {
+	case EdgeKindSubscribes:
+		return fmt.Sprintf("subscribes to %s/%s", peer, e.Label)
+	case EdgeKindAlias:
+		return fmt.Sprintf("shares alias %s with %s", e.Label, peer)
+	default:
+		return fmt.Sprintf("calls %s", peer)
+	}
+}
+
+// DOT returns the graph in the Graphviz DOT language.
+func (g *WorkloadGraph) DOT() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "digraph %q {\n", g.App)
+	fmt.Fprint(&b, "  rankdir=LR;\n  node [shape=box];\n")
+	for _, node := range g.Workloads {
+		fmt.Fprintf(&b, "  %q [label=%q];\n", node.Name, fmt.Sprintf("%s\n(%s)", node.Name, node.Type))
+		for _, a := range node.Addons {
+			fmt.Fprintf(&b, "  %q [label=%q, shape=cylinder];\n", node.Name+"/"+a.Name, fmt.Sprintf("%s\n(%s)", a.Name, a.Type))
+		}
+	}
+	for _, edge := range g.Edges {
+		switch edge.Kind {
+		case EdgeKindSubscribes:
+			fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", edge.From, edge.To, "subscribes to "+edge.Label)
+		case EdgeKindAlias:
+			fmt.Fprintf(&b, "  %q -> %q [label=%q, style=dashed, dir=none];\n", edge.From, edge.To, "shares alias "+edge.Label)
+		default:
+			fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", edge.From, edge.To, "calls")
+		}
+	}
+	for _, node := range g.Workloads {
+		for _, a := range node.Addons {
+			fmt.Fprintf(&b, "  %q -> %q [label=%q, style=dotted];\n", node.Name, node.Name+"/"+a.Name, "owns")
+		}
+	}
+	fmt.Fprint(&b, "}\n")
+	return b.String()
+}
+
+// Mermaid returns the graph as a Mermaid flowchart.
+func (g *WorkloadGraph) Mermaid() string {
+	var b bytes.Buffer
+	fmt.Fprint(&b, "graph LR\n")
+	for _, node := range g.Workloads {
+		fmt.Fprintf(&b, "  %s[\"%s<br/>%s\"]\n", mermaidID(node.Name), mermaidLabel(node.Name), mermaidLabel(node.Type))
+		for _, a := range node.Addons {
+			fmt.Fprintf(&b, "  %s[(\"%s<br/>%s\")]\n", mermaidID(node.Name+"/"+a.Name), mermaidLabel(a.Name), mermaidLabel(a.Type))
+		}
+	}
+	for _, edge := range g.Edges {
+		switch edge.Kind {
+		case EdgeKindSubscribes:
+			fmt.Fprintf(&b, "  %s -->|\"subscribes to %s\"| %s\n", mermaidID(edge.From), mermaidLabel(edge.Label), mermaidID(edge.To))
+		case EdgeKindAlias:
+			fmt.Fprintf(&b, "  %s ---|\"shares alias %s\"| %s\n", mermaidID(edge.From), mermaidLabel(edge.Label), mermaidID(edge.To))
+		default:
+			fmt.Fprintf(&b, "  %s -->|calls| %s\n", mermaidID(edge.From), mermaidID(edge.To))
+		}
+	}
+	for _, node := range g.Workloads {
+		for _, a := range node.Addons {
+			fmt.Fprintf(&b, "  %s -.->|owns| %s\n", mermaidID(node.Name), mermaidID(node.Name+"/"+a.Name))
+		}
+	}
+	return b.String()
+}
+
+// mermaidID returns an identifier for a node that doesn't clash with the Mermaid syntax, such as "end".
+func mermaidID(name string) string {
+	return "n_" + mermaidInvalidIDChars.ReplaceAllString(name, "_")
+}
+
+// mermaidLabel escapes the text of a quoted Mermaid label.
+func mermaidLabel(text string) string {
+	return mermaidLabelEscaper.Replace(text)
+}
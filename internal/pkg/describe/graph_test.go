@@ -0,0 +1,336 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockFrontendManifest = `name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+http:
+  path: '/'
+  alias: example.com
+network:
+  connect:
+    services: [orders]
+`
+	mockAdminManifest = `name: admin
+type: Load Balanced Web Service
+image:
+  build: admin/Dockerfile
+http:
+  path: 'admin'
+  alias: example.com
+`
+	mockWorkerManifest = `name: mailer
+type: Worker Service
+image:
+  build: mailer/Dockerfile
+subscribe:
+  topics:
+    - name: created
+      service: orders
+`
+	mockOrdersTableAddon = `Resources:
+  OrdersTable:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: !Sub ${App}-${Env}-orders
+  OrdersAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+`
+	mockOrdersAddonsStackARN = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-orders-AddonsStack-1AB2C3/abc-123"
+)
+
+func TestGraphDescriber_Describe(t *testing.T) {
+	workloads := []*config.Workload{
+		{Name: "orders", Type: manifest.BackendServiceType},
+		{Name: "mailer", Type: manifest.WorkerServiceType},
+		{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "admin", Type: manifest.LoadBalancedWebServiceType},
+	}
+	testCases := map[string]struct {
+		deployed   []string
+		setupMocks func(ws *mocks.MockWorkspaceManifestReader, stacks map[string]*mocks.MockgraphStackDescriber)
+
+		wanted      *WorkloadGraph
+		wantedError error
+	}{
+		"return error if fail to read a manifest": {
+			setupMocks: func(ws *mocks.MockWorkspaceManifestReader, stacks map[string]*mocks.MockgraphStackDescriber) {
+				ws.EXPECT().WorkloadNames().Return([]string{"admin"}, nil)
+				ws.EXPECT().ReadServiceManifest("admin").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"builds the graph from the workspace manifests and addons": {
+			setupMocks: func(ws *mocks.MockWorkspaceManifestReader, stacks map[string]*mocks.MockgraphStackDescriber) {
+				ws.EXPECT().WorkloadNames().Return([]string{"admin", "frontend", "mailer", "orders"}, nil)
+				ws.EXPECT().ReadServiceManifest("admin").Return([]byte(mockAdminManifest), nil)
+				ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mockFrontendManifest), nil)
+				ws.EXPECT().ReadServiceManifest("mailer").Return([]byte(mockWorkerManifest), nil)
+				ws.EXPECT().ReadServiceManifest("orders").Return([]byte("name: orders\ntype: Backend Service\n"), nil)
+				ws.EXPECT().ReadAddonsDir("admin").Return(nil, errors.New("no such directory"))
+				ws.EXPECT().ReadAddonsDir("frontend").Return(nil, errors.New("no such directory"))
+				ws.EXPECT().ReadAddonsDir("mailer").Return(nil, errors.New("no such directory"))
+				ws.EXPECT().ReadAddonsDir("orders").Return([]string{"table.yml", "README.md"}, nil)
+				ws.EXPECT().ReadAddon("orders", "table.yml").Return([]byte(mockOrdersTableAddon), nil)
+			},
+			wanted: &WorkloadGraph{
+				App: "phonetool",
+				Workloads: []*WorkloadNode{
+					{Name: "admin", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "mailer", Type: manifest.WorkerServiceType},
+					{
+						Name: "orders",
+						Type: manifest.BackendServiceType,
+						Addons: []*WorkloadAddon{
+							{Name: "OrdersAccessPolicy", Type: "AWS::IAM::ManagedPolicy"},
+							{Name: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+						},
+					},
+				},
+				Edges: []*WorkloadEdge{
+					{From: "frontend", To: "orders", Kind: EdgeKindCalls},
+					{From: "mailer", To: "orders", Kind: EdgeKindSubscribes, Label: "created"},
+					{From: "admin", To: "frontend", Kind: EdgeKindAlias, Label: "example.com"},
+				},
+			},
+		},
+		"uses the deployed stacks for the workloads that are not in the workspace": {
+			deployed: []string{"frontend", "orders"},
+			setupMocks: func(ws *mocks.MockWorkspaceManifestReader, stacks map[string]*mocks.MockgraphStackDescriber) {
+				ws.EXPECT().WorkloadNames().Return(nil, nil)
+				stacks["phonetool-test-frontend"].EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						svcOutputConnectServices: "orders",
+					},
+				}, nil)
+				stacks["phonetool-test-frontend"].EXPECT().Template().Return("", nil)
+				stacks["phonetool-test-frontend"].EXPECT().Resources().Return(nil, nil)
+				stacks["phonetool-test-orders"].EXPECT().Describe().Return(stack.StackDescription{}, nil)
+				stacks["phonetool-test-orders"].EXPECT().Template().Return("", nil)
+				stacks["phonetool-test-orders"].EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::ECS::Service", PhysicalID: "orders-svc"},
+					{Type: nestedStackResourceType, PhysicalID: mockOrdersAddonsStackARN},
+				}, nil)
+				stacks[mockOrdersAddonsStackARN].EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::DynamoDB::Table", PhysicalID: "phonetool-test-orders"},
+				}, nil)
+			},
+			wanted: &WorkloadGraph{
+				App: "phonetool",
+				Workloads: []*WorkloadNode{
+					{Name: "admin", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "mailer", Type: manifest.WorkerServiceType},
+					{
+						Name: "orders",
+						Type: manifest.BackendServiceType,
+						Addons: []*WorkloadAddon{
+							{Name: "phonetool-test-orders", Type: "AWS::DynamoDB::Table"},
+						},
+					},
+				},
+				Edges: []*WorkloadEdge{
+					{From: "frontend", To: "orders", Kind: EdgeKindCalls},
+				},
+			},
+		},
+		"complements the workspace with the calls and subscriptions of the deployed stacks": {
+			deployed: []string{"frontend", "mailer"},
+			setupMocks: func(ws *mocks.MockWorkspaceManifestReader, stacks map[string]*mocks.MockgraphStackDescriber) {
+				ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil)
+				ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mockFrontendManifest), nil)
+				ws.EXPECT().ReadAddonsDir("frontend").Return(nil, errors.New("no such directory"))
+				stacks["phonetool-test-frontend"].EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						svcOutputConnectServices: "orders",
+					},
+				}, nil)
+				stacks["phonetool-test-frontend"].EXPECT().Template().Return(`Environment:
+  - Name: COPILOT_SERVICE_DISCOVERY_ENDPOINT
+    Value: test.phonetool.local
+  - Name: ADMIN_URL
+    Value: http://admin.test.phonetool.local:8080
+  - Name: LEGACY_URL
+    Value: http://my-mailer.test.phonetool.local:8080`, nil)
+				stacks["phonetool-test-frontend"].EXPECT().Resources().Return(nil, nil)
+				stacks["phonetool-test-mailer"].EXPECT().Describe().Return(stack.StackDescription{}, nil)
+				stacks["phonetool-test-mailer"].EXPECT().Template().Return("", nil)
+				stacks["phonetool-test-mailer"].EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::SNS::Subscription", PhysicalID: "arn:aws:sns:us-west-2:123456789012:phonetool-test-orders-created:8a21d249-4329-4871-acc6-7be709c6ea7f"},
+				}, nil)
+			},
+			wanted: &WorkloadGraph{
+				App: "phonetool",
+				Workloads: []*WorkloadNode{
+					{Name: "admin", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "mailer", Type: manifest.WorkerServiceType},
+					{Name: "orders", Type: manifest.BackendServiceType},
+				},
+				Edges: []*WorkloadEdge{
+					{From: "frontend", To: "orders", Kind: EdgeKindCalls},
+					{From: "frontend", To: "admin", Kind: EdgeKindCalls},
+					{From: "mailer", To: "orders", Kind: EdgeKindSubscribes, Label: "created"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockWorkspaceManifestReader(ctrl)
+			stacks := map[string]*mocks.MockgraphStackDescriber{
+				"phonetool-test-frontend": mocks.NewMockgraphStackDescriber(ctrl),
+				"phonetool-test-orders":   mocks.NewMockgraphStackDescriber(ctrl),
+				"phonetool-test-mailer":   mocks.NewMockgraphStackDescriber(ctrl),
+				mockOrdersAddonsStackARN:  mocks.NewMockgraphStackDescriber(ctrl),
+			}
+			tc.setupMocks(ws, stacks)
+			deployed := make(map[string]bool)
+			for _, wl := range tc.deployed {
+				deployed[wl] = true
+			}
+			d := &GraphDescriber{
+				app:               "phonetool",
+				env:               "test",
+				workloads:         workloads,
+				deployedWorkloads: deployed,
+				ws:                ws,
+				newStackDescriber: func(stackID string) graphStackDescriber {
+					return stacks[stackID]
+				},
+				workloadStackName: func(wlName string) string {
+					return fmt.Sprintf("phonetool-test-%s", wlName)
+				},
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+func TestWorkloadGraph_String(t *testing.T) {
+	graph := &WorkloadGraph{
+		App: "phonetool",
+		Workloads: []*WorkloadNode{
+			{Name: "admin", Type: manifest.LoadBalancedWebServiceType},
+			{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+			{Name: "mailer", Type: manifest.WorkerServiceType},
+			{
+				Name: "orders",
+				Type: manifest.BackendServiceType,
+				Addons: []*WorkloadAddon{
+					{Name: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+				},
+			},
+		},
+		Edges: []*WorkloadEdge{
+			{From: "frontend", To: "orders", Kind: EdgeKindCalls},
+			{From: "mailer", To: "orders", Kind: EdgeKindSubscribes, Label: "created"},
+			{From: "admin", To: "frontend", Kind: EdgeKindAlias, Label: "example.com"},
+		},
+	}
+	wantedHuman := `admin (Load Balanced Web Service)
+└── shares alias example.com with frontend
+frontend (Load Balanced Web Service)
+├── calls orders
+└── shares alias example.com with admin
+mailer (Worker Service)
+└── subscribes to orders/created
+orders (Backend Service)
+└── owns OrdersTable (AWS::DynamoDB::Table)
+`
+	wantedJSON := `{"application":"phonetool","workloads":[{"name":"admin","type":"Load Balanced Web Service","addons":null},{"name":"frontend","type":"Load Balanced Web Service","addons":null},{"name":"mailer","type":"Worker Service","addons":null},{"name":"orders","type":"Backend Service","addons":[{"name":"OrdersTable","type":"AWS::DynamoDB::Table"}]}],"edges":[{"from":"frontend","to":"orders","kind":"calls"},{"from":"mailer","to":"orders","kind":"subscribes","label":"created"},{"from":"admin","to":"frontend","kind":"alias","label":"example.com"}]}
+`
+	wantedDOT := `digraph "phonetool" {
+  rankdir=LR;
+  node [shape=box];
+  "admin" [label="admin\n(Load Balanced Web Service)"];
+  "frontend" [label="frontend\n(Load Balanced Web Service)"];
+  "mailer" [label="mailer\n(Worker Service)"];
+  "orders" [label="orders\n(Backend Service)"];
+  "orders/OrdersTable" [label="OrdersTable\n(AWS::DynamoDB::Table)", shape=cylinder];
+  "frontend" -> "orders" [label="calls"];
+  "mailer" -> "orders" [label="subscribes to created"];
+  "admin" -> "frontend" [label="shares alias example.com", style=dashed, dir=none];
+  "orders" -> "orders/OrdersTable" [label="owns", style=dotted];
+}
+`
+	wantedMermaid := `graph LR
+  n_admin["admin<br/>Load Balanced Web Service"]
+  n_frontend["frontend<br/>Load Balanced Web Service"]
+  n_mailer["mailer<br/>Worker Service"]
+  n_orders["orders<br/>Backend Service"]
+  n_orders_OrdersTable[("OrdersTable<br/>AWS::DynamoDB::Table")]
+  n_frontend -->|calls| n_orders
+  n_mailer -->|"subscribes to created"| n_orders
+  n_admin ---|"shares alias example.com"| n_frontend
+  n_orders -.->|owns| n_orders_OrdersTable
+`
+
+	json, err := graph.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, wantedJSON, json)
+	require.Equal(t, wantedHuman, graph.HumanString())
+	require.Equal(t, wantedDOT, graph.DOT())
+	require.Equal(t, wantedMermaid, graph.Mermaid())
+}
+
+func TestWorkloadGraph_MermaidEscapesLabels(t *testing.T) {
+	graph := &WorkloadGraph{
+		App: "phonetool",
+		Workloads: []*WorkloadNode{
+			{
+				Name: "orders",
+				Type: manifest.BackendServiceType,
+				Addons: []*WorkloadAddon{
+					{Name: `orders "main" <table>`, Type: "AWS::DynamoDB::Table"},
+				},
+			},
+			{Name: "mailer", Type: manifest.WorkerServiceType},
+		},
+		Edges: []*WorkloadEdge{
+			{From: "mailer", To: "orders", Kind: EdgeKindSubscribes, Label: "created|#1"},
+		},
+	}
+	wanted := `graph LR
+  n_orders["orders<br/>Backend Service"]
+  n_orders_orders__main___table_[("orders #quot;main#quot; #lt;table#gt;<br/>AWS::DynamoDB::Table")]
+  n_mailer["mailer<br/>Worker Service"]
+  n_mailer -->|"subscribes to created#124;#35;1"| n_orders
+  n_orders -.->|owns| n_orders_orders__main___table_
+`
+
+	require.Equal(t, wanted, graph.Mermaid())
+}
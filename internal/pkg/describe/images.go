@@ -0,0 +1,167 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	fmtImageRepositoryName = "%s/%s" // The ECR repository of a workload is named "{app}/{workload}".
+	shortDigestLength      = 12
+	scanStatusComplete     = "COMPLETE"
+	untaggedImage          = "<untagged>"
+)
+
+// Severities of image scan findings from the most to the least severe.
+var findingSeverities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNDEFINED"}
+
+type imageDetailsGetter interface {
+	ImageDetails(repoName string) ([]ecr.ImageDetail, error)
+}
+
+// NewImagesDescriberConfig contains fields that initiates an ImagesDescriber.
+type NewImagesDescriberConfig struct {
+	App         string
+	Env         string // The images are listed from the repository in the region of the environment.
+	Svc         string
+	ConfigStore ConfigStoreSvc
+}
+
+// ImagesDescriber retrieves the images pushed to the ECR repository of a service.
+type ImagesDescriber struct {
+	repoName string
+	ecr      imageDetailsGetter
+}
+
+// NewImagesDescriber instantiates an images describer for the repository of a service.
+func NewImagesDescriber(opt NewImagesDescriberConfig) (*ImagesDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	// The repositories are created in the application account in each region that has an environment.
+	sess, err := sessions.NewProvider().DefaultWithRegion(env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session in region %s: %w", env.Region, err)
+	}
+	return &ImagesDescriber{
+		repoName: fmt.Sprintf(fmtImageRepositoryName, opt.App, opt.Svc),
+		ecr:      ecr.New(sess),
+	}, nil
+}
+
+// Describe returns the images in the repository of the service, most recently pushed first.
+func (d *ImagesDescriber) Describe() (HumanJSONStringer, error) {
+	images, err := d.ecr.ImageDetails(d.repoName)
+	if err != nil {
+		return nil, err
+	}
+	return &ImagesDescription{
+		Repository: d.repoName,
+		Images:     images,
+	}, nil
+}
+
+// ImagesDescription contains the images in the ECR repository of a service.
+type ImagesDescription struct {
+	Repository string            `json:"repository"`
+	Images     []ecr.ImageDetail `json:"images"`
+}
+
+// JSONString returns the stringified ImagesDescription struct with json format.
+func (d *ImagesDescription) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal images: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ImagesDescription struct with human readable format.
+func (d *ImagesDescription) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Repository", d.Repository)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Images", len(d.Images))
+	fmt.Fprint(writer, color.Bold.Sprint("\nImages\n\n"))
+	writer.Flush()
+	if len(d.Images) == 0 {
+		fmt.Fprint(writer, "  No images have been pushed yet.\n")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Tags", "Digest", "Pushed", "Size", "Scan Findings"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, image := range d.Images {
+		tags := untaggedImage
+		if len(image.Tags) != 0 {
+			tags = strings.Join(image.Tags, ", ")
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", tags, shortDigest(image.Digest), humanizeTime(image.PushedAt),
+			humanize.Bytes(uint64(image.SizeInBytes)), scanFindings(image))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// shortDigest returns the first characters of the hash of an image digest, such as "sha256:1a2b3c4d5e6f".
+func shortDigest(digest string) string {
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 || len(parts[1]) <= shortDigestLength {
+		return digest
+	}
+	return fmt.Sprintf("%s:%s", parts[0], parts[1][:shortDigestLength])
+}
+
+// scanFindings returns the number of findings by severity, from the most severe, of the latest scan of an image.
+func scanFindings(image ecr.ImageDetail) string {
+	if image.ScanStatus == "" {
+		return "Not scanned"
+	}
+	if image.ScanStatus != scanStatusComplete {
+		return strings.Title(strings.ToLower(strings.ReplaceAll(image.ScanStatus, "_", " ")))
+	}
+	var severities []string
+	for severity := range image.Findings {
+		severities = append(severities, severity)
+	}
+	sort.SliceStable(severities, func(i, j int) bool {
+		return severityRank(severities[i]) < severityRank(severities[j])
+	})
+	var findings []string
+	for _, severity := range severities {
+		finding := fmt.Sprintf("%s: %d", severity, image.Findings[severity])
+		if severity == "CRITICAL" || severity == "HIGH" {
+			finding = color.Red.Sprint(finding)
+		}
+		findings = append(findings, finding)
+	}
+	if len(findings) == 0 {
+		return color.Green.Sprint("No findings")
+	}
+	return strings.Join(findings, ", ")
+}
+
+func severityRank(severity string) int {
+	for i, s := range findingSeverities {
+		if s == severity {
+			return i
+		}
+	}
+	return len(findingSeverities)
+}
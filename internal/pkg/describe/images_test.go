@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImagesDescriber_Describe(t *testing.T) {
+	mockImages := []ecr.ImageDetail{
+		{Digest: "sha256:1a2b3c4d5e6f7a8b9c0d", Tags: []string{"latest"}},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockimageDetailsGetter)
+
+		wanted      HumanJSONStringer
+		wantedError error
+	}{
+		"return error if fail to list the images": {
+			setupMocks: func(m *mocks.MockimageDetailsGetter) {
+				m.EXPECT().ImageDetails("phonetool/api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the images of the repository": {
+			setupMocks: func(m *mocks.MockimageDetailsGetter) {
+				m.EXPECT().ImageDetails("phonetool/api").Return(mockImages, nil)
+			},
+			wanted: &ImagesDescription{
+				Repository: "phonetool/api",
+				Images:     mockImages,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockimageDetailsGetter(ctrl)
+			tc.setupMocks(m)
+			d := &ImagesDescriber{
+				repoName: "phonetool/api",
+				ecr:      m,
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+func TestImagesDescription_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2021-08-27T10:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	pushedAt := time.Date(2021, 8, 26, 10, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		in *ImagesDescription
+
+		wantedHuman string
+		wantedJSON  string
+	}{
+		"without images": {
+			in: &ImagesDescription{
+				Repository: "phonetool/api",
+			},
+			wantedHuman: `About
+
+  Repository        phonetool/api
+  Images            0
+
+Images
+
+  No images have been pushed yet.
+`,
+			wantedJSON: `{"repository":"phonetool/api","images":null}
+`,
+		},
+		"with scanned and untagged images": {
+			in: &ImagesDescription{
+				Repository: "phonetool/api",
+				Images: []ecr.ImageDetail{
+					{
+						Digest:      "sha256:1a2b3c4d5e6f7a8b9c0d",
+						Tags:        []string{"latest", "v2"},
+						PushedAt:    pushedAt,
+						SizeInBytes: 52428800,
+						ScanStatus:  "COMPLETE",
+						Findings: map[string]int64{
+							"LOW":      3,
+							"CRITICAL": 1,
+						},
+					},
+					{
+						Digest:      "sha256:9f8e7d6c5b4a3f2e1d0c",
+						Tags:        []string{},
+						PushedAt:    pushedAt.Add(-24 * time.Hour),
+						SizeInBytes: 51200000,
+						ScanStatus:  "IN_PROGRESS",
+					},
+					{
+						Digest:      "sha256:0a0b0c0d0e0f0a0b0c0d",
+						Tags:        []string{"v1"},
+						PushedAt:    pushedAt.Add(-48 * time.Hour),
+						SizeInBytes: 51200000,
+					},
+				},
+			},
+			wantedHuman: `About
+
+  Repository        phonetool/api
+  Images            3
+
+Images
+
+  Tags              Digest               Pushed              Size                Scan Findings
+  ----              ------               ------              ----                -------------
+  latest, v2        sha256:1a2b3c4d5e6f  1 day ago           52 MB               CRITICAL: 1, LOW: 3
+  <untagged>        sha256:9f8e7d6c5b4a  2 days ago          51 MB               In Progress
+  v1                sha256:0a0b0c0d0e0f  3 days ago          51 MB               Not scanned
+`,
+			wantedJSON: `{"repository":"phonetool/api","images":[{"digest":"sha256:1a2b3c4d5e6f7a8b9c0d","tags":["latest","v2"],"pushedAt":"2021-08-26T10:00:00Z","sizeInBytes":52428800,"scanStatus":"COMPLETE","findings":{"CRITICAL":1,"LOW":3}},{"digest":"sha256:9f8e7d6c5b4a3f2e1d0c","tags":[],"pushedAt":"2021-08-25T10:00:00Z","sizeInBytes":51200000,"scanStatus":"IN_PROGRESS"},{"digest":"sha256:0a0b0c0d0e0f0a0b0c0d","tags":["v1"],"pushedAt":"2021-08-24T10:00:00Z","sizeInBytes":51200000}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.in.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, json)
+			require.Equal(t, tc.wantedHuman, tc.in.HumanString())
+		})
+	}
+}
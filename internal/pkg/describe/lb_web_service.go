@@ -74,6 +74,7 @@ type LBWebServiceDescriber struct {
 	enableResources bool
 
 	store         DeployedEnvServicesLister
+	connDescriber *serviceConnectionDescriber
 	svcDescriber  map[string]ecsSvcDescriber
 	envDescriber  map[string]envDescriber
 	initDescriber func(string) error
@@ -124,6 +125,19 @@ func NewLBWebServiceDescriber(opt NewLBWebServiceConfig) (*LBWebServiceDescriber
 		describer.envDescriber[env] = envDescr
 		return nil
 	}
+	describer.connDescriber = &serviceConnectionDescriber{
+		app:   opt.App,
+		svc:   opt.Svc,
+		store: opt.DeployStore,
+		newPeerDescriber: func(env, svc string) (stackOutputsDescriber, error) {
+			return NewServiceDescriber(NewServiceConfig{
+				App:         opt.App,
+				Env:         env,
+				Svc:         svc,
+				ConfigStore: opt.ConfigStore,
+			})
+		},
+	}
 	return describer, nil
 }
 
@@ -139,6 +153,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	var connections []*ServiceConnection
 	for _, env := range environments {
 		err := d.initDescriber(env)
 		if err != nil {
@@ -177,6 +192,17 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve secrets: %w", err)
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		svcOutputs, err := d.svcDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for environment %s: %w", env, err)
+		}
+		conn, err := d.connDescriber.describe(env, svcOutputs)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service connections: %w", err)
+		}
+		if conn != nil {
+			connections = append(connections, conn)
+		}
 	}
 	resources := make(map[string][]*stack.Resource)
 	if d.enableResources {
@@ -202,6 +228,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		ServiceDiscovery: serviceDiscoveries,
 		Variables:        envVars,
 		Secrets:          secrets,
+		Connections:      connections,
 		Resources:        resources,
 
 		environments: environments,
@@ -232,6 +259,15 @@ func (d *LBWebServiceDescriber) URI(envName string) (string, error) {
 		DNSNames: []string{envOutputs[envOutputPublicLoadBalancerDNSName]},
 		Path:     svcParams[cfnstack.LBWebServiceRulePathParamKey],
 	}
+	for _, wkld := range strings.Split(envParams[cfnstack.EnvParamInternalALBWorkloadsKey], ",") {
+		if wkld != d.svc {
+			continue
+		}
+		// Services behind the internal load balancer are only reachable over HTTP from within the VPC.
+		uri.DNSNames = []string{envOutputs[cfnstack.EnvOutputInternalLoadBalancerDNSName]}
+		d.svcParams = svcParams
+		return uri.String(), nil
+	}
 	_, isHTTPS := envOutputs[envOutputSubdomain]
 	if isHTTPS {
 		dnsName := fmt.Sprintf("%s.%s", d.svc, envOutputs[envOutputSubdomain])
@@ -341,6 +377,7 @@ type webSvcDesc struct {
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
+	Connections      serviceConnections   `json:"connections,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
 
 	environments []string
@@ -386,6 +423,11 @@ func (w *webSvcDesc) HumanString() string {
 		writer.Flush()
 		w.Secrets.humanString(writer)
 	}
+	if len(w.Connections) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nConnections\n\n"))
+		writer.Flush()
+		w.Connections.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -148,6 +148,25 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 
 			wantedURI: "http://abc.us-west-1.elb.amazonaws.com/*",
 		},
+		"internal web service": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.EnvParamInternalALBWorkloadsKey: "admin,jobs",
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName:            testEnvLBDNSName,
+						envOutputSubdomain:                            testEnvSubdomain,
+						cfnstack.EnvOutputInternalLoadBalancerDNSName: "internal-abc.us-west-1.elb.amazonaws.com",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceRulePathParamKey: testSvcPath,
+					}, nil),
+				)
+			},
+
+			wantedURI: "http://internal-abc.us-west-1.elb.amazonaws.com",
+		},
 		"with alias": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
 				gomock.InOrder(
@@ -314,6 +333,28 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve secrets: some error"),
 		},
+		"return error if fail to retrieve service stack outputs": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get stack outputs for environment test: some error"),
+		},
 		"return error if fail to retrieve service resources": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -349,6 +390,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(nil, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
@@ -385,6 +427,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(nil, nil),
 					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
@@ -410,6 +453,10 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						"SecurityGroup": "sg-1234",
+					}, nil),
+					m.storeSvc.EXPECT().ListDeployedServices(testApp, prodEnv).Return([]string{testSvc}, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
@@ -500,6 +547,11 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						ValueFrom:   "SHHHHHHHH",
 					},
 				},
+				Connections: []*ServiceConnection{
+					{
+						Environment: "prod",
+					},
+				},
 				Resources: map[string][]*stack.Resource{
 					"test": {
 						{
@@ -549,6 +601,14 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					"prod": mockEnvDescriber,
 				},
 				initDescriber: func(string) error { return nil },
+				connDescriber: &serviceConnectionDescriber{
+					app:   testApp,
+					svc:   testSvc,
+					store: mockStore,
+					newPeerDescriber: func(string, string) (stackOutputsDescriber, error) {
+						return mockSvcDescriber, nil
+					},
+				},
 			}
 
 			// WHEN
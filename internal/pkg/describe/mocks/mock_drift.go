@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/drift.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackDriftDescriber is a mock of stackDriftDescriber interface.
+type MockstackDriftDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDescriberMockRecorder
+}
+
+// MockstackDriftDescriberMockRecorder is the mock recorder for MockstackDriftDescriber.
+type MockstackDriftDescriberMockRecorder struct {
+	mock *MockstackDriftDescriber
+}
+
+// NewMockstackDriftDescriber creates a new mock instance.
+func NewMockstackDriftDescriber(ctrl *gomock.Controller) *MockstackDriftDescriber {
+	mock := &MockstackDriftDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDescriber) EXPECT() *MockstackDriftDescriberMockRecorder {
+	return m.recorder
+}
+
+// Drift mocks base method.
+func (m *MockstackDriftDescriber) Drift() ([]*stack.ResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drift")
+	ret0, _ := ret[0].([]*stack.ResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drift indicates an expected call of Drift.
+func (mr *MockstackDriftDescriberMockRecorder) Drift() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drift", reflect.TypeOf((*MockstackDriftDescriber)(nil).Drift))
+}
+
+// Resources mocks base method.
+func (m *MockstackDriftDescriber) Resources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockstackDriftDescriberMockRecorder) Resources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockstackDriftDescriber)(nil).Resources))
+}
@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/graph.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWorkspaceManifestReader is a mock of WorkspaceManifestReader interface.
+type MockWorkspaceManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceManifestReaderMockRecorder
+}
+
+// MockWorkspaceManifestReaderMockRecorder is the mock recorder for MockWorkspaceManifestReader.
+type MockWorkspaceManifestReaderMockRecorder struct {
+	mock *MockWorkspaceManifestReader
+}
+
+// NewMockWorkspaceManifestReader creates a new mock instance.
+func NewMockWorkspaceManifestReader(ctrl *gomock.Controller) *MockWorkspaceManifestReader {
+	mock := &MockWorkspaceManifestReader{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceManifestReader) EXPECT() *MockWorkspaceManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadAddon mocks base method.
+func (m *MockWorkspaceManifestReader) ReadAddon(wlName, fileName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddon", wlName, fileName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddon indicates an expected call of ReadAddon.
+func (mr *MockWorkspaceManifestReaderMockRecorder) ReadAddon(wlName, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddon", reflect.TypeOf((*MockWorkspaceManifestReader)(nil).ReadAddon), wlName, fileName)
+}
+
+// ReadAddonsDir mocks base method.
+func (m *MockWorkspaceManifestReader) ReadAddonsDir(wlName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddonsDir", wlName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddonsDir indicates an expected call of ReadAddonsDir.
+func (mr *MockWorkspaceManifestReaderMockRecorder) ReadAddonsDir(wlName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddonsDir", reflect.TypeOf((*MockWorkspaceManifestReader)(nil).ReadAddonsDir), wlName)
+}
+
+// ReadJobManifest mocks base method.
+func (m *MockWorkspaceManifestReader) ReadJobManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest.
+func (mr *MockWorkspaceManifestReaderMockRecorder) ReadJobManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockWorkspaceManifestReader)(nil).ReadJobManifest), name)
+}
+
+// ReadServiceManifest mocks base method.
+func (m *MockWorkspaceManifestReader) ReadServiceManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest.
+func (mr *MockWorkspaceManifestReaderMockRecorder) ReadServiceManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockWorkspaceManifestReader)(nil).ReadServiceManifest), name)
+}
+
+// WorkloadNames mocks base method.
+func (m *MockWorkspaceManifestReader) WorkloadNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadNames indicates an expected call of WorkloadNames.
+func (mr *MockWorkspaceManifestReaderMockRecorder) WorkloadNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockWorkspaceManifestReader)(nil).WorkloadNames))
+}
+
+// MockgraphStackDescriber is a mock of graphStackDescriber interface.
+type MockgraphStackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockgraphStackDescriberMockRecorder
+}
+
+// MockgraphStackDescriberMockRecorder is the mock recorder for MockgraphStackDescriber.
+type MockgraphStackDescriberMockRecorder struct {
+	mock *MockgraphStackDescriber
+}
+
+// NewMockgraphStackDescriber creates a new mock instance.
+func NewMockgraphStackDescriber(ctrl *gomock.Controller) *MockgraphStackDescriber {
+	mock := &MockgraphStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockgraphStackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgraphStackDescriber) EXPECT() *MockgraphStackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockgraphStackDescriber) Describe() (stack.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(stack.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockgraphStackDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockgraphStackDescriber)(nil).Describe))
+}
+
+// Resources mocks base method.
+func (m *MockgraphStackDescriber) Resources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockgraphStackDescriberMockRecorder) Resources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockgraphStackDescriber)(nil).Resources))
+}
+
+// Template mocks base method.
+func (m *MockgraphStackDescriber) Template() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockgraphStackDescriberMockRecorder) Template() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockgraphStackDescriber)(nil).Template))
+}
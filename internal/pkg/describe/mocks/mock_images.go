@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/images.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockimageDetailsGetter is a mock of imageDetailsGetter interface.
+type MockimageDetailsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageDetailsGetterMockRecorder
+}
+
+// MockimageDetailsGetterMockRecorder is the mock recorder for MockimageDetailsGetter.
+type MockimageDetailsGetterMockRecorder struct {
+	mock *MockimageDetailsGetter
+}
+
+// NewMockimageDetailsGetter creates a new mock instance.
+func NewMockimageDetailsGetter(ctrl *gomock.Controller) *MockimageDetailsGetter {
+	mock := &MockimageDetailsGetter{ctrl: ctrl}
+	mock.recorder = &MockimageDetailsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageDetailsGetter) EXPECT() *MockimageDetailsGetterMockRecorder {
+	return m.recorder
+}
+
+// ImageDetails mocks base method.
+func (m *MockimageDetailsGetter) ImageDetails(repoName string) ([]ecr.ImageDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDetails", repoName)
+	ret0, _ := ret[0].([]ecr.ImageDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDetails indicates an expected call of ImageDetails.
+func (mr *MockimageDetailsGetterMockRecorder) ImageDetails(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDetails", reflect.TypeOf((*MockimageDetailsGetter)(nil).ImageDetails), repoName)
+}
@@ -49,6 +49,21 @@ func (mr *MockcfnMockRecorder) Describe(name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*Mockcfn)(nil).Describe), name)
 }
 
+// DetectDrift mocks base method.
+func (m *Mockcfn) DetectDrift(name string) ([]*cloudformation.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockcfnMockRecorder) DetectDrift(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*Mockcfn)(nil).DetectDrift), name)
+}
+
 // Metadata mocks base method.
 func (m *Mockcfn) Metadata(opt cloudformation.MetadataOpts) (string, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +93,18 @@ func (mr *MockcfnMockRecorder) StackResources(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*Mockcfn)(nil).StackResources), name)
 }
+
+// TemplateBody mocks base method.
+func (m *Mockcfn) TemplateBody(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockcfnMockRecorder) TemplateBody(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*Mockcfn)(nil).TemplateBody), name)
+}
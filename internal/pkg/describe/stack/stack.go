@@ -15,6 +15,8 @@ type cfn interface {
 	Describe(name string) (*cloudformation.StackDescription, error)
 	StackResources(name string) ([]*cloudformation.StackResource, error)
 	Metadata(opt cloudformation.MetadataOpts) (string, error)
+	TemplateBody(name string) (string, error)
+	DetectDrift(name string) ([]*cloudformation.StackResourceDrift, error)
 }
 
 // StackDescription is the description of a cloudformation stack.
@@ -35,6 +37,22 @@ func (c Resource) HumanString() string {
 	return fmt.Sprintf("%s\t%s\n", c.Type, c.PhysicalID)
 }
 
+// ResourceDrift contains the drift of a cloudformation stack resource that was modified or deleted outside of cloudformation.
+type ResourceDrift struct {
+	Resource
+	LogicalID  string           `json:"logicalID"`
+	Status     string           `json:"status"`
+	Properties []*PropertyDrift `json:"properties,omitempty"`
+}
+
+// PropertyDrift contains the expected and actual values of a drifted resource property.
+type PropertyDrift struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
 // StackDescriber retrieves information about a stack.
 type StackDescriber struct {
 	name string
@@ -92,6 +110,15 @@ func (d *StackDescriber) StackMetadata() (string, error) {
 	return metadata, nil
 }
 
+// Template returns the template body of the stack.
+func (d *StackDescriber) Template() (string, error) {
+	template, err := d.cfn.TemplateBody(d.name)
+	if err != nil {
+		return "", fmt.Errorf("get template for stack %s: %w", d.name, err)
+	}
+	return template, nil
+}
+
 // StackSetMetadata returns the metadata of the stackset.
 func (d *StackDescriber) StackSetMetadata() (string, error) {
 	metadata, err := d.cfn.Metadata(cloudformation.MetadataWithStackSetName(d.name))
@@ -101,6 +128,36 @@ func (d *StackDescriber) StackSetMetadata() (string, error) {
 	return metadata, nil
 }
 
+// Drift detects the drift of the stack's resources, and returns the resources that were modified or deleted.
+func (d *StackDescriber) Drift() ([]*ResourceDrift, error) {
+	drifts, err := d.cfn.DetectDrift(d.name)
+	if err != nil {
+		return nil, fmt.Errorf("detect drift for stack %s: %w", d.name, err)
+	}
+	var resources []*ResourceDrift
+	for _, drift := range drifts {
+		var props []*PropertyDrift
+		for _, diff := range drift.PropertyDifferences {
+			props = append(props, &PropertyDrift{
+				Path:     aws.StringValue(diff.PropertyPath),
+				Type:     aws.StringValue(diff.DifferenceType),
+				Expected: aws.StringValue(diff.ExpectedValue),
+				Actual:   aws.StringValue(diff.ActualValue),
+			})
+		}
+		resources = append(resources, &ResourceDrift{
+			Resource: Resource{
+				Type:       aws.StringValue(drift.ResourceType),
+				PhysicalID: aws.StringValue(drift.PhysicalResourceId),
+			},
+			LogicalID:  aws.StringValue(drift.LogicalResourceId),
+			Status:     aws.StringValue(drift.StackResourceDriftStatus),
+			Properties: props,
+		})
+	}
+	return resources, nil
+}
+
 func flattenResources(stackResources []*cloudformation.StackResource) []*Resource {
 	var resources []*Resource
 	for _, stackResource := range stackResources {
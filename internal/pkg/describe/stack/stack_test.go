@@ -169,6 +169,101 @@ func TestStackDescriber_Resources(t *testing.T) {
 	}
 }
 
+func TestStackDescriber_Drift(t *testing.T) {
+	const mockStackName = "phonetool"
+	testCases := map[string]struct {
+		setupMocks func(mocks stackDescriberMocks)
+
+		wantedDrifts []*ResourceDrift
+		wantedError  error
+	}{
+		"return error if fail to detect drift": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().DetectDrift(mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("detect drift for stack phonetool: some error"),
+		},
+		"success": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().DetectDrift(mockStackName).Return([]*cloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("EnvironmentSecurityGroup"),
+						PhysicalResourceId:       aws.String("sg-1234"),
+						ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+						StackResourceDriftStatus: aws.String("MODIFIED"),
+						PropertyDifferences: []*sdkcfn.PropertyDifference{
+							{
+								PropertyPath:   aws.String("/SecurityGroupIngress/0/CidrIp"),
+								DifferenceType: aws.String("NOT_EQUAL"),
+								ExpectedValue:  aws.String("10.0.0.0/16"),
+								ActualValue:    aws.String("0.0.0.0/0"),
+							},
+						},
+					},
+					{
+						LogicalResourceId:        aws.String("LogGroup"),
+						ResourceType:             aws.String("AWS::Logs::LogGroup"),
+						StackResourceDriftStatus: aws.String("DELETED"),
+					},
+				}, nil)
+			},
+			wantedDrifts: []*ResourceDrift{
+				{
+					Resource: Resource{
+						Type:       "AWS::EC2::SecurityGroup",
+						PhysicalID: "sg-1234",
+					},
+					LogicalID: "EnvironmentSecurityGroup",
+					Status:    "MODIFIED",
+					Properties: []*PropertyDrift{
+						{
+							Path:     "/SecurityGroupIngress/0/CidrIp",
+							Type:     "NOT_EQUAL",
+							Expected: "10.0.0.0/16",
+							Actual:   "0.0.0.0/0",
+						},
+					},
+				},
+				{
+					Resource: Resource{
+						Type: "AWS::Logs::LogGroup",
+					},
+					LogicalID: "LogGroup",
+					Status:    "DELETED",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcfn := mocks.NewMockcfn(ctrl)
+			tc.setupMocks(stackDescriberMocks{
+				cfn: mockcfn,
+			})
+
+			d := &StackDescriber{
+				name: mockStackName,
+				cfn:  mockcfn,
+			}
+
+			// WHEN
+			actual, err := d.Drift()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, actual)
+			}
+		})
+	}
+}
+
 func TestStackDescriber_Metadata(t *testing.T) {
 	const mockStackName = "phonetool"
 	mockErr := errors.New("some error")
@@ -226,3 +321,56 @@ func TestStackDescriber_Metadata(t *testing.T) {
 		})
 	}
 }
+
+func TestStackDescriber_Template(t *testing.T) {
+	const mockStackName = "phonetool"
+	testCases := map[string]struct {
+		setupMocks func(mocks stackDescriberMocks)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"return error if fail to get the stack template": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().TemplateBody(mockStackName).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get template for stack phonetool: some error"),
+		},
+		"success": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().TemplateBody(mockStackName).Return("mockTemplate", nil)
+			},
+			wantedTemplate: "mockTemplate",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcfn := mocks.NewMockcfn(ctrl)
+			mocks := stackDescriberMocks{
+				cfn: mockcfn,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &StackDescriber{
+				name: mockStackName,
+				cfn:  mockcfn,
+			}
+
+			// WHEN
+			actual, err := d.Template()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTemplate, actual)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress/summarybar"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
@@ -29,6 +31,7 @@ import (
 const (
 	maxAlarmStatusColumnWidth = 30
 	defaultServiceLogsLimit   = 10
+	defaultServiceEventsLimit = 5
 	shortTaskIDLength         = 8
 	summaryBarWidth           = 10
 	emptyRep                  = "░"
@@ -46,6 +49,7 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	Events                   []awsecs.ServiceEvent    `json:"events"`
 }
 
 // appRunnerServiceStatus contains the status for an AppRunner service.
@@ -127,6 +131,13 @@ func (s *ecsServiceStatus) HumanString() string {
 		s.writeAlarms(writer)
 		writer.Flush()
 	}
+
+	if len(s.Events) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRecent Events\n\n"))
+		writer.Flush()
+		s.writeEvents(writer)
+		writer.Flush()
+	}
 	return b.String()
 }
 
@@ -357,16 +368,61 @@ func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
 
+	var reasons []string
 	reasonToTasks := make(map[string][]string)
+	reasonToExits := make(map[string][]string)
+	seenExits := make(map[string]bool)
 	for _, task := range s.StoppedTasks {
+		if _, ok := reasonToTasks[task.StoppedReason]; !ok {
+			reasons = append(reasons, task.StoppedReason)
+		}
 		reasonToTasks[task.StoppedReason] = append(reasonToTasks[task.StoppedReason], shortTaskID(task.ID))
+		for _, container := range task.Containers {
+			exit := containerExitString(container)
+			if exit == "" || seenExits[task.StoppedReason+exit] {
+				continue
+			}
+			seenExits[task.StoppedReason+exit] = true
+			reasonToExits[task.StoppedReason] = append(reasonToExits[task.StoppedReason], exit)
+		}
 	}
-	for reason, ids := range reasonToTasks {
+	for _, reason := range reasons {
+		ids := reasonToTasks[reason]
 		sampleIDs := ids
 		if len(sampleIDs) > 5 {
 			sampleIDs = sampleIDs[:5]
 		}
 		printWithMaxWidth(writer, "  %s\t%s\t%s\n", 30, reason, strconv.Itoa(len(ids)), strings.Join(sampleIDs, ","))
+		// Indent the exit codes and reasons of the containers of the tasks under the reason of the tasks.
+		for _, exit := range reasonToExits[reason] {
+			printWithMaxWidth(writer, "    %s\t%s\t%s\n", 28, exit, "", "")
+		}
+	}
+}
+
+// containerExitString returns the name of a stopped container followed by its exit code and reason.
+// It returns an empty string if the container didn't exit.
+func containerExitString(container awsecs.ContainerStatus) string {
+	var details []string
+	if container.ExitCode != nil {
+		details = append(details, fmt.Sprintf("exit code %d", aws.Int64Value(container.ExitCode)))
+	}
+	if container.Reason != "" {
+		details = append(details, container.Reason)
+	}
+	if len(details) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", container.Name, strings.Join(details, ": "))
+}
+
+func (s *ecsServiceStatus) writeEvents(writer io.Writer) {
+	for _, event := range s.Events {
+		msg := event.Message
+		if stream.IsFailureServiceEvent(msg) {
+			msg = color.Red.Sprint(msg)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\n", humanizeTime(event.CreatedAt), msg)
 	}
 }
 
@@ -191,6 +191,7 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		Alarms:                   alarms,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		Events:                   service.LatestEvents(defaultServiceEventsLimit),
 	}, nil
 }
 
@@ -169,6 +169,13 @@ func TestServiceStatus_Describe(t *testing.T) {
 								TargetGroupArn: aws.String("group-1"),
 							},
 						},
+						Events: []*ecsapi.ServiceEvent{
+							{
+								Id:        aws.String("event-1"),
+								Message:   aws.String("(service mockSvc) has reached a steady state."),
+								CreatedAt: aws.Time(startTime),
+							},
+						},
 					}, nil),
 					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
 					m.aas.EXPECT().ECSServiceAlarmNames(gomock.Any(), gomock.Any()).Return([]string{}, nil),
@@ -194,6 +201,13 @@ func TestServiceStatus_Describe(t *testing.T) {
 				},
 				StoppedTasks:             nil,
 				TargetHealthDescriptions: nil,
+				Events: []awsecs.ServiceEvent{
+					{
+						ID:        "event-1",
+						Message:   "(service mockSvc) has reached a steady state.",
+						CreatedAt: startTime,
+					},
+				},
 				//rendererConfigurer:       &barRendererConfigurer{},
 			},
 		},
@@ -375,12 +389,16 @@ func TestServiceStatus_Describe(t *testing.T) {
 								LastStatus:   aws.String("RUNNING"),
 								Containers: []*ecsapi.Container{
 									{
+										Name:        aws.String("mockContainer1"),
 										Image:       aws.String("mockImageID1"),
 										ImageDigest: aws.String("69671a968e8ec3648e2697417750e"),
+										LastStatus:  aws.String("RUNNING"),
 									},
 									{
+										Name:        aws.String("mockContainer2"),
 										Image:       aws.String("mockImageID2"),
 										ImageDigest: aws.String("ca27a44e25ce17fea7b07940ad793"),
+										LastStatus:  aws.String("RUNNING"),
 									},
 								},
 								StoppedAt:     &stopTime,
@@ -477,6 +495,16 @@ func TestServiceStatus_Describe(t *testing.T) {
 						StartedAt:     startTime,
 						StoppedAt:     stopTime,
 						StoppedReason: "some reason",
+						Containers: []awsecs.ContainerStatus{
+							{
+								Name:       "mockContainer1",
+								LastStatus: "RUNNING",
+							},
+							{
+								Name:       "mockContainer2",
+								LastStatus: "RUNNING",
+							},
+						},
 					},
 				},
 				//rendererConfigurer: &barRendererConfigurer{},
@@ -0,0 +1,283 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	defaultStatusRefreshInterval = 10 * time.Second
+	maxStatusChanges             = 10
+	statusChangeTimeFormat       = "15:04:05"
+)
+
+type statusDescriber interface {
+	Describe() (HumanJSONStringer, error)
+}
+
+// statusDiffer is implemented by service statuses that can list what changed since a previous status.
+// If prev is nil, only the recent events of the service are returned.
+type statusDiffer interface {
+	changesSince(prev HumanJSONStringer) []statusChange
+}
+
+// statusChange is a change in the status of a service observed while watching it.
+type statusChange struct {
+	At      time.Time // Zero if the change has no timestamp of its own, in which case the time of the refresh is used.
+	Message string
+}
+
+// ServiceStatusWatcher periodically describes the status of a service and keeps track of what changed between refreshes.
+// ServiceStatusWatcher implements the progress.DynamicRenderer interface.
+type ServiceStatusWatcher struct {
+	describer       statusDescriber
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu          sync.Mutex
+	status      HumanJSONStringer
+	changes     []statusChange
+	refreshedAt time.Time
+	refreshErr  error
+
+	done chan struct{}
+}
+
+// NewServiceStatusWatcher describes the status of the service once and returns a watcher that refreshes it.
+func NewServiceStatusWatcher(describer statusDescriber) (*ServiceStatusWatcher, error) {
+	w := &ServiceStatusWatcher{
+		describer:       describer,
+		refreshInterval: defaultStatusRefreshInterval,
+		now:             time.Now,
+		done:            make(chan struct{}),
+	}
+	status, err := describer.Describe()
+	if err != nil {
+		return nil, err
+	}
+	w.update(status)
+	return w, nil
+}
+
+// Watch refreshes the status of the service every refresh interval until ctx is canceled.
+// Failures to refresh the status are rendered instead of stopping the watch, as they are often transient.
+func (w *ServiceStatusWatcher) Watch(ctx context.Context) {
+	defer close(w.done)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.refreshInterval):
+			w.refresh()
+		}
+	}
+}
+
+// Done returns a channel that's closed when the watcher stops refreshing the status.
+func (w *ServiceStatusWatcher) Done() <-chan struct{} {
+	return w.done
+}
+
+// Render writes the latest status of the service followed by the most recent changes, and returns the number of lines written.
+func (w *ServiceStatusWatcher) Render(out io.Writer) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var b bytes.Buffer
+	fmt.Fprint(&b, color.Faint.Sprintf("Refreshed at %s every %s. Press Ctrl-C to stop watching.\n", w.refreshedAt.Format(statusChangeTimeFormat), w.refreshInterval))
+	if w.refreshErr != nil {
+		fmt.Fprint(&b, color.Red.Sprintf("Failed to refresh the status: %v\n", w.refreshErr))
+	}
+	fmt.Fprint(&b, "\n")
+	fmt.Fprint(&b, w.status.HumanString())
+	fmt.Fprint(&b, color.Bold.Sprint("\nRecent Changes\n\n"))
+	if len(w.changes) == 0 {
+		fmt.Fprint(&b, "  No changes yet.\n")
+	}
+	for _, change := range w.changes {
+		fmt.Fprintf(&b, "  %s  %s\n", color.Faint.Sprint(change.At.Format(statusChangeTimeFormat)), change.Message)
+	}
+	numLines := strings.Count(b.String(), "\n")
+	if _, err := b.WriteTo(out); err != nil {
+		return 0, err
+	}
+	return numLines, nil
+}
+
+func (w *ServiceStatusWatcher) refresh() {
+	status, err := w.describer.Describe()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err != nil {
+		w.refreshErr = err
+		return
+	}
+	w.refreshErr = nil
+	w.updateLocked(status)
+}
+
+func (w *ServiceStatusWatcher) update(status HumanJSONStringer) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.updateLocked(status)
+}
+
+// updateLocked records the changes from the previous status to status. The caller must hold w.mu.
+func (w *ServiceStatusWatcher) updateLocked(status HumanJSONStringer) {
+	w.refreshedAt = w.now()
+	if differ, ok := status.(statusDiffer); ok {
+		for _, change := range differ.changesSince(w.status) {
+			if change.At.IsZero() {
+				change.At = w.refreshedAt
+			}
+			w.changes = append(w.changes, change)
+		}
+	}
+	if len(w.changes) > maxStatusChanges {
+		w.changes = w.changes[len(w.changes)-maxStatusChanges:]
+	}
+	w.status = status
+}
+
+// changesSince returns the new service events, stopped and started tasks, deployment progress and alarm state changes since prev.
+func (s *ecsServiceStatus) changesSince(prev HumanJSONStringer) []statusChange {
+	var changes []statusChange
+	p, _ := prev.(*ecsServiceStatus)
+
+	// Events are sorted from newest to oldest.
+	seenEvents := make(map[string]bool)
+	if p != nil {
+		for _, event := range p.Events {
+			seenEvents[event.ID] = true
+		}
+	}
+	for i := len(s.Events) - 1; i >= 0; i-- {
+		event := s.Events[i]
+		if seenEvents[event.ID] {
+			continue
+		}
+		changes = append(changes, statusChange{
+			At:      event.CreatedAt,
+			Message: event.Message,
+		})
+	}
+	if p == nil {
+		return changes
+	}
+
+	changes = append(changes, deploymentChanges(p.Service.Deployments, s.Service.Deployments)...)
+
+	wasStopped := make(map[string]bool)
+	for _, task := range p.StoppedTasks {
+		wasStopped[task.ID] = true
+	}
+	for _, task := range s.StoppedTasks {
+		if wasStopped[task.ID] {
+			continue
+		}
+		changes = append(changes, statusChange{
+			At:      task.StoppedAt,
+			Message: color.Red.Sprintf("Task %s stopped: %s", shortTaskID(task.ID), task.StoppedReason),
+		})
+	}
+
+	wasRunning := make(map[string]bool)
+	for _, task := range p.DesiredRunningTasks {
+		wasRunning[task.ID] = true
+	}
+	for _, task := range s.DesiredRunningTasks {
+		if wasRunning[task.ID] {
+			continue
+		}
+		changes = append(changes, statusChange{
+			Message: fmt.Sprintf("Task %s was placed and is %s", shortTaskID(task.ID), task.LastStatus),
+		})
+	}
+
+	prevAlarmStatus := make(map[string]string)
+	for _, alarm := range p.Alarms {
+		prevAlarmStatus[alarm.Name] = alarm.Status
+	}
+	for _, alarm := range s.Alarms {
+		prevStatus, ok := prevAlarmStatus[alarm.Name]
+		if !ok || prevStatus == alarm.Status {
+			continue
+		}
+		changes = append(changes, statusChange{
+			Message: fmt.Sprintf("Alarm %s changed from %s to %s", alarm.Name, alarmHealthColor(prevStatus), alarmHealthColor(alarm.Status)),
+		})
+	}
+	return changes
+}
+
+func deploymentChanges(prev, curr []awsecs.Deployment) []statusChange {
+	var changes []statusChange
+	prevByID := make(map[string]awsecs.Deployment)
+	for _, d := range prev {
+		prevByID[d.Id] = d
+	}
+	currIDs := make(map[string]bool)
+	for _, d := range curr {
+		currIDs[d.Id] = true
+		p, ok := prevByID[d.Id]
+		switch {
+		case !ok:
+			changes = append(changes, statusChange{
+				Message: color.Yellow.Sprintf("Deployment %s started with %d desired tasks", deploymentName(d), d.DesiredCount),
+			})
+		case p.RunningCount != d.RunningCount || p.DesiredCount != d.DesiredCount:
+			changes = append(changes, statusChange{
+				Message: color.Yellow.Sprintf("Deployment %s is running %d/%d tasks", deploymentName(d), d.RunningCount, d.DesiredCount),
+			})
+		}
+	}
+	for _, d := range prev {
+		if currIDs[d.Id] || d.Status != awsecs.ServiceDeploymentStatusActive {
+			continue
+		}
+		changes = append(changes, statusChange{
+			Message: color.Green.Sprintf("Deployment %s was replaced", deploymentName(d)),
+		})
+	}
+	return changes
+}
+
+func deploymentName(d awsecs.Deployment) string {
+	revision, err := awsecs.TaskDefinitionVersion(d.TaskDefinition)
+	if err != nil {
+		return d.Id
+	}
+	return fmt.Sprintf("%s (rev %d)", d.Id, revision)
+}
+
+// changesSince returns the status changes and new deployments of the App Runner service since prev.
+func (a *appRunnerServiceStatus) changesSince(prev HumanJSONStringer) []statusChange {
+	p, ok := prev.(*appRunnerServiceStatus)
+	if !ok {
+		return nil
+	}
+	var changes []statusChange
+	if p.Service.Status != a.Service.Status {
+		changes = append(changes, statusChange{
+			Message: fmt.Sprintf("Service status changed from %s to %s", statusColor(p.Service.Status), statusColor(a.Service.Status)),
+		})
+	}
+	if !p.Service.DateUpdated.Equal(a.Service.DateUpdated) {
+		changes = append(changes, statusChange{
+			At:      a.Service.DateUpdated,
+			Message: color.Yellow.Sprintf("Service was updated with source %s", a.Service.ImageID),
+		})
+	}
+	return changes
+}
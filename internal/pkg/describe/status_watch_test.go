@@ -0,0 +1,267 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStatusDescriber returns the statuses and errors in order on each call to Describe.
+type fakeStatusDescriber struct {
+	statuses []HumanJSONStringer
+	errs     []error
+	calls    int
+}
+
+func (d *fakeStatusDescriber) Describe() (HumanJSONStringer, error) {
+	i := d.calls
+	d.calls++
+	return d.statuses[i], d.errs[i]
+}
+
+func TestECSServiceStatus_changesSince(t *testing.T) {
+	refreshTime := time.Date(2021, 8, 26, 10, 0, 0, 0, time.UTC)
+	prev := &ecsServiceStatus{
+		Service: awsecs.ServiceStatus{
+			Deployments: []awsecs.Deployment{
+				{
+					Id:             "ecs-svc/2",
+					DesiredCount:   2,
+					RunningCount:   0,
+					TaskDefinition: "arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6",
+					Status:         "PRIMARY",
+				},
+				{
+					Id:             "ecs-svc/1",
+					DesiredCount:   2,
+					RunningCount:   2,
+					TaskDefinition: "arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:5",
+					Status:         "ACTIVE",
+				},
+			},
+		},
+		DesiredRunningTasks: []awsecs.TaskStatus{
+			{ID: "1111111111111111", LastStatus: "RUNNING"},
+		},
+		StoppedTasks: []awsecs.TaskStatus{
+			{ID: "2222222222222222", StoppedReason: "Scaling activity initiated by deployment ecs-svc/1"},
+		},
+		Alarms: []cloudwatch.AlarmStatus{
+			{Name: "CPUAlarm", Status: "OK"},
+			{Name: "MemoryAlarm", Status: "OK"},
+		},
+		Events: []awsecs.ServiceEvent{
+			{ID: "event-1", Message: "(service my-svc) has started 2 tasks.", CreatedAt: refreshTime.Add(-time.Minute)},
+		},
+	}
+	curr := &ecsServiceStatus{
+		Service: awsecs.ServiceStatus{
+			Deployments: []awsecs.Deployment{
+				{
+					Id:             "ecs-svc/2",
+					DesiredCount:   2,
+					RunningCount:   2,
+					TaskDefinition: "arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6",
+					Status:         "PRIMARY",
+				},
+			},
+		},
+		DesiredRunningTasks: []awsecs.TaskStatus{
+			{ID: "1111111111111111", LastStatus: "RUNNING"},
+			{ID: "3333333333333333", LastStatus: "PROVISIONING"},
+		},
+		StoppedTasks: []awsecs.TaskStatus{
+			{ID: "2222222222222222", StoppedReason: "Scaling activity initiated by deployment ecs-svc/1"},
+			{ID: "4444444444444444", StoppedReason: "Essential container in task exited", StoppedAt: refreshTime.Add(-time.Second)},
+		},
+		Alarms: []cloudwatch.AlarmStatus{
+			{Name: "CPUAlarm", Status: "ALARM"},
+			{Name: "MemoryAlarm", Status: "OK"},
+		},
+		Events: []awsecs.ServiceEvent{
+			{ID: "event-3", Message: "(service my-svc) has reached a steady state.", CreatedAt: refreshTime.Add(-10 * time.Second)},
+			{ID: "event-2", Message: "(service my-svc) has stopped 1 running tasks.", CreatedAt: refreshTime.Add(-20 * time.Second)},
+			{ID: "event-1", Message: "(service my-svc) has started 2 tasks.", CreatedAt: refreshTime.Add(-time.Minute)},
+		},
+	}
+
+	testCases := map[string]struct {
+		prev HumanJSONStringer
+
+		wanted []statusChange
+	}{
+		"returns only the events of the service if there is no previous status": {
+			wanted: []statusChange{
+				{At: refreshTime.Add(-time.Minute), Message: "(service my-svc) has started 2 tasks."},
+				{At: refreshTime.Add(-20 * time.Second), Message: "(service my-svc) has stopped 1 running tasks."},
+				{At: refreshTime.Add(-10 * time.Second), Message: "(service my-svc) has reached a steady state."},
+			},
+		},
+		"returns new events, deployment progress, stopped and placed tasks, and alarm changes": {
+			prev: prev,
+			wanted: []statusChange{
+				{At: refreshTime.Add(-20 * time.Second), Message: "(service my-svc) has stopped 1 running tasks."},
+				{At: refreshTime.Add(-10 * time.Second), Message: "(service my-svc) has reached a steady state."},
+				{Message: "Deployment ecs-svc/2 (rev 6) is running 2/2 tasks"},
+				{Message: "Deployment ecs-svc/1 (rev 5) was replaced"},
+				{At: refreshTime.Add(-time.Second), Message: "Task 44444444 stopped: Essential container in task exited"},
+				{Message: "Task 33333333 was placed and is PROVISIONING"},
+				{Message: "Alarm CPUAlarm changed from OK to ALARM"},
+			},
+		},
+		"returns no changes if the status is the same": {
+			prev: curr,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, curr.changesSince(tc.prev))
+		})
+	}
+}
+
+func TestAppRunnerServiceStatus_changesSince(t *testing.T) {
+	updatedAt := time.Date(2021, 8, 26, 10, 0, 0, 0, time.UTC)
+	prev := &appRunnerServiceStatus{
+		Service: apprunner.Service{
+			Status:  "RUNNING",
+			ImageID: "public.ecr.aws/my-svc:v1",
+		},
+	}
+	curr := &appRunnerServiceStatus{
+		Service: apprunner.Service{
+			Status:      "OPERATION_IN_PROGRESS",
+			DateUpdated: updatedAt,
+			ImageID:     "public.ecr.aws/my-svc:v2",
+		},
+	}
+
+	require.Nil(t, curr.changesSince(nil))
+	require.Equal(t, []statusChange{
+		{Message: "Service status changed from RUNNING to OPERATION_IN_PROGRESS"},
+		{At: updatedAt, Message: "Service was updated with source public.ecr.aws/my-svc:v2"},
+	}, curr.changesSince(prev))
+}
+
+func TestServiceStatusWatcher_Render(t *testing.T) {
+	refreshTime := time.Date(2021, 8, 26, 10, 0, 0, 0, time.UTC)
+	first := &appRunnerServiceStatus{
+		Service: apprunner.Service{
+			Name:    "frontend",
+			ID:      "8a2b343f658144d885e47d10adb4845e",
+			Status:  "OPERATION_IN_PROGRESS",
+			ImageID: "public.ecr.aws/my-svc:v2",
+		},
+	}
+	second := &appRunnerServiceStatus{
+		Service: apprunner.Service{
+			Name:    "frontend",
+			ID:      "8a2b343f658144d885e47d10adb4845e",
+			Status:  "RUNNING",
+			ImageID: "public.ecr.aws/my-svc:v2",
+		},
+	}
+	testCases := map[string]struct {
+		describer *fakeStatusDescriber
+
+		wantedNumLines int
+		wanted         string
+	}{
+		"renders the status and its changes after a refresh": {
+			describer: &fakeStatusDescriber{
+				statuses: []HumanJSONStringer{first, second},
+				errs:     []error{nil, nil},
+			},
+			wantedNumLines: 18,
+			wanted: `Refreshed at 10:00:00 every 10s. Press Ctrl-C to stop watching.
+
+Service Status
+
+ Status RUNNING 
+
+Last deployment
+
+  Updated At        a long while ago
+  Service ID        frontend/8a2b343f658144d885e47d10adb4845e
+  Source            my-svc:v2
+
+System Logs
+
+
+Recent Changes
+
+  10:00:00  Service status changed from OPERATION_IN_PROGRESS to RUNNING
+`,
+		},
+		"keeps the previous status if the refresh fails": {
+			describer: &fakeStatusDescriber{
+				statuses: []HumanJSONStringer{first, nil},
+				errs:     []error{nil, errors.New("some error")},
+			},
+			wantedNumLines: 19,
+			wanted: `Refreshed at 10:00:00 every 10s. Press Ctrl-C to stop watching.
+Failed to refresh the status: some error
+
+Service Status
+
+ Status OPERATION_IN_PROGRESS 
+
+Last deployment
+
+  Updated At        a long while ago
+  Service ID        frontend/8a2b343f658144d885e47d10adb4845e
+  Source            my-svc:v2
+
+System Logs
+
+
+Recent Changes
+
+  No changes yet.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := &ServiceStatusWatcher{
+				describer:       tc.describer,
+				refreshInterval: defaultStatusRefreshInterval,
+				now: func() time.Time {
+					return refreshTime
+				},
+			}
+			w.refresh()
+			buf := new(bytes.Buffer)
+
+			// WHEN
+			w.refresh()
+			nl, err := w.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+			require.Equal(t, tc.wantedNumLines, nl)
+		})
+	}
+}
+
+func TestNewServiceStatusWatcher(t *testing.T) {
+	_, err := NewServiceStatusWatcher(&fakeStatusDescriber{
+		statuses: []HumanJSONStringer{nil},
+		errs:     []error{errors.New("some error")},
+	})
+
+	require.EqualError(t, err, "some error")
+}
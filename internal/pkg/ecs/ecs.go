@@ -41,6 +41,7 @@ type ecsClient interface {
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
 	NetworkConfiguration(cluster, serviceName string) (*ecs.NetworkConfiguration, error)
 	StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error)
+	StoppedTasks(cluster string) ([]*ecs.Task, error)
 }
 
 type stepFunctionsClient interface {
@@ -155,6 +156,24 @@ func (c Client) ListActiveDefaultClusterTasks(filter ListTasksFilter) ([]*ecs.Ta
 	})
 }
 
+// ListStoppedAppEnvTasks returns the recently stopped Copilot one-off tasks in the environment of an application.
+func (c Client) ListStoppedAppEnvTasks(app, env string) ([]*ecs.Task, error) {
+	clusterARN, err := c.ClusterARN(app, env)
+	if err != nil {
+		return nil, err
+	}
+	return c.listStoppedCopilotTasks(clusterARN)
+}
+
+// ListStoppedDefaultClusterTasks returns the recently stopped Copilot one-off tasks in the default cluster.
+func (c Client) ListStoppedDefaultClusterTasks() ([]*ecs.Task, error) {
+	defaultCluster, err := c.ecsClient.DefaultCluster()
+	if err != nil {
+		return nil, fmt.Errorf("get default cluster: %w", err)
+	}
+	return c.listStoppedCopilotTasks(defaultCluster)
+}
+
 // StopWorkloadTasks stops all tasks in the given application, enviornment, and workload.
 func (c Client) StopWorkloadTasks(app, env, workload string) error {
 	return c.stopTasks(app, env, ListTasksFilter{
@@ -336,6 +355,14 @@ func (c Client) listActiveCopilotTasks(opts listActiveCopilotTasksOpts) ([]*ecs.
 	return filterTasksByID(tasks, opts.TaskID), nil
 }
 
+func (c Client) listStoppedCopilotTasks(cluster string) ([]*ecs.Task, error) {
+	tasks, err := c.ecsClient.StoppedTasks(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("list stopped tasks in cluster %s: %w", cluster, err)
+	}
+	return filterCopilotTasks(tasks, ""), nil
+}
+
 func filterTasksByID(tasks []*ecs.Task, taskID string) []*ecs.Task {
 	var filteredTasks []*ecs.Task
 	for _, task := range tasks {
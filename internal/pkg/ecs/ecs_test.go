@@ -480,6 +480,87 @@ func TestClient_listActiveCopilotTasks(t *testing.T) {
 	}
 }
 
+func TestClient_ListStoppedAppEnvTasks(t *testing.T) {
+	mockCluster := "arn:aws::ecs:cluster/abcd1234"
+	mockResource := resourcegroups.Resource{
+		ARN: mockCluster,
+	}
+	mockCopilotTask := &ecs.Task{
+		TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/123456789"),
+		Tags: []*awsecs.Tag{
+			{Key: aws.String("copilot-task")},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wanted      []*ecs.Task
+		wantedError error
+	}{
+		"errors if fail to get the cluster": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get cluster resources for environment pdx: some error"),
+		},
+		"errors if fail to list stopped tasks": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, gomock.Any()).Return([]*resourcegroups.Resource{&mockResource}, nil)
+				m.ecsClient.EXPECT().StoppedTasks(mockCluster).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list stopped tasks in cluster arn:aws::ecs:cluster/abcd1234: some error"),
+		},
+		"returns only the Copilot one-off tasks": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "pdx",
+				}).Return([]*resourcegroups.Resource{&mockResource}, nil)
+				m.ecsClient.EXPECT().StoppedTasks(mockCluster).Return([]*ecs.Task{
+					mockCopilotTask,
+					{
+						TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/987654321"),
+						Tags: []*awsecs.Tag{
+							{Key: aws.String("copilot-service")},
+						},
+					},
+				}, nil)
+			},
+			wanted: []*ecs.Task{mockCopilotTask},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := clientMocks{
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+				ecsClient:      mocks.NewMockecsClient(ctrl),
+			}
+			tc.setupMocks(m)
+
+			c := Client{
+				rgGetter:  m.resourceGetter,
+				ecsClient: m.ecsClient,
+			}
+
+			// WHEN
+			got, err := c.ListStoppedAppEnvTasks("phonetool", "pdx")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func TestClient_StopWorkloadTasks(t *testing.T) {
 	mockCluster := "arn:aws::ecs:cluster/abcd1234"
 	mockResource := resourcegroups.Resource{
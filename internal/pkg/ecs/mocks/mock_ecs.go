@@ -182,6 +182,21 @@ func (mr *MockecsClientMockRecorder) StoppedServiceTasks(cluster, service interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockecsClient)(nil).StoppedServiceTasks), cluster, service)
 }
 
+// StoppedTasks mocks base method.
+func (m *MockecsClient) StoppedTasks(cluster string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedTasks", cluster)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedTasks indicates an expected call of StoppedTasks.
+func (mr *MockecsClientMockRecorder) StoppedTasks(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedTasks", reflect.TypeOf((*MockecsClient)(nil).StoppedTasks), cluster)
+}
+
 // TaskDefinition mocks base method.
 func (m *MockecsClient) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
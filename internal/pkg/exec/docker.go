@@ -7,6 +7,7 @@ package exec
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -22,6 +23,9 @@ type DockerCommand struct {
 	// Override in unit tests.
 	buf      *bytes.Buffer
 	homePath string
+
+	binary string // The docker compatible CLI to run. Defaults to "docker".
+	buildx bool   // Whether to build images with the buildx plugin.
 }
 
 // NewDockerCommand returns a DockerCommand.
@@ -32,6 +36,20 @@ func NewDockerCommand() DockerCommand {
 	}
 }
 
+// NewBuildxCommand returns a DockerCommand that builds images with the `docker buildx` plugin.
+func NewBuildxCommand() DockerCommand {
+	cmd := NewDockerCommand()
+	cmd.buildx = true
+	return cmd
+}
+
+// NewPodmanCommand returns a DockerCommand that runs `podman` instead of `docker`.
+func NewPodmanCommand() DockerCommand {
+	cmd := NewDockerCommand()
+	cmd.binary = podmanBinary
+	return cmd
+}
+
 // BuildArguments holds the arguments we can pass in as flags from the manifest.
 type BuildArguments struct {
 	URI        string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
@@ -41,6 +59,37 @@ type BuildArguments struct {
 	Target     string            // Optional. The target build stage to pass to `docker build`
 	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
+	Secrets    []BuildSecret     // Optional. Secrets to expose to the build via `--secret` flags. Requires BuildKit.
+	SSH        []string          // Optional. SSH agent sockets or keys to forward via `--ssh` flags. Requires BuildKit.
+	CacheTo    []string          // Optional. Cache export destinations to pass via `--cache-to` flags. Requires BuildKit.
+	Labels     map[string]string // Optional. Metadata to add to the image via `--label` flags.
+	Network    string            // Optional. The networking mode for the RUN instructions to pass via `--network`.
+	Platform   string            // Optional. The target platform of the image, such as "linux/arm64", to pass via `--platform`.
+
+	SkipLatestTag bool // Optional. Tag the image only with Tags, for example if the tags of the repository are immutable.
+}
+
+// BuildSecret is a secret exposed to the RUN instructions of a build.
+// Exactly one of File or Env holds the value of the secret.
+type BuildSecret struct {
+	ID   string // Required. The id used by `RUN --mount=type=secret,id=<id>` instructions.
+	File string // Path to the file that holds the secret.
+	Env  string // Name of the environment variable that holds the secret.
+}
+
+// ExportsCacheToImageRepository returns true if a registry cache destination without a "ref" exports
+// the build cache to a tag of the repository of the image.
+func (in *BuildArguments) ExportsCacheToImageRepository() bool {
+	for _, cacheTo := range in.CacheTo {
+		if exportsToImageRepository(cacheTo) {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *BuildArguments) requiresBuildKit() bool {
+	return len(in.Secrets) != 0 || len(in.SSH) != 0 || len(in.CacheTo) != 0 || in.Network != "" || in.Platform != ""
 }
 
 type dockerConfig struct {
@@ -50,8 +99,25 @@ type dockerConfig struct {
 
 const (
 	credStoreECRLogin = "ecr-login" // set on `credStore` attribute in docker configuration file
+
+	dockerBinary = "docker"
+	podmanBinary = "podman"
+
+	envDockerBuildKit = "DOCKER_BUILDKIT=1"
+	envSSHAuthSock    = "SSH_AUTH_SOCK"
+	defaultSSHID      = "default"
+
+	cacheTypeRegistry = "registry"
+	defaultCacheStage = "default" // Stage in the cache tag when no build target is specified.
 )
 
+// BuildCacheTagPrefix is the prefix of the tag of the build cache exported to the repository of the image
+// when a registry cache destination has no "ref".
+const BuildCacheTagPrefix = "buildcache-"
+
+
+var buildNetworkModes = []string{"default", "none", "host"}
+
 // Architectures that receive special handling.
 const (
 	ArmArch   = "arm"
@@ -59,16 +125,26 @@ const (
 )
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
+// BuildKit is enabled if any of the secrets, ssh, cache to, network or platform options is set.
 func (c DockerCommand) Build(in *BuildArguments) error {
+	if err := c.validateBuildKitOptions(in); err != nil {
+		return fmt.Errorf("validate build options: %w", err)
+	}
 	dfDir := in.Context
 	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
 		dfDir = filepath.Dir(in.Dockerfile)
 	}
 
 	args := []string{"build"}
+	if c.buildx || len(in.CacheTo) != 0 {
+		// Only the buildx plugin can export the build cache. Load the image into docker so that it can be pushed.
+		args = []string{"buildx", "build", "--load"}
+	}
 
 	// Add additional image tags to the docker build call.
-	args = append(args, "-t", in.URI)
+	if !in.SkipLatestTag {
+		args = append(args, "-t", in.URI)
+	}
 	for _, tag := range in.Tags {
 		args = append(args, "-t", imageName(in.URI, tag))
 	}
@@ -95,18 +171,173 @@ func (c DockerCommand) Build(in *BuildArguments) error {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
 	}
 
+	for _, secret := range in.Secrets {
+		if secret.Env != "" {
+			args = append(args, "--secret", fmt.Sprintf("id=%s,env=%s", secret.ID, secret.Env))
+			continue
+		}
+		args = append(args, "--secret", fmt.Sprintf("id=%s,src=%s", secret.ID, c.expandHomeDir(secret.File)))
+	}
+	for _, ssh := range in.SSH {
+		id, paths := parseSSH(ssh)
+		if len(paths) == 0 {
+			args = append(args, "--ssh", id)
+			continue
+		}
+		for i, path := range paths {
+			paths[i] = c.expandHomeDir(path)
+		}
+		args = append(args, "--ssh", fmt.Sprintf("%s=%s", id, strings.Join(paths, ",")))
+	}
+	for _, cacheTo := range in.CacheTo {
+		args = append(args, "--cache-to", cacheExportDestination(cacheTo, in.URI, in.Target))
+	}
+	var labels []string
+	for k := range in.Labels {
+		labels = append(labels, k)
+	}
+	sort.Strings(labels)
+	for _, k := range labels {
+		args = append(args, "--label", fmt.Sprintf("%s=%s", k, in.Labels[k]))
+	}
+	if in.Network != "" {
+		args = append(args, "--network", in.Network)
+	}
+	if in.Platform != "" {
+		args = append(args, "--platform", in.Platform)
+	}
+
 	args = append(args, dfDir, "-f", in.Dockerfile)
 
-	if err := c.Run("docker", args); err != nil {
+	var opts []CmdOption
+	if in.requiresBuildKit() {
+		opts = append(opts, Env(envDockerBuildKit))
+	}
+	if err := c.Run(c.bin(), args, opts...); err != nil {
 		return fmt.Errorf("building image: %w", err)
 	}
 
 	return nil
 }
 
+// validateBuildKitOptions returns an error if the secrets, ssh, cache to, labels or network options
+// can't be passed to docker, for example if a secret file doesn't exist.
+func (c DockerCommand) validateBuildKitOptions(in *BuildArguments) error {
+	if c.bin() == podmanBinary && len(in.CacheTo) != 0 {
+		return errors.New("cache_to is not supported when building images with podman")
+	}
+	for _, secret := range in.Secrets {
+		if secret.ID == "" {
+			return errors.New("secrets must have an id")
+		}
+		if (secret.File == "") == (secret.Env == "") {
+			return fmt.Errorf("secret %s must be read from either a file or an environment variable", secret.ID)
+		}
+		if secret.File != "" {
+			if _, err := os.Stat(c.expandHomeDir(secret.File)); err != nil {
+				return fmt.Errorf("read file of secret %s: %w", secret.ID, err)
+			}
+			continue
+		}
+		if _, ok := os.LookupEnv(secret.Env); !ok {
+			return fmt.Errorf("environment variable %s of secret %s is not set", secret.Env, secret.ID)
+		}
+	}
+	for _, ssh := range in.SSH {
+		id, paths := parseSSH(ssh)
+		if id == "" {
+			return fmt.Errorf("ssh %q must have an id", ssh)
+		}
+		if len(paths) == 0 {
+			if _, ok := os.LookupEnv(envSSHAuthSock); !ok {
+				return fmt.Errorf("ssh %s forwards the SSH agent but %s is not set", id, envSSHAuthSock)
+			}
+			continue
+		}
+		for _, path := range paths {
+			if _, err := os.Stat(c.expandHomeDir(path)); err != nil {
+				return fmt.Errorf("read key of ssh %s: %w", id, err)
+			}
+		}
+	}
+	for _, cacheTo := range in.CacheTo {
+		if _, ok := parseCSVAttributes(cacheTo)["type"]; !ok {
+			return fmt.Errorf(`cache destination %q must have a "type"`, cacheTo)
+		}
+	}
+	for k := range in.Labels {
+		if k == "" {
+			return errors.New("labels must have a key")
+		}
+	}
+	if in.Network != "" && !contains(in.Network, buildNetworkModes) {
+		return fmt.Errorf("network %q must be one of %s", in.Network, strings.Join(buildNetworkModes, ", "))
+	}
+	return nil
+}
+
+func (c DockerCommand) expandHomeDir(path string) string {
+	if c.homePath == "" || !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	return filepath.Join(c.homePath, strings.TrimPrefix(path, "~/"))
+}
+
+// parseSSH splits an ssh option such as "default" or "github=~/.ssh/id_rsa" into its id and key paths.
+func parseSSH(ssh string) (id string, paths []string) {
+	parts := strings.SplitN(ssh, "=", 2)
+	if len(parts) == 1 || parts[1] == "" {
+		return parts[0], nil
+	}
+	return parts[0], strings.Split(parts[1], ",")
+}
+
+// cacheExportDestination returns the "--cache-to" value of a cache destination.
+// A registry destination without a "ref" exports the cache to the repository of the image, under a
+// "buildcache-" tag named after the build target so that it never overwrites an image tag.
+func cacheExportDestination(cacheTo, uri, target string) string {
+	if !exportsToImageRepository(cacheTo) {
+		return cacheTo
+	}
+	stage := target
+	if stage == "" {
+		stage = defaultCacheStage
+	}
+	return fmt.Sprintf("%s,ref=%s", cacheTo, imageName(uri, BuildCacheTagPrefix+stage))
+}
+
+// exportsToImageRepository returns true if the cache destination is a registry without a "ref".
+func exportsToImageRepository(cacheTo string) bool {
+	attrs := parseCSVAttributes(cacheTo)
+	_, hasRef := attrs["ref"]
+	return attrs["type"] == cacheTypeRegistry && !hasRef
+}
+
+// parseCSVAttributes parses comma-separated attributes such as "type=registry,mode=max".
+func parseCSVAttributes(csv string) map[string]string {
+	attrs := make(map[string]string)
+	for _, attr := range strings.Split(csv, ",") {
+		kv := strings.SplitN(attr, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		attrs[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return attrs
+}
+
+func contains(s string, items []string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (c DockerCommand) Login(uri, username, password string) error {
-	err := c.Run("docker",
+	err := c.Run(c.bin(),
 		[]string{"login", "-u", username, "--password-stdin", uri},
 		Stdin(strings.NewReader(password)))
 
@@ -125,12 +356,12 @@ func (c DockerCommand) Push(uri string, tags ...string) (digest string, err erro
 	}
 
 	for _, img := range images {
-		if err := c.Run("docker", []string{"push", img}); err != nil {
-			return "", fmt.Errorf("docker push %s: %w", img, err)
+		if err := c.Run(c.bin(), []string{"push", img}); err != nil {
+			return "", fmt.Errorf("%s push %s: %w", c.bin(), img, err)
 		}
 	}
 	buf := new(strings.Builder)
-	if err := c.Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", uri}, Stdout(buf)); err != nil {
+	if err := c.Run(c.bin(), []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", uri}, Stdout(buf)); err != nil {
 		return "", fmt.Errorf("inspect image digest for %s: %w", uri, err)
 	}
 	repoDigest := strings.Trim(strings.TrimSpace(buf.String()), `"'`) // remove new lines and quotes from output
@@ -143,11 +374,11 @@ func (c DockerCommand) Push(uri string, tags ...string) (digest string, err erro
 
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c DockerCommand) CheckDockerEngineRunning() error {
-	if _, err := exec.LookPath("docker"); err != nil {
+	if _, err := exec.LookPath(c.bin()); err != nil {
 		return ErrDockerCommandNotFound
 	}
 	buf := &bytes.Buffer{}
-	err := c.runner.Run("docker", []string{"info", "-f", "'{{json .}}'"}, Stdout(buf))
+	err := c.runner.Run(c.bin(), []string{"info", "-f", "'{{json .}}'"}, Stdout(buf))
 	if err != nil {
 		return fmt.Errorf("get docker info: %w", err)
 	}
@@ -171,11 +402,11 @@ func (c DockerCommand) CheckDockerEngineRunning() error {
 
 // GetPlatform will run the `docker version` command to get the OS/Arch.
 func (c DockerCommand) GetPlatform() (os, arch string, err error) {
-	if _, err := exec.LookPath("docker"); err != nil {
+	if _, err := exec.LookPath(c.bin()); err != nil {
 		return "", "", ErrDockerCommandNotFound
 	}
 	buf := &bytes.Buffer{}
-	err = c.runner.Run("docker", []string{"version", "-f", "'{{json .Server}}'"}, Stdout(buf))
+	err = c.runner.Run(c.bin(), []string{"version", "-f", "'{{json .Server}}'"}, Stdout(buf))
 	if err != nil {
 		return "", "", fmt.Errorf("run docker version: %w", err)
 	}
@@ -193,6 +424,14 @@ func (c DockerCommand) GetPlatform() (os, arch string, err error) {
 	return platform.OS, platform.Arch, nil
 }
 
+// bin returns the name of the CLI that runs the commands.
+func (c DockerCommand) bin() string {
+	if c.binary == "" {
+		return dockerBinary
+	}
+	return c.binary
+}
+
 func imageName(uri, tag string) string {
 	if tag == "" {
 		return uri // If no tag is specified build with latest.
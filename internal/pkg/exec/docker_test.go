@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"testing"
@@ -37,6 +39,7 @@ func TestDockerCommand_Build(t *testing.T) {
 		args       map[string]string
 		target     string
 		cacheFrom  []string
+		skipLatest bool
 		setupMocks func(controller *gomock.Controller)
 
 		wantedError error
@@ -106,6 +109,17 @@ func TestDockerCommand_Build(t *testing.T) {
 					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
 			},
 		},
+		"should not tag the image as latest if skipped": {
+			path:       mockPath,
+			tags:       []string{mockTag1},
+			skipLatest: true,
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = NewMockrunner(controller)
+				mockRunner.EXPECT().Run("docker", []string{"build",
+					"-t", mockURI + ":" + mockTag1,
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
 		"success with build args": {
 			path: mockPath,
 			args: map[string]string{
@@ -154,6 +168,8 @@ func TestDockerCommand_Build(t *testing.T) {
 				Target:     tc.target,
 				CacheFrom:  tc.cacheFrom,
 				Tags:       tc.tags,
+
+				SkipLatestTag: tc.skipLatest,
 			}
 			got := s.Build(&buildInput)
 
@@ -166,6 +182,225 @@ func TestDockerCommand_Build(t *testing.T) {
 	}
 }
 
+func TestDockerCommand_BuildWithBuildKit(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "buildkit")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	npmrc := filepath.Join(tmpDir, ".npmrc")
+	require.NoError(t, ioutil.WriteFile(npmrc, []byte("//registry.npmjs.org/:_authToken=abc"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "id_rsa"), []byte("key"), 0600))
+	require.NoError(t, os.Setenv("COPILOT_TEST_GITHUB_TOKEN", "ghp_123"))
+	defer os.Unsetenv("COPILOT_TEST_GITHUB_TOKEN")
+
+	mockURI := "mockURI"
+	mockPath := "mockPath/to/mockDockerfile"
+
+	testCases := map[string]struct {
+		in         BuildArguments
+		setupMocks func(m *Mockrunner)
+
+		wantedError error
+	}{
+		"should error if a secret has both a file and an environment variable": {
+			in: BuildArguments{
+				Secrets: []BuildSecret{{ID: "npmrc", File: npmrc, Env: "NPM_TOKEN"}},
+			},
+			wantedError: errors.New("validate build options: secret npmrc must be read from either a file or an environment variable"),
+		},
+		"should error if the file of a secret does not exist": {
+			in: BuildArguments{
+				Secrets: []BuildSecret{{ID: "npmrc", File: filepath.Join(tmpDir, "missing")}},
+			},
+			wantedError: fmt.Errorf("validate build options: read file of secret npmrc: stat %s: no such file or directory", filepath.Join(tmpDir, "missing")),
+		},
+		"should error if the environment variable of a secret is not set": {
+			in: BuildArguments{
+				Secrets: []BuildSecret{{ID: "token", Env: "COPILOT_TEST_UNSET"}},
+			},
+			wantedError: errors.New("validate build options: environment variable COPILOT_TEST_UNSET of secret token is not set"),
+		},
+		"should error if an ssh key does not exist": {
+			in: BuildArguments{
+				SSH: []string{"github=~/missing_rsa"},
+			},
+			wantedError: fmt.Errorf("validate build options: read key of ssh github: stat %s: no such file or directory", filepath.Join(tmpDir, "missing_rsa")),
+		},
+		"should error if a cache destination has no type": {
+			in: BuildArguments{
+				CacheTo: []string{"mode=max"},
+			},
+			wantedError: errors.New(`validate build options: cache destination "mode=max" must have a "type"`),
+		},
+		"should error if the network is not supported": {
+			in: BuildArguments{
+				Network: "bridge",
+			},
+			wantedError: errors.New(`validate build options: network "bridge" must be one of default, none, host`),
+		},
+		"should enable BuildKit and pass secrets, ssh keys, labels and network": {
+			in: BuildArguments{
+				Secrets: []BuildSecret{
+					{ID: "npmrc", File: npmrc},
+					{ID: "github_token", Env: "COPILOT_TEST_GITHUB_TOKEN"},
+				},
+				SSH: []string{"github=~/id_rsa"},
+				Labels: map[string]string{
+					"team":    "payments",
+					"service": "api",
+				},
+				Network: "host",
+			},
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker", []string{"build",
+					"-t", mockURI,
+					"--secret", "id=npmrc,src=" + npmrc,
+					"--secret", "id=github_token,env=COPILOT_TEST_GITHUB_TOKEN",
+					"--ssh", "github=" + filepath.Join(tmpDir, "id_rsa"),
+					"--label", "service=api",
+					"--label", "team=payments",
+					"--network", "host",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}, gomock.Any()).Return(nil)
+			},
+		},
+		"should enable BuildKit and pass the target platform": {
+			in: BuildArguments{
+				Platform: "linux/arm64",
+			},
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker", []string{"build",
+					"-t", mockURI,
+					"--platform", "linux/arm64",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}, gomock.Any()).Return(nil)
+			},
+		},
+		"should build with buildx and export the cache to the repository of the image": {
+			in: BuildArguments{
+				CacheTo: []string{"type=registry,mode=max", "type=local,dest=/tmp/cache"},
+			},
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker", []string{"buildx", "build", "--load",
+					"-t", mockURI,
+					"--cache-to", "type=registry,mode=max,ref=mockURI:buildcache-default",
+					"--cache-to", "type=local,dest=/tmp/cache",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}, gomock.Any()).Return(nil)
+			},
+		},
+		"should export the cache of a build target under its own tag": {
+			in: BuildArguments{
+				Target:  "prod",
+				CacheTo: []string{"type=registry,mode=max", "type=registry,ref=mockURI-cache:prod"},
+			},
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker", []string{"buildx", "build", "--load",
+					"-t", mockURI,
+					"--target", "prod",
+					"--cache-to", "type=registry,mode=max,ref=mockURI:buildcache-prod",
+					"--cache-to", "type=registry,ref=mockURI-cache:prod",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := NewMockrunner(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockRunner)
+			}
+			s := DockerCommand{
+				runner:   mockRunner,
+				homePath: tmpDir,
+			}
+			in := tc.in
+			in.URI = mockURI
+			in.Dockerfile = mockPath
+
+			err := s.Build(&in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDockerCommand_BuildWithAlternativeBuilders(t *testing.T) {
+	mockURI := "mockURI"
+	mockPath := "mockPath/to/mockDockerfile"
+
+	testCases := map[string]struct {
+		binary     string
+		buildx     bool
+		in         BuildArguments
+		setupMocks func(m *Mockrunner)
+
+		wantedError error
+	}{
+		"should build with the buildx plugin": {
+			buildx: true,
+			in: BuildArguments{
+				Tags: []string{"tag1"},
+			},
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker", []string{"buildx", "build", "--load",
+					"-t", mockURI,
+					"-t", "mockURI:tag1",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
+		"should build with podman": {
+			binary: podmanBinary,
+			in: BuildArguments{
+				Args: map[string]string{"GO_VERSION": "1.16"},
+			},
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("podman", []string{"build",
+					"-t", mockURI,
+					"--build-arg", "GO_VERSION=1.16",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
+		"should error if podman exports the build cache": {
+			binary: podmanBinary,
+			in: BuildArguments{
+				CacheTo: []string{"type=registry"},
+			},
+			wantedError: errors.New("validate build options: cache_to is not supported when building images with podman"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := NewMockrunner(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockRunner)
+			}
+			s := DockerCommand{
+				runner: mockRunner,
+				binary: tc.binary,
+				buildx: tc.buildx,
+			}
+			in := tc.in
+			in.URI = mockURI
+			in.Dockerfile = mockPath
+
+			err := s.Build(&in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDockerCommand_Login(t *testing.T) {
 	mockError := errors.New("mockError")
 
@@ -254,6 +489,30 @@ func TestDockerCommand_Push(t *testing.T) {
 		// THEN
 		require.EqualError(t, err, "docker push uri: some error")
 	})
+	t.Run("pushes an image with podman", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockrunner(ctrl)
+		m.EXPECT().Run("podman", []string{"push", "uri"}).Return(nil)
+		m.EXPECT().Run("podman", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "uri"}, gomock.Any()).
+			Do(func(_ string, _ []string, opt CmdOption) {
+				cmd := &exec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte("\"uri@sha256:f1d4ae3f\"\n"))
+			}).Return(nil)
+
+		// WHEN
+		cmd := DockerCommand{
+			runner: m,
+			binary: podmanBinary,
+		}
+		digest, err := cmd.Push("uri")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "sha256:f1d4ae3f", digest)
+	})
 	t.Run("returns a wrapped error on failure to retrieve image digest", func(t *testing.T) {
 		// GIVEN
 		ctrl := gomock.NewController(t)
@@ -504,3 +763,64 @@ func TestIsEcrCredentialHelperEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildArguments_requiresBuildKit(t *testing.T) {
+	testCases := map[string]struct {
+		in     BuildArguments
+		wanted bool
+	}{
+		"false without BuildKit options": {
+			in: BuildArguments{
+				Target:    "prod",
+				CacheFrom: []string{"foo/bar:latest"},
+			},
+		},
+		"false with labels only": {
+			in: BuildArguments{
+				Labels: map[string]string{"team": "payments"},
+			},
+		},
+		"true with ssh": {
+			in: BuildArguments{
+				SSH: []string{"default"},
+			},
+			wanted: true,
+		},
+		"true with a network": {
+			in: BuildArguments{
+				Network: "host",
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.requiresBuildKit())
+		})
+	}
+}
+
+func TestBuildArguments_ExportsCacheToImageRepository(t *testing.T) {
+	testCases := map[string]struct {
+		in     BuildArguments
+		wanted bool
+	}{
+		"false without cache destinations": {},
+		"false with registry destinations that have a ref": {
+			in: BuildArguments{
+				CacheTo: []string{"type=registry,ref=mockURI-cache:prod", "type=local,dest=/tmp/cache"},
+			},
+		},
+		"true with a registry destination without a ref": {
+			in: BuildArguments{
+				CacheTo: []string{"type=local,dest=/tmp/cache", "type=registry,mode=max"},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.ExportsCacheToImageRepository())
+		})
+	}
+}
@@ -70,6 +70,16 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Env appends environment variables to the ones inherited from the current process.
+func Env(env ...string) CmdOption {
+	return func(c *exec.Cmd) {
+		if c.Env == nil {
+			c.Env = os.Environ()
+		}
+		c.Env = append(c.Env, env...)
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(name, args, opts...)
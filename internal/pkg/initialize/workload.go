@@ -81,9 +81,10 @@ type JobProps struct {
 // ServiceProps contains the information needed to represent a Service (port, HealthCheck, and workload common props).
 type ServiceProps struct {
 	WorkloadProps
-	Port        uint16
-	HealthCheck *manifest.ContainerHealthCheck
-	appDomain   *string
+	Port             uint16
+	HealthCheck      *manifest.ContainerHealthCheck
+	SourceRepository string // Only used by Request-Driven Web Services built from source.
+	appDomain        *string
 }
 
 // WorkloadInitializer holds the clients necessary to initialize either a
@@ -326,7 +327,8 @@ func (w *WorkloadInitializer) newRequestDrivenWebServiceManifest(i *ServiceProps
 			Dockerfile: i.DockerfilePath,
 			Image:      i.Image,
 		},
-		Port: i.Port,
+		Port:             i.Port,
+		SourceRepository: i.SourceRepository,
 	}
 	return manifest.NewRequestDrivenWebService(props)
 }
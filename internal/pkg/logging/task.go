@@ -5,6 +5,7 @@
 package logging
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -23,6 +24,9 @@ const (
 	fmtTaskLogStreamName = "copilot-task/%s/%s"
 )
 
+// ErrTaskTimeout is returned by WriteEventsUntilStopped if the tasks are still running when the timeout expires.
+var ErrTaskTimeout = errors.New("tasks are still running after the timeout")
+
 // TasksDescriber describes ECS tasks.
 type TasksDescriber interface {
 	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
@@ -34,17 +38,32 @@ type TaskClient struct {
 	groupName string
 	tasks     []*task.Task
 
+	deadline     time.Time   // Zero if the client doesn't time out.
+	stoppedTasks []*ecs.Task // Descriptions of the tasks that stopped while their events were written.
+
 	eventsWriter  io.Writer
 	eventsLogger  logGetter
 	taskDescriber TasksDescriber
 
 	// Replaced in tests.
 	sleep func()
+	now   func() time.Time
+}
+
+// TaskClientOpts sets optional fields of a TaskClient.
+type TaskClientOpts func(*TaskClient)
+
+// WithTimeout makes WriteEventsUntilStopped return ErrTaskTimeout if the tasks are still running after the timeout.
+// The timeout expires only once, so that the events of the tasks can still be written while they are stopped.
+func WithTimeout(timeout time.Duration) TaskClientOpts {
+	return func(t *TaskClient) {
+		t.deadline = t.now().Add(timeout)
+	}
 }
 
 // NewTaskClient returns a TaskClient that can retrieve logs from the given tasks under the groupName.
-func NewTaskClient(sess *session.Session, groupName string, tasks []*task.Task) *TaskClient {
-	return &TaskClient{
+func NewTaskClient(sess *session.Session, groupName string, tasks []*task.Task, opts ...TaskClientOpts) *TaskClient {
+	client := &TaskClient{
 		groupName: groupName,
 		tasks:     tasks,
 
@@ -55,7 +74,12 @@ func NewTaskClient(sess *session.Session, groupName string, tasks []*task.Task)
 		sleep: func() {
 			time.Sleep(cloudwatchlogs.SleepDuration)
 		},
+		now: time.Now,
+	}
+	for _, opt := range opts {
+		opt(client)
 	}
+	return client
 }
 
 // WriteEventsUntilStopped writes tasks' events to a writer until all tasks have stopped.
@@ -88,9 +112,18 @@ func (t *TaskClient) WriteEventsUntilStopped() error {
 		if stopped {
 			return nil
 		}
+		if !t.deadline.IsZero() && !t.now().Before(t.deadline) {
+			t.deadline = time.Time{}
+			return ErrTaskTimeout
+		}
 	}
 }
 
+// StoppedTasks returns the descriptions of the tasks that stopped while their events were written.
+func (t *TaskClient) StoppedTasks() []*ecs.Task {
+	return t.stoppedTasks
+}
+
 func (t *TaskClient) allTasksStopped() (bool, error) {
 	taskARNs := make([]string, len(t.tasks))
 	for idx, task := range t.tasks {
@@ -107,14 +140,16 @@ func (t *TaskClient) allTasksStopped() (bool, error) {
 
 	stopped := true
 	var runningTasks []*task.Task
-	for _, t := range tasksResp {
-		if *t.LastStatus != ecs.DesiredStatusStopped {
-			stopped = false
-			runningTasks = append(runningTasks, &task.Task{
-				ClusterARN: *t.ClusterArn,
-				TaskARN:    *t.TaskArn,
-			})
+	for _, resp := range tasksResp {
+		if *resp.LastStatus == ecs.DesiredStatusStopped {
+			t.stoppedTasks = append(t.stoppedTasks, resp)
+			continue
 		}
+		stopped = false
+		runningTasks = append(runningTasks, &task.Task{
+			ClusterARN: *resp.ClusterArn,
+			TaskARN:    *resp.TaskArn,
+		})
 	}
 	t.tasks = runningTasks
 	return stopped, nil
@@ -61,11 +61,27 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 			StartedAt:  &now,
 		},
 	}
+	stoppedTasks := []*ecs.Task{
+		{
+			TaskArn:    aws.String(taskARN1),
+			LastStatus: aws.String(ecs.DesiredStatusStopped),
+		},
+		{
+			TaskArn:    aws.String(taskARN2),
+			LastStatus: aws.String(ecs.DesiredStatusStopped),
+		},
+		{
+			TaskArn:    aws.String(taskARN3),
+			LastStatus: aws.String(ecs.DesiredStatusStopped),
+		},
+	}
 	testCases := map[string]struct {
 		tasks      []*task.Task
+		deadline   time.Time
 		setUpMocks func(m writeEventMocks)
 
-		wantedError error
+		wantedError        error
+		wantedStoppedTasks []*ecs.Task
 	}{
 		"error parsing task ID": {
 			tasks:       badTasks,
@@ -102,22 +118,31 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 					Return(&cloudwatchlogs.LogEventsOutput{
 						Events: []*cloudwatchlogs.Event{},
 					}, nil).Times(numCWLogsCallsPerRound)
+				m.describer.EXPECT().DescribeTasks("cluster", []string{taskARN1, taskARN2, taskARN3}).
+					Return(stoppedTasks, nil)
+			},
+			wantedStoppedTasks: stoppedTasks,
+		},
+		"times out if tasks are still running after the deadline": {
+			tasks:    goodTasks,
+			deadline: now,
+			setUpMocks: func(m writeEventMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{},
+					}, nil).Times(numCWLogsCallsPerRound)
 				m.describer.EXPECT().DescribeTasks("cluster", []string{taskARN1, taskARN2, taskARN3}).
 					Return([]*ecs.Task{
-						{
-							TaskArn:    aws.String(taskARN1),
-							LastStatus: aws.String(ecs.DesiredStatusStopped),
-						},
+						stoppedTasks[0],
 						{
 							TaskArn:    aws.String(taskARN2),
-							LastStatus: aws.String(ecs.DesiredStatusStopped),
-						},
-						{
-							TaskArn:    aws.String(taskARN3),
-							LastStatus: aws.String(ecs.DesiredStatusStopped),
+							ClusterArn: aws.String("cluster"),
+							LastStatus: aws.String("RUNNING"),
 						},
 					}, nil)
 			},
+			wantedError:        ErrTaskTimeout,
+			wantedStoppedTasks: stoppedTasks[:1],
 		},
 	}
 
@@ -140,7 +165,12 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 				eventsLogger:  mocks.logGetter,
 				taskDescriber: mocks.describer,
 
+				deadline: tc.deadline,
+
 				sleep: func() {}, // no-op.
+				now: func() time.Time {
+					return now
+				},
 			}
 
 			err := ew.WriteEventsUntilStopped()
@@ -149,6 +179,7 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tc.wantedStoppedTasks, ew.StoppedTasks())
 		})
 	}
 }
@@ -37,6 +37,7 @@ type BackendServiceConfig struct {
 	TaskConfig    `yaml:",inline"`
 	*Logging      `yaml:"logging,flow"`
 	Sidecars      map[string]*SidecarConfig `yaml:"sidecars"`
+	Observability Observability             `yaml:"observability"`
 	Network       *NetworkConfig            `yaml:"network"`
 }
 
@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// Dependencies are the relationships of a workload with the other workloads of the application
+// as declared in its manifest.
+type Dependencies struct {
+	Calls         []string            // Names of the services the workload calls, from "network.connect.services".
+	Subscriptions []TopicSubscription // Topics published by other services that the workload subscribes to.
+	Alias         string              // Alias of the workload on the load balancer, shared with the services that declare the same alias.
+}
+
+// WorkloadDependencies returns the relationships declared in the workload manifest.
+// The names of the called services and the subscriptions are sorted.
+func WorkloadDependencies(mft interface{}) Dependencies {
+	var deps Dependencies
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		deps.Calls = connectServices(m.Network)
+		deps.Alias = aws.StringValue(m.Alias)
+	case *BackendService:
+		deps.Calls = connectServices(m.Network)
+	case *WorkerService:
+		deps.Calls = connectServices(m.Network)
+		if m.Subscribe != nil && m.Subscribe.Topics != nil {
+			deps.Subscriptions = append(deps.Subscriptions, *m.Subscribe.Topics...)
+		}
+	case *ScheduledJob:
+		deps.Calls = connectServices(m.Network)
+	}
+	sort.Strings(deps.Calls)
+	sort.SliceStable(deps.Subscriptions, func(i, j int) bool {
+		if deps.Subscriptions[i].Service != deps.Subscriptions[j].Service {
+			return deps.Subscriptions[i].Service < deps.Subscriptions[j].Service
+		}
+		return deps.Subscriptions[i].Name < deps.Subscriptions[j].Name
+	})
+	return deps
+}
+
+func connectServices(network *NetworkConfig) []string {
+	if network == nil || network.Connect == nil {
+		return nil
+	}
+	return append([]string(nil), network.Connect.Services...)
+}
@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted Dependencies
+	}{
+		"returns the called services and the alias of a load balanced web service": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						Alias: aws.String("example.com"),
+					},
+					Network: &NetworkConfig{
+						Connect: &ConnectConfig{
+							Services: []string{"users", "orders"},
+						},
+					},
+				},
+			},
+			wanted: Dependencies{
+				Calls: []string{"orders", "users"},
+				Alias: "example.com",
+			},
+		},
+		"returns the subscriptions of a worker service": {
+			in: &WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					Subscribe: &SubscribeConfig{
+						Topics: &[]TopicSubscription{
+							{Name: "shipped", Service: "orders"},
+							{Name: "created", Service: "users"},
+							{Name: "created", Service: "orders"},
+						},
+					},
+				},
+			},
+			wanted: Dependencies{
+				Subscriptions: []TopicSubscription{
+					{Name: "created", Service: "orders"},
+					{Name: "shipped", Service: "orders"},
+					{Name: "created", Service: "users"},
+				},
+			},
+		},
+		"returns no dependencies for a request-driven web service": {
+			in: &RequestDrivenWebService{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, WorkloadDependencies(tc.in))
+		})
+	}
+}
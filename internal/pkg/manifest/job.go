@@ -41,6 +41,7 @@ type ScheduledJobConfig struct {
 	TaskConfig              `yaml:",inline"`
 	*Logging                `yaml:"logging,flow"`
 	Sidecars                map[string]*SidecarConfig `yaml:"sidecars"`
+	Observability           Observability             `yaml:"observability"`
 	On                      JobTriggerConfig          `yaml:"on,flow"`
 	JobFailureHandlerConfig `yaml:",inline"`
 	Network                 *NetworkConfig `yaml:"network"`
@@ -52,6 +52,7 @@ type LoadBalancedWebServiceConfig struct {
 	TaskConfig    `yaml:",inline"`
 	*Logging      `yaml:"logging,flow"`
 	Sidecars      map[string]*SidecarConfig `yaml:"sidecars"`
+	Observability Observability             `yaml:"observability"`
 	Network       *NetworkConfig            `yaml:"network"` // TODO: the type needs to be updated after we upgrade mergo
 }
 
@@ -66,6 +67,8 @@ type RoutingRule struct {
 	TargetContainer          *string   `yaml:"target_container"`
 	TargetContainerCamelCase *string   `yaml:"targetContainer"`    // "targetContainerCamelCase" for backwards compatibility
 	AllowedSourceIps         *[]string `yaml:"allowed_source_ips"` // TODO: the type needs to be updated after we upgrade mergo
+	// Internal places the service behind the environment's internal load balancer instead of the public one.
+	Internal *bool `yaml:"internal"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
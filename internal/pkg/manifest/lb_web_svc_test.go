@@ -357,7 +357,7 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 					Sidecars: map[string]*SidecarConfig{
 						"xray": {
 							Port:       aws.String("2000"),
-							Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+							Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 							CredsParam: aws.String("some arn"),
 						},
 					},
@@ -502,7 +502,7 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 					Sidecars: map[string]*SidecarConfig{
 						"xray": {
 							Port:       aws.String("2000/udp"),
-							Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+							Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 							CredsParam: aws.String("some arn"),
 							MountPoints: []SidecarMountPoint{
 								{
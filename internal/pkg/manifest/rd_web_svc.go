@@ -4,6 +4,8 @@
 package manifest
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/imdario/mergo"
@@ -11,6 +13,8 @@ import (
 
 const (
 	requestDrivenWebSvcManifestPath string = "workloads/services/rd-web/manifest.yml"
+
+	defaultAppRunnerSourceBranch = "main"
 )
 
 // RequestDrivenWebService holds the configuration to create a Request-Driven Web Service.
@@ -25,10 +29,63 @@ type RequestDrivenWebService struct {
 // RequestDrivenWebServiceConfig holds the configuration that can be overridden per environments.
 type RequestDrivenWebServiceConfig struct {
 	RequestDrivenWebServiceHttpConfig `yaml:"http,flow"`
-	InstanceConfig                    AppRunnerInstanceConfig `yaml:",inline"`
-	ImageConfig                       ImageWithPort           `yaml:"image"`
-	Variables                         map[string]string       `yaml:"variables"`
-	Tags                              map[string]string       `yaml:"tags"`
+	InstanceConfig                    AppRunnerInstanceConfig              `yaml:",inline"`
+	ImageConfig                       RequestDrivenWebServiceImageConfig   `yaml:"image"`
+	Variables                         map[string]string                    `yaml:"variables"`
+	Secrets                           map[string]string                    `yaml:"secrets"`
+	Network                           RequestDrivenWebServiceNetworkConfig `yaml:"network"`
+	Autoscaling                       AppRunnerAutoscalingConfig           `yaml:"autoscaling"`
+	Tags                              map[string]string                    `yaml:"tags"`
+}
+
+// RequestDrivenWebServiceImageConfig represents the container image of a Request-Driven Web Service,
+// or the source code repository that App Runner builds the service from.
+type RequestDrivenWebServiceImageConfig struct {
+	ImageWithPort `yaml:",inline"`
+	Source        AppRunnerSourceConfig `yaml:"source"`
+}
+
+// AppRunnerSourceConfig represents a source code repository that App Runner builds and deploys from.
+type AppRunnerSourceConfig struct {
+	Connection   *string `yaml:"connection"` // ARN of the App Runner connection to the repository provider.
+	Repository   *string `yaml:"repository"`
+	Branch       *string `yaml:"branch"`
+	Runtime      *string `yaml:"runtime"`
+	BuildCommand *string `yaml:"build_command"`
+	StartCommand *string `yaml:"start_command"`
+}
+
+// IsEmpty returns true if the service is not built from a source code repository.
+func (s AppRunnerSourceConfig) IsEmpty() bool {
+	return s.Connection == nil && s.Repository == nil && s.Branch == nil &&
+		s.Runtime == nil && s.BuildCommand == nil && s.StartCommand == nil
+}
+
+// RequestDrivenWebServiceNetworkConfig represents options for network connection to AWS resources for a Request-Driven Web Service.
+type RequestDrivenWebServiceNetworkConfig struct {
+	VPC RequestDrivenWebServiceVpcConfig `yaml:"vpc"`
+}
+
+// RequestDrivenWebServiceVpcConfig represents the VPC that the outbound traffic of a Request-Driven Web Service is routed through.
+type RequestDrivenWebServiceVpcConfig struct {
+	Placement *string `yaml:"placement"`
+}
+
+// IsEmpty returns true if the VPC configuration is not set.
+func (c RequestDrivenWebServiceVpcConfig) IsEmpty() bool {
+	return c.Placement == nil
+}
+
+// AppRunnerAutoscalingConfig contains the scaling configuration properties for an App Runner service.
+type AppRunnerAutoscalingConfig struct {
+	MaxConcurrency *int `yaml:"max_concurrency"`
+	MinInstances   *int `yaml:"min_instances"`
+	MaxInstances   *int `yaml:"max_instances"`
+}
+
+// IsEmpty returns true if none of the autoscaling fields are set.
+func (c AppRunnerAutoscalingConfig) IsEmpty() bool {
+	return c.MaxConcurrency == nil && c.MinInstances == nil && c.MaxInstances == nil
 }
 
 type RequestDrivenWebServiceHttpConfig struct {
@@ -38,7 +95,8 @@ type RequestDrivenWebServiceHttpConfig struct {
 // RequestDrivenWebServiceProps contains properties for creating a new request-driven web service manifest.
 type RequestDrivenWebServiceProps struct {
 	*WorkloadProps
-	Port uint16
+	Port             uint16
+	SourceRepository string // URL of the repository that App Runner builds the service from.
 }
 
 // AppRunnerInstanceConfig contains the instance configuration properties for an App Runner service.
@@ -54,6 +112,12 @@ func NewRequestDrivenWebService(props *RequestDrivenWebServiceProps) *RequestDri
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Image.Location = stringP(props.Image)
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Port = aws.Uint16(props.Port)
+	if props.SourceRepository != "" {
+		svc.RequestDrivenWebServiceConfig.ImageConfig.Source = AppRunnerSourceConfig{
+			Repository: aws.String(props.SourceRepository),
+			Branch:     aws.String(defaultAppRunnerSourceBranch),
+		}
+	}
 	svc.parser = template.New()
 	return svc
 }
@@ -65,7 +129,7 @@ func newDefaultRequestDrivenWebService() *RequestDrivenWebService {
 			Type: aws.String(RequestDrivenWebServiceType),
 		},
 		RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-			ImageConfig: ImageWithPort{},
+			ImageConfig: RequestDrivenWebServiceImageConfig{},
 			InstanceConfig: AppRunnerInstanceConfig{
 				CPU:    aws.Int(1024),
 				Memory: aws.Int(2048),
@@ -86,6 +150,12 @@ func (s *RequestDrivenWebService) MarshalBinary() ([]byte, error) {
 
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (s *RequestDrivenWebService) BuildRequired() (bool, error) {
+	if !s.ImageConfig.Source.IsEmpty() {
+		if !s.ImageConfig.Build.isEmpty() || s.ImageConfig.Location != nil {
+			return false, fmt.Errorf(`"image.source" cannot be specified together with "image.build" or "image.location"`)
+		}
+		return false, nil
+	}
 	return requiresBuild(s.ImageConfig.Image)
 }
 
@@ -42,15 +42,48 @@ func TestNewRequestDrivenWebService(t *testing.T) {
 					Type: aws.String(RequestDrivenWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Build: BuildArgsOrString{
-								BuildArgs: DockerBuildArgs{
-									Dockerfile: aws.String("./Dockerfile"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildArgs: DockerBuildArgs{
+										Dockerfile: aws.String("./Dockerfile"),
+									},
 								},
 							},
+							Port: aws.Uint16(80),
+						},
+					},
+					InstanceConfig: AppRunnerInstanceConfig{
+						CPU:    aws.Int(1024),
+						Memory: aws.Int(2048),
+					},
+				},
+			},
+		},
+		"should scaffold the source configuration when building from a repository": {
+			input: &RequestDrivenWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name: "api",
+				},
+				Port:             uint16(8080),
+				SourceRepository: "https://github.com/user/repo",
+			},
+
+			wantedStruct: &RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(RequestDrivenWebServiceType),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Port: aws.Uint16(8080),
+						},
+						Source: AppRunnerSourceConfig{
+							Repository: aws.String("https://github.com/user/repo"),
+							Branch:     aws.String("main"),
 						},
-						Port: aws.Uint16(80),
 					},
 					InstanceConfig: AppRunnerInstanceConfig{
 						CPU:    aws.Int(1024),
@@ -101,13 +134,15 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 					Type: aws.String(RequestDrivenWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Build: BuildArgsOrString{
-								BuildString: aws.String("./Dockerfile"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("./Dockerfile"),
+								},
 							},
+							Port: aws.Uint16(80),
 						},
-						Port: aws.Uint16(80),
 					},
 					InstanceConfig: AppRunnerInstanceConfig{
 						CPU:    aws.Int(512),
@@ -124,9 +159,11 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 
 			wantedStruct: RequestDrivenWebService{
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Location: aws.String("test-repository/image@digest"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Location: aws.String("test-repository/image@digest"),
+							},
 						},
 					},
 				},
@@ -148,15 +185,17 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 
 			wantedStruct: RequestDrivenWebService{
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Build: BuildArgsOrString{
-								BuildArgs: DockerBuildArgs{
-									Context:    aws.String("context/dir"),
-									Dockerfile: aws.String("./Dockerfile"),
-									Target:     aws.String("build-stage"),
-									CacheFrom:  []string{"image:tag"},
-									Args:       map[string]string{"a": "1", "b": "2"},
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildArgs: DockerBuildArgs{
+										Context:    aws.String("context/dir"),
+										Dockerfile: aws.String("./Dockerfile"),
+										Target:     aws.String("build-stage"),
+										CacheFrom:  []string{"image:tag"},
+										Args:       map[string]string{"a": "1", "b": "2"},
+									},
 								},
 							},
 						},
@@ -164,6 +203,37 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				},
 			},
 		},
+		"should unmarshal image source configuration": {
+			inContent: []byte(
+				"image:\n" +
+					"  source:\n" +
+					"    connection: arn:aws:apprunner:us-west-2:123456789012:connection/github/abc\n" +
+					"    repository: https://github.com/user/repo\n" +
+					"    branch: main\n" +
+					"    runtime: PYTHON_3\n" +
+					"    build_command: pip install -r requirements.txt\n" +
+					"    start_command: python app.py\n" +
+					"  port: 8080\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Port: aws.Uint16(8080),
+						},
+						Source: AppRunnerSourceConfig{
+							Connection:   aws.String("arn:aws:apprunner:us-west-2:123456789012:connection/github/abc"),
+							Repository:   aws.String("https://github.com/user/repo"),
+							Branch:       aws.String("main"),
+							Runtime:      aws.String("PYTHON_3"),
+							BuildCommand: aws.String("pip install -r requirements.txt"),
+							StartCommand: aws.String("python app.py"),
+						},
+					},
+				},
+			},
+		},
 		"should unmarshal environment variables": {
 			inContent: []byte(
 				"variables:\n" +
@@ -196,6 +266,48 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				},
 			},
 		},
+		"should unmarshal secrets": {
+			inContent: []byte(
+				"secrets:\n" +
+					"  DB_SECRET: arn:aws:secretsmanager:us-west-2:123456789012:secret:mydb\n" +
+					"  GITHUB_TOKEN: GITHUB_TOKEN\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Secrets: map[string]string{
+						"DB_SECRET":    "arn:aws:secretsmanager:us-west-2:123456789012:secret:mydb",
+						"GITHUB_TOKEN": "GITHUB_TOKEN",
+					},
+				},
+			},
+		},
+		"should unmarshal network and autoscaling": {
+			inContent: []byte(
+				"network:\n" +
+					"  vpc:\n" +
+					"    placement: private\n" +
+					"autoscaling:\n" +
+					"  max_concurrency: 50\n" +
+					"  min_instances: 2\n" +
+					"  max_instances: 10\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Network: RequestDrivenWebServiceNetworkConfig{
+						VPC: RequestDrivenWebServiceVpcConfig{
+							Placement: aws.String("private"),
+						},
+					},
+					Autoscaling: AppRunnerAutoscalingConfig{
+						MaxConcurrency: aws.Int(50),
+						MinInstances:   aws.Int(2),
+						MaxInstances:   aws.Int(10),
+					},
+				},
+			},
+		},
 		"should unmarshal healthcheck": {
 			inContent: []byte(
 				"http:\n" +
@@ -257,6 +369,9 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.Variables, svc.Variables)
 				require.Equal(t, tc.wantedStruct.InstanceConfig, svc.InstanceConfig)
 				require.Equal(t, tc.wantedStruct.Tags, svc.Tags)
+				require.Equal(t, tc.wantedStruct.Secrets, svc.Secrets)
+				require.Equal(t, tc.wantedStruct.Network, svc.Network)
+				require.Equal(t, tc.wantedStruct.Autoscaling, svc.Autoscaling)
 			}
 		})
 	}
@@ -320,11 +435,13 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Build: BuildArgsOrString{
-								BuildArgs: DockerBuildArgs{
-									Dockerfile: aws.String("./Dockerfile"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildArgs: DockerBuildArgs{
+										Dockerfile: aws.String("./Dockerfile"),
+									},
 								},
 							},
 						},
@@ -332,9 +449,11 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 				},
 				Environments: map[string]*RequestDrivenWebServiceConfig{
 					"prod-iad": {
-						ImageConfig: ImageWithPort{
-							Image: Image{
-								Location: aws.String("env-override location"),
+						ImageConfig: RequestDrivenWebServiceImageConfig{
+							ImageWithPort: ImageWithPort{
+								Image: Image{
+									Location: aws.String("env-override location"),
+								},
 							},
 						},
 					},
@@ -348,9 +467,11 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Location: aws.String("env-override location"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Location: aws.String("env-override location"),
+							},
 						},
 					},
 				},
@@ -363,17 +484,21 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Location: aws.String("default location"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Location: aws.String("default location"),
+							},
 						},
 					},
 				},
 				Environments: map[string]*RequestDrivenWebServiceConfig{
 					"prod-iad": {
-						ImageConfig: ImageWithPort{
-							Image: Image{
-								Location: aws.String("env-override location"),
+						ImageConfig: RequestDrivenWebServiceImageConfig{
+							ImageWithPort: ImageWithPort{
+								Image: Image{
+									Location: aws.String("env-override location"),
+								},
 							},
 						},
 					},
@@ -387,9 +512,11 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Location: aws.String("env-override location"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Location: aws.String("env-override location"),
+							},
 						},
 					},
 				},
@@ -402,11 +529,13 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Build: BuildArgsOrString{
-								BuildArgs: DockerBuildArgs{
-									Dockerfile: aws.String("./Dockerfile"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildArgs: DockerBuildArgs{
+										Dockerfile: aws.String("./Dockerfile"),
+									},
 								},
 							},
 						},
@@ -414,10 +543,12 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 				},
 				Environments: map[string]*RequestDrivenWebServiceConfig{
 					"prod-iad": {
-						ImageConfig: ImageWithPort{
-							Image: Image{
-								Build: BuildArgsOrString{
-									BuildString: aws.String("overridden build string"),
+						ImageConfig: RequestDrivenWebServiceImageConfig{
+							ImageWithPort: ImageWithPort{
+								Image: Image{
+									Build: BuildArgsOrString{
+										BuildString: aws.String("overridden build string"),
+									},
 								},
 							},
 						},
@@ -432,10 +563,12 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Build: BuildArgsOrString{
-								BuildString: aws.String("overridden build string"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("overridden build string"),
+								},
 							},
 						},
 					},
@@ -449,18 +582,22 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Location: aws.String("default location"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Location: aws.String("default location"),
+							},
 						},
 					},
 				},
 				Environments: map[string]*RequestDrivenWebServiceConfig{
 					"prod-iad": {
-						ImageConfig: ImageWithPort{
-							Image: Image{
-								Build: BuildArgsOrString{
-									BuildString: aws.String("overridden build string"),
+						ImageConfig: RequestDrivenWebServiceImageConfig{
+							ImageWithPort: ImageWithPort{
+								Image: Image{
+									Build: BuildArgsOrString{
+										BuildString: aws.String("overridden build string"),
+									},
 								},
 							},
 						},
@@ -475,10 +612,12 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 					Type: aws.String(LoadBalancedWebServiceType),
 				},
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
-						Image: Image{
-							Build: BuildArgsOrString{
-								BuildString: aws.String("overridden build string"),
+					ImageConfig: RequestDrivenWebServiceImageConfig{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("overridden build string"),
+								},
 							},
 						},
 					},
@@ -497,3 +636,63 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestDrivenWebService_BuildRequired(t *testing.T) {
+	testCases := map[string]struct {
+		in RequestDrivenWebServiceImageConfig
+
+		wanted    bool
+		wantedErr error
+	}{
+		"should build from a Dockerfile": {
+			in: RequestDrivenWebServiceImageConfig{
+				ImageWithPort: ImageWithPort{
+					Image: Image{
+						Build: BuildArgsOrString{
+							BuildString: aws.String("./Dockerfile"),
+						},
+					},
+				},
+			},
+			wanted: true,
+		},
+		"should not build if App Runner builds from source": {
+			in: RequestDrivenWebServiceImageConfig{
+				Source: AppRunnerSourceConfig{
+					Repository: aws.String("https://github.com/user/repo"),
+				},
+			},
+		},
+		"error if both source and image location are specified": {
+			in: RequestDrivenWebServiceImageConfig{
+				ImageWithPort: ImageWithPort{
+					Image: Image{
+						Location: aws.String("public.ecr.aws/nginx/nginx"),
+					},
+				},
+				Source: AppRunnerSourceConfig{
+					Repository: aws.String("https://github.com/user/repo"),
+				},
+			},
+			wantedErr: errors.New(`"image.source" cannot be specified together with "image.build" or "image.location"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: tc.in,
+				},
+			}
+
+			got, err := svc.BuildRequired()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SecretRef is a secret referenced by a workload manifest.
+type SecretRef struct {
+	Path      string // Path to the field in the manifest, such as "sidecars.nginx.secrets.API_KEY".
+	ValueFrom string // Name or ARN of the SSM parameter, or ARN of the Secrets Manager secret.
+}
+
+// SecretRefs returns the secrets referenced by the workload manifest sorted by their path,
+// including the secrets of the sidecars and the secret options of the log router.
+func SecretRefs(mft interface{}) []SecretRef {
+	var refs []SecretRef
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		refs = taskSecretRefs(m.TaskConfig, m.Sidecars, m.Logging)
+	case *BackendService:
+		refs = taskSecretRefs(m.TaskConfig, m.Sidecars, m.Logging)
+	case *WorkerService:
+		refs = taskSecretRefs(m.TaskConfig, m.Sidecars, m.Logging)
+	case *ScheduledJob:
+		refs = taskSecretRefs(m.TaskConfig, m.Sidecars, m.Logging)
+	case *RequestDrivenWebService:
+		refs = secretRefsAt("secrets", m.Secrets)
+	}
+	sort.SliceStable(refs, func(i, j int) bool {
+		return refs[i].Path < refs[j].Path
+	})
+	return refs
+}
+
+func taskSecretRefs(task TaskConfig, sidecars map[string]*SidecarConfig, logging *Logging) []SecretRef {
+	refs := secretRefsAt("secrets", task.Secrets)
+	for name, sidecar := range sidecars {
+		if sidecar == nil {
+			continue
+		}
+		refs = append(refs, secretRefsAt(fmt.Sprintf("sidecars.%s.secrets", name), sidecar.Secrets)...)
+	}
+	if logging != nil {
+		refs = append(refs, secretRefsAt("logging.secretOptions", logging.SecretOptions)...)
+	}
+	return refs
+}
+
+func secretRefsAt(path string, secrets map[string]string) []SecretRef {
+	var refs []SecretRef
+	for key, valueFrom := range secrets {
+		refs = append(refs, SecretRef{
+			Path:      fmt.Sprintf("%s.%s", path, key),
+			ValueFrom: valueFrom,
+		})
+	}
+	return refs
+}
@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretRefs(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted []SecretRef
+	}{
+		"returns the secrets of the main container, sidecars and log router": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Secrets: map[string]string{
+							"DB_PASSWORD": "/copilot/phonetool/test/secrets/db-password",
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Secrets: map[string]string{
+								"API_KEY": "arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key-AbCdEf",
+							},
+						},
+						"empty": nil,
+					},
+					Logging: &Logging{
+						SecretOptions: map[string]string{
+							"LICENSE": "arn:aws:ssm:us-west-2:123456789012:parameter/license",
+						},
+					},
+				},
+			},
+			wanted: []SecretRef{
+				{Path: "logging.secretOptions.LICENSE", ValueFrom: "arn:aws:ssm:us-west-2:123456789012:parameter/license"},
+				{Path: "secrets.DB_PASSWORD", ValueFrom: "/copilot/phonetool/test/secrets/db-password"},
+				{Path: "sidecars.nginx.secrets.API_KEY", ValueFrom: "arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key-AbCdEf"},
+			},
+		},
+		"returns the secrets of a scheduled job": {
+			in: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					TaskConfig: TaskConfig{
+						Secrets: map[string]string{
+							"B": "b",
+							"A": "a",
+						},
+					},
+				},
+			},
+			wanted: []SecretRef{
+				{Path: "secrets.A", ValueFrom: "a"},
+				{Path: "secrets.B", ValueFrom: "b"},
+			},
+		},
+		"returns the secrets of a request-driven web service": {
+			in: &RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Secrets: map[string]string{
+						"DB_PASSWORD": "db-password",
+					},
+				},
+			},
+			wanted: []SecretRef{
+				{Path: "secrets.DB_PASSWORD", ValueFrom: "db-password"},
+			},
+		},
+		"returns nil for a manifest without secrets": {
+			in: &BackendService{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, SecretRefs(tc.in))
+		})
+	}
+}
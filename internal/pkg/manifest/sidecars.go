@@ -0,0 +1,38 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+)
+
+// SidecarBuilds returns the images of the sidecars that are built from a local Dockerfile, keyed by the name of the sidecar.
+func SidecarBuilds(mft interface{}) (map[string]SidecarImage, error) {
+	var sidecars map[string]*SidecarConfig
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		sidecars = m.Sidecars
+	case *BackendService:
+		sidecars = m.Sidecars
+	case *WorkerService:
+		sidecars = m.Sidecars
+	case *ScheduledJob:
+		sidecars = m.Sidecars
+	}
+	builds := make(map[string]SidecarImage)
+	for name, sidecar := range sidecars {
+		if sidecar == nil {
+			continue
+		}
+		required, err := sidecar.Image.BuildRequired()
+		if err != nil {
+			return nil, fmt.Errorf("sidecar %s: %w", name, err)
+		}
+		if !required {
+			continue
+		}
+		builds[name] = sidecar.Image
+	}
+	return builds, nil
+}
@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSidecarBuilds(t *testing.T) {
+	testCases := map[string]struct {
+		in interface{}
+
+		wanted      map[string]SidecarImage
+		wantedError error
+	}{
+		"returns the build configuration of the sidecars built from a Dockerfile": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Image: SidecarImage{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("proxy/Dockerfile"),
+								},
+							},
+						},
+						"xray": {
+							Image: SidecarImage{
+								Location: aws.String("public.ecr.aws/xray/aws-xray-daemon"),
+							},
+						},
+						"empty": nil,
+					},
+				},
+			},
+			wanted: map[string]SidecarImage{
+				"nginx": {
+					Build: BuildArgsOrString{
+						BuildString: aws.String("proxy/Dockerfile"),
+					},
+				},
+			},
+		},
+		"returns the sidecars of a scheduled job": {
+			in: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					Sidecars: map[string]*SidecarConfig{
+						"agent": {
+							Image: SidecarImage{
+								Build: BuildArgsOrString{
+									BuildArgs: DockerBuildArgs{
+										Context: aws.String("agent"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: map[string]SidecarImage{
+				"agent": {
+					Build: BuildArgsOrString{
+						BuildArgs: DockerBuildArgs{
+							Context: aws.String("agent"),
+						},
+					},
+				},
+			},
+		},
+		"errors if both the build and location of a sidecar image are specified": {
+			in: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Image: SidecarImage{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("proxy/Dockerfile"),
+								},
+								Location: aws.String("nginx"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`sidecar nginx: cannot specify both "build" and "location" for a sidecar image`),
+		},
+		"returns no builds for request-driven web services": {
+			in:     &RequestDrivenWebService{},
+			wanted: map[string]SidecarImage{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SidecarBuilds(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
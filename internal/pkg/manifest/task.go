@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	taskManifestPath = "task/manifest.yml"
+)
+
+// Task holds the configuration of a one-off task run with `copilot task run --task-manifest`.
+type Task struct {
+	Name          *string `yaml:"name"`
+	ImageConfig   Image   `yaml:"image,flow"`
+	ImageOverride `yaml:",inline"`
+	CPU           *int              `yaml:"cpu"`
+	Memory        *int              `yaml:"memory"`
+	Count         *int              `yaml:"count"`
+	Platform      *string           `yaml:"platform"`
+	TaskRole      *string           `yaml:"task_role"`
+	ExecutionRole *string           `yaml:"execution_role"`
+	Variables     map[string]string `yaml:"variables"`
+	Secrets       map[string]string `yaml:"secrets"`
+	Network       *NetworkConfig    `yaml:"network"`
+	Storage       *Storage          `yaml:"storage"`
+	Tags          map[string]string `yaml:"tags"`
+
+	parser template.Parser
+}
+
+// TaskProps contains properties for creating a new task manifest.
+type TaskProps struct {
+	Name       string
+	Dockerfile string
+	Image      string
+	Platform   string
+}
+
+// newDefaultTask returns an empty Task with only the default values set.
+func newDefaultTask() *Task {
+	return &Task{
+		CPU:    aws.Int(256),
+		Memory: aws.Int(512),
+		Count:  aws.Int(1),
+		Network: &NetworkConfig{
+			VPC: &vpcConfig{
+				Placement: stringP(PublicSubnetPlacement),
+			},
+		},
+	}
+}
+
+// NewTask creates a new task manifest object.
+func NewTask(props *TaskProps) *Task {
+	t := newDefaultTask()
+	t.Name = stringP(props.Name)
+	t.ImageConfig.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	t.ImageConfig.Location = stringP(props.Image)
+	t.Platform = stringP(props.Platform)
+	t.parser = template.New()
+	return t
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (t *Task) MarshalBinary() ([]byte, error) {
+	content, err := t.parser.Parse(taskManifestPath, *t)
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// BuildArgs returns a docker.BuildArguments object for the task given a workspace root.
+func (t *Task) BuildArgs(wsRoot string) *DockerBuildArgs {
+	return t.ImageConfig.BuildConfig(wsRoot)
+}
+
+// BuildRequired returns if the task requires building from the local Dockerfile.
+func (t *Task) BuildRequired() (bool, error) {
+	return requiresBuild(t.ImageConfig)
+}
+
+// UnmarshalTask deserializes the YAML input stream into a task manifest object.
+// It returns an error if the YAML input contains invalid fields.
+func UnmarshalTask(in []byte) (*Task, error) {
+	t := newDefaultTask()
+	if err := yaml.Unmarshal(in, t); err != nil {
+		return nil, fmt.Errorf("unmarshal to task manifest: %w", err)
+	}
+	if t.Platform != nil {
+		if parts := strings.Split(aws.StringValue(t.Platform), "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf(`field "platform" is %q but must be of the form "<os>/<arch>"`, aws.StringValue(t.Platform))
+		}
+	}
+	return t, nil
+}
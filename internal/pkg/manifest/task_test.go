@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTask(t *testing.T) {
+	task := NewTask(&TaskProps{
+		Name:       "db-migrate",
+		Dockerfile: "migrations/Dockerfile",
+		Platform:   "linux/arm64",
+	})
+
+	require.Equal(t, "db-migrate", aws.StringValue(task.Name))
+	require.Equal(t, "migrations/Dockerfile", aws.StringValue(task.ImageConfig.Build.BuildArgs.Dockerfile))
+	require.Nil(t, task.ImageConfig.Location)
+	require.Equal(t, "linux/arm64", aws.StringValue(task.Platform))
+	require.Equal(t, 256, aws.IntValue(task.CPU))
+	require.Equal(t, 512, aws.IntValue(task.Memory))
+	require.Equal(t, 1, aws.IntValue(task.Count))
+	require.Equal(t, PublicSubnetPlacement, aws.StringValue(task.Network.VPC.Placement))
+}
+
+func TestUnmarshalTask(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedTask  *Task
+		wantedError error
+	}{
+		"sets the default values": {
+			inContent: `
+name: db-migrate
+image:
+  location: flyway/flyway
+`,
+			wantedTask: &Task{
+				Name: aws.String("db-migrate"),
+				ImageConfig: Image{
+					Location: aws.String("flyway/flyway"),
+				},
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+				Count:  aws.Int(1),
+				Network: &NetworkConfig{
+					VPC: &vpcConfig{
+						Placement: aws.String(PublicSubnetPlacement),
+					},
+				},
+			},
+		},
+		"overrides the default values": {
+			inContent: `
+name: db-migrate
+image:
+  build:
+    dockerfile: migrations/Dockerfile
+    args:
+      GOPROXY: direct
+platform: linux/arm64
+cpu: 1024
+memory: 2048
+command: ["python", "migrate.py"]
+variables:
+  LOG_LEVEL: debug
+secrets:
+  DB_PASSWORD: /copilot/db/password
+network:
+  vpc:
+    placement: private
+    security_groups: ["sg-123"]
+storage:
+  ephemeral: 50
+  volumes:
+    data:
+      path: /var/data
+      read_only: true
+      efs:
+        id: fs-1234
+task_role: migrate-role
+`,
+			wantedTask: &Task{
+				Name: aws.String("db-migrate"),
+				ImageConfig: Image{
+					Build: BuildArgsOrString{
+						BuildArgs: DockerBuildArgs{
+							Dockerfile: aws.String("migrations/Dockerfile"),
+							Args: map[string]string{
+								"GOPROXY": "direct",
+							},
+						},
+					},
+				},
+				ImageOverride: ImageOverride{
+					Command: &CommandOverride{
+						StringSlice: []string{"python", "migrate.py"},
+					},
+				},
+				CPU:      aws.Int(1024),
+				Memory:   aws.Int(2048),
+				Count:    aws.Int(1),
+				Platform: aws.String("linux/arm64"),
+				TaskRole: aws.String("migrate-role"),
+				Variables: map[string]string{
+					"LOG_LEVEL": "debug",
+				},
+				Secrets: map[string]string{
+					"DB_PASSWORD": "/copilot/db/password",
+				},
+				Network: &NetworkConfig{
+					VPC: &vpcConfig{
+						Placement:      aws.String(PrivateSubnetPlacement),
+						SecurityGroups: []string{"sg-123"},
+					},
+				},
+				Storage: &Storage{
+					Ephemeral: aws.Int(50),
+					Volumes: map[string]Volume{
+						"data": {
+							EFS: &EFSConfigOrBool{
+								Advanced: EFSVolumeConfiguration{
+									FileSystemID: aws.String("fs-1234"),
+								},
+							},
+							MountPointOpts: MountPointOpts{
+								ContainerPath: aws.String("/var/data"),
+								ReadOnly:      aws.Bool(true),
+							},
+						},
+					},
+				},
+			},
+		},
+		"errors if the platform is not of the form os/arch": {
+			inContent: `
+name: db-migrate
+platform: arm64
+`,
+			wantedError: errors.New(`field "platform" is "arm64" but must be of the form "<os>/<arch>"`),
+		},
+		"errors if the placement is invalid": {
+			inContent: `
+name: db-migrate
+network:
+  vpc:
+    placement: dmz
+`,
+			wantedError: errors.New(`unmarshal to task manifest: field 'network.vpc.placement' is 'dmz' must be one of []string{"public", "private"}`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			task, err := UnmarshalTask([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTask, task)
+		})
+	}
+}
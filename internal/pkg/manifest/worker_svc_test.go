@@ -249,7 +249,7 @@ func TestWorkerSvc_ApplyEnv(t *testing.T) {
 			Sidecars: map[string]*SidecarConfig{
 				"xray": {
 					Port:  aws.String("2000/udp"),
-					Image: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+					Image: SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 				},
 			},
 			Logging: &Logging{
@@ -472,7 +472,7 @@ func TestWorkerSvc_ApplyEnv(t *testing.T) {
 					Sidecars: map[string]*SidecarConfig{
 						"xray": {
 							Port:       aws.String("2000/udp"),
-							Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+							Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 							CredsParam: aws.String("some arn"),
 						},
 					},
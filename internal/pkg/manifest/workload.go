@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/imdario/mergo"
@@ -42,8 +43,10 @@ var (
 	errUnmarshalCountOpts  = errors.New(`cannot unmarshal "count" field to an integer or autoscaling configuration`)
 	errUnmarshalRangeOpts  = errors.New(`cannot unmarshal "range" field`)
 	errUnmarshalExec       = errors.New("cannot unmarshal exec field into boolean or exec configuration")
+	errUnmarshalMesh       = errors.New(`cannot unmarshal "network.connect.mesh" field into boolean or mesh configuration`)
 	errUnmarshalEntryPoint = errors.New("cannot unmarshal entrypoint into string or slice of strings")
 	errUnmarshalCommand    = errors.New("cannot unmarshal command into string or slice of strings")
+	errUnmarshalSidecarImg = errors.New("cannot unmarshal sidecar image into string or image configuration")
 
 	errInvalidRangeOpts     = errors.New(`cannot specify both "range" and "min"/"max"`)
 	errInvalidAdvancedCount = errors.New(`cannot specify both "spot" and autoscaling fields`)
@@ -86,6 +89,9 @@ func (t workloadTransformer) Transformer(typ reflect.Type) func(dst, src reflect
 	if typ == reflect.TypeOf(Image{}) {
 		return transformImage()
 	}
+	if typ == reflect.TypeOf(SidecarImage{}) {
+		return transformSidecarImage()
+	}
 	return nil
 }
 
@@ -115,6 +121,16 @@ func transformImage() func(dst, src reflect.Value) error {
 	}
 }
 
+func transformSidecarImage() func(dst, src reflect.Value) error {
+	return func(dst, src reflect.Value) error {
+		// Override both `Build` and `Location` if either of them is specified in src.
+		if !src.IsZero() {
+			dst.Set(src)
+		}
+		return nil
+	}
+}
+
 // ImageWithHealthcheck represents a container image with health check.
 type ImageWithHealthcheck struct {
 	Image       `yaml:",inline"`
@@ -168,6 +184,11 @@ func (i *Image) BuildConfig(rootDirectory string) *DockerBuildArgs {
 		Args:       i.args(),
 		Target:     i.target(),
 		CacheFrom:  i.cacheFrom(),
+		Secrets:    i.secrets(rootDirectory),
+		SSH:        i.ssh(rootDirectory),
+		CacheTo:    i.Build.BuildArgs.CacheTo,
+		Labels:     i.Build.BuildArgs.Labels,
+		Network:    i.Build.BuildArgs.Network,
 	}
 }
 
@@ -210,6 +231,46 @@ func (i *Image) cacheFrom() []string {
 	return i.Build.BuildArgs.CacheFrom
 }
 
+// secrets returns the build secrets section, if it exists, with the relative file paths
+// resolved from the root directory. Otherwise it returns nil.
+func (i *Image) secrets(rootDirectory string) map[string]BuildSecret {
+	if i.Build.BuildArgs.Secrets == nil {
+		return nil
+	}
+	secrets := make(map[string]BuildSecret, len(i.Build.BuildArgs.Secrets))
+	for id, secret := range i.Build.BuildArgs.Secrets {
+		if file := aws.StringValue(secret.File); file != "" && !filepath.IsAbs(file) && !strings.HasPrefix(file, "~") {
+			secret.File = aws.String(filepath.Join(rootDirectory, file))
+		}
+		secrets[id] = secret
+	}
+	return secrets
+}
+
+// ssh returns the build ssh section, if it exists, with the relative key paths
+// resolved from the root directory. Otherwise it returns nil.
+func (i *Image) ssh(rootDirectory string) []string {
+	if i.Build.BuildArgs.SSH == nil {
+		return nil
+	}
+	ssh := make([]string, len(i.Build.BuildArgs.SSH))
+	for idx, entry := range i.Build.BuildArgs.SSH {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 1 || parts[1] == "" {
+			ssh[idx] = entry // Forwards the SSH agent.
+			continue
+		}
+		paths := strings.Split(parts[1], ",")
+		for j, path := range paths {
+			if !filepath.IsAbs(path) && !strings.HasPrefix(path, "~") {
+				paths[j] = filepath.Join(rootDirectory, path)
+			}
+		}
+		ssh[idx] = fmt.Sprintf("%s=%s", parts[0], strings.Join(paths, ","))
+	}
+	return ssh
+}
+
 // ImageOverride holds fields that override Dockerfile image defaults.
 type ImageOverride struct {
 	EntryPoint *EntryPointOverride `yaml:"entrypoint"` // TODO: the type needs to be updated after we upgrade mergo
@@ -346,20 +407,33 @@ func (b *BuildArgsOrString) UnmarshalYAML(unmarshal func(interface{}) error) err
 // of Docker Compose services. For more information, see:
 // https://docs.docker.com/compose/compose-file/#build
 type DockerBuildArgs struct {
-	Context    *string           `yaml:"context,omitempty"`
-	Dockerfile *string           `yaml:"dockerfile,omitempty"`
-	Args       map[string]string `yaml:"args,omitempty"`
-	Target     *string           `yaml:"target,omitempty"`
-	CacheFrom  []string          `yaml:"cache_from,omitempty"`
+	Context    *string                `yaml:"context,omitempty"`
+	Dockerfile *string                `yaml:"dockerfile,omitempty"`
+	Args       map[string]string      `yaml:"args,omitempty"`
+	Target     *string                `yaml:"target,omitempty"`
+	CacheFrom  []string               `yaml:"cache_from,omitempty"`
+	Secrets    map[string]BuildSecret `yaml:"secrets,omitempty"`
+	SSH        []string               `yaml:"ssh,omitempty"`
+	CacheTo    []string               `yaml:"cache_to,omitempty"`
+	Labels     map[string]string      `yaml:"labels,omitempty"`
+	Network    *string                `yaml:"network,omitempty"`
 }
 
 func (b *DockerBuildArgs) isEmpty() bool {
-	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil {
+	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil &&
+		b.Secrets == nil && b.SSH == nil && b.CacheTo == nil && b.Labels == nil && b.Network == nil {
 		return true
 	}
 	return false
 }
 
+// BuildSecret represents a secret exposed to the RUN instructions of a build.
+// The value of the secret is read either from a file or from an environment variable.
+type BuildSecret struct {
+	File *string `yaml:"file,omitempty"`
+	Env  *string `yaml:"env,omitempty"`
+}
+
 // ExecuteCommand is a custom type which supports unmarshaling yaml which
 // can either be of type bool or type ExecuteCommandConfig.
 type ExecuteCommand struct {
@@ -427,10 +501,15 @@ func (lc *Logging) GetEnableMetadata() *string {
 	return aws.String(strconv.FormatBool(*lc.EnableMetadata))
 }
 
+// Observability represents the configurable options for collecting telemetry from a workload.
+type Observability struct {
+	Tracing *string `yaml:"tracing"` // The tracing vendor, for example "awsxray".
+}
+
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
 	Port         *string             `yaml:"port"`
-	Image        *string             `yaml:"image"`
+	Image        SidecarImage        `yaml:"image"`
 	Essential    *bool               `yaml:"essential"`
 	CredsParam   *string             `yaml:"credentialsParameter"`
 	Variables    map[string]string   `yaml:"variables"`
@@ -441,6 +520,62 @@ type SidecarConfig struct {
 	ImageOverride `yaml:",inline"`
 }
 
+// SidecarImage represents the container image of a sidecar.
+// It can either be the location of an existing image or a Dockerfile to build the image from.
+type SidecarImage struct {
+	Build    BuildArgsOrString `yaml:"build"`    // Build an image from a Dockerfile.
+	Location *string           `yaml:"location"` // Use an existing image instead.
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the SidecarImage
+// struct, allowing the image to be specified as a string or as a map with "build" or "location".
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (s *SidecarImage) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type sidecarImage SidecarImage // Alias to avoid calling UnmarshalYAML recursively.
+	var img sidecarImage
+	if err := unmarshal(&img); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !img.Build.isEmpty() || img.Location != nil {
+		// Unmarshaled successfully to the image configuration.
+		*s = SidecarImage(img)
+		return nil
+	}
+
+	s.Build = BuildArgsOrString{}
+	if err := unmarshal(&s.Location); err != nil {
+		return errUnmarshalSidecarImg
+	}
+	return nil
+}
+
+// GetLocation returns the location of the sidecar image.
+func (s SidecarImage) GetLocation() string {
+	return aws.StringValue(s.Location)
+}
+
+// BuildRequired returns if the sidecar image needs to be built from a local Dockerfile.
+func (s SidecarImage) BuildRequired() (bool, error) {
+	noBuild, noURL := s.Build.isEmpty(), s.Location == nil
+	if !noBuild && !noURL {
+		return false, fmt.Errorf(`cannot specify both "build" and "location" for a sidecar image`)
+	}
+	return !noBuild, nil
+}
+
+// BuildConfig populates a DockerBuildArgs struct from the build configuration of the sidecar image.
+// It follows the same hierarchy as the image of the main container.
+func (s SidecarImage) BuildConfig(rootDirectory string) *DockerBuildArgs {
+	img := Image{Build: s.Build}
+	return img.BuildConfig(rootDirectory)
+}
+
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
 	CPU            *int              `yaml:"cpu"`
@@ -465,7 +600,64 @@ type Topic struct {
 
 // NetworkConfig represents options for network connection to AWS resources within a VPC.
 type NetworkConfig struct {
-	VPC *vpcConfig `yaml:"vpc"`
+	VPC     *vpcConfig     `yaml:"vpc"`
+	Connect *ConnectConfig `yaml:"connect"`
+}
+
+// ConnectConfig represents the services that a workload is allowed to call.
+// A service with a connect configuration only accepts traffic from its load balancer and from its allowed callers.
+type ConnectConfig struct {
+	Services []string    `yaml:"services"`
+	Mesh     ServiceMesh `yaml:"mesh"`
+}
+
+// ServiceMesh is a custom type which supports unmarshaling yaml which
+// can either be of type bool or type ServiceMeshConfig.
+type ServiceMesh struct {
+	Enable *bool
+	Config ServiceMeshConfig
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the ServiceMesh
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (m *ServiceMesh) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&m.Config); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !m.Config.IsEmpty() {
+		return nil
+	}
+
+	if err := unmarshal(&m.Enable); err != nil {
+		return errUnmarshalMesh
+	}
+	return nil
+}
+
+// IsEnabled returns true if the workload's traffic should be routed through the environment's service mesh.
+func (m ServiceMesh) IsEnabled() bool {
+	if !m.Config.IsEmpty() {
+		return true
+	}
+	return aws.BoolValue(m.Enable)
+}
+
+// ServiceMeshConfig represents the retry and timeout policies of the calls routed through the service mesh.
+type ServiceMeshConfig struct {
+	Retries *int           `yaml:"retries"`
+	Timeout *time.Duration `yaml:"timeout"`
+}
+
+// IsEmpty returns whether ServiceMeshConfig is empty.
+func (m ServiceMeshConfig) IsEmpty() bool {
+	return m.Retries == nil && m.Timeout == nil
 }
 
 // PlatformConfig represents operating system and architecture specifications.
@@ -5,8 +5,10 @@ package manifest
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -255,6 +257,35 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				BuildString: nil,
 			},
 		},
+		"Dockerfile with BuildKit build opts": {
+			inContent: []byte(`build:
+  secrets:
+    npmrc:
+      file: ~/.npmrc
+    github_token:
+      env: GITHUB_TOKEN
+  ssh:
+    - default
+  cache_to:
+    - type=registry,mode=max
+  labels:
+    team: payments
+  network: host`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Secrets: map[string]BuildSecret{
+						"npmrc":        {File: aws.String("~/.npmrc")},
+						"github_token": {Env: aws.String("GITHUB_TOKEN")},
+					},
+					SSH:     []string{"default"},
+					CacheTo: []string{"type=registry,mode=max"},
+					Labels: map[string]string{
+						"team": "payments",
+					},
+					Network: aws.String("host"),
+				},
+			},
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`build:
   badfield: OH NOES
@@ -281,11 +312,111 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.BuildArgs.Args, b.Build.BuildArgs.Args)
 				require.Equal(t, tc.wantedStruct.BuildArgs.Target, b.Build.BuildArgs.Target)
 				require.Equal(t, tc.wantedStruct.BuildArgs.CacheFrom, b.Build.BuildArgs.CacheFrom)
+				require.Equal(t, tc.wantedStruct.BuildArgs.Secrets, b.Build.BuildArgs.Secrets)
+				require.Equal(t, tc.wantedStruct.BuildArgs.SSH, b.Build.BuildArgs.SSH)
+				require.Equal(t, tc.wantedStruct.BuildArgs.CacheTo, b.Build.BuildArgs.CacheTo)
+				require.Equal(t, tc.wantedStruct.BuildArgs.Labels, b.Build.BuildArgs.Labels)
+				require.Equal(t, tc.wantedStruct.BuildArgs.Network, b.Build.BuildArgs.Network)
+			}
+		})
+	}
+}
+
+func TestSidecarImage_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct SidecarImage
+		wantedError  error
+	}{
+		"image location as a string": {
+			inContent: []byte(`image: public.ecr.aws/nginx/nginx:latest`),
+
+			wantedStruct: SidecarImage{
+				Location: aws.String("public.ecr.aws/nginx/nginx:latest"),
+			},
+		},
+		"image location in a map": {
+			inContent: []byte(`image:
+  location: public.ecr.aws/nginx/nginx:latest`),
+
+			wantedStruct: SidecarImage{
+				Location: aws.String("public.ecr.aws/nginx/nginx:latest"),
+			},
+		},
+		"build from a Dockerfile": {
+			inContent: []byte(`image:
+  build: proxy/Dockerfile`),
+
+			wantedStruct: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("proxy/Dockerfile"),
+				},
+			},
+		},
+		"build with build opts": {
+			inContent: []byte(`image:
+  build:
+    dockerfile: proxy/Dockerfile
+    context: proxy
+    args:
+      VERSION: "1.21"`),
+
+			wantedStruct: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("proxy/Dockerfile"),
+						Context:    aws.String("proxy"),
+						Args: map[string]string{
+							"VERSION": "1.21",
+						},
+					},
+				},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`image:
+  - nginx`),
+			wantedError: errUnmarshalSidecarImg,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var sidecar SidecarConfig
+			err := yaml.Unmarshal(tc.inContent, &sidecar)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct, sidecar.Image)
 		})
 	}
 }
 
+func TestSidecarImage_BuildConfig(t *testing.T) {
+	img := SidecarImage{
+		Build: BuildArgsOrString{
+			BuildArgs: DockerBuildArgs{
+				Dockerfile: aws.String("proxy/Dockerfile"),
+				Args: map[string]string{
+					"VERSION": "1.21",
+				},
+			},
+		},
+	}
+
+	got := img.BuildConfig("/root/dir")
+
+	require.Equal(t, &DockerBuildArgs{
+		Dockerfile: aws.String(filepath.Join("/root/dir", "proxy", "Dockerfile")),
+		Context:    aws.String(filepath.Join("/root/dir", "proxy")),
+		Args: map[string]string{
+			"VERSION": "1.21",
+		},
+	}, got)
+}
+
 func TestExec_UnmarshalYAML(t *testing.T) {
 	testCases := map[string]struct {
 		inContent []byte
@@ -352,6 +483,55 @@ count: 1`),
 	}
 }
 
+func TestServiceMesh_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct ServiceMesh
+		wantedError  error
+	}{
+		"mesh not specified": {
+			inContent: []byte(`services: [api]`),
+		},
+		"simple enable": {
+			inContent: []byte(`mesh: true`),
+
+			wantedStruct: ServiceMesh{
+				Enable: aws.Bool(true),
+			},
+		},
+		"with config": {
+			inContent: []byte(`mesh:
+  retries: 3
+  timeout: 5s`),
+
+			wantedStruct: ServiceMesh{
+				Config: ServiceMeshConfig{
+					Retries: aws.Int(3),
+					Timeout: durationp(5 * time.Second),
+				},
+			},
+		},
+		"Error if unmarshalable": {
+			inContent: []byte(`mesh:
+  badfield: OH NOES`),
+			wantedError: errUnmarshalMesh,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var c ConnectConfig
+			err := yaml.Unmarshal(tc.inContent, &c)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, c.Mesh)
+			}
+		})
+	}
+}
+
 func TestBuildConfig(t *testing.T) {
 	mockWsRoot := "/root/dir"
 	testCases := map[string]struct {
@@ -445,6 +625,50 @@ func TestBuildConfig(t *testing.T) {
 				},
 			},
 		},
+		"resolves the relative paths of secret files": {
+			inBuild: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Secrets: map[string]BuildSecret{
+						"npmrc":   {File: aws.String(".npmrc")},
+						"netrc":   {File: aws.String("~/.netrc")},
+						"license": {File: aws.String("/etc/license")},
+						"token":   {Env: aws.String("TOKEN")},
+					},
+					Network: aws.String("none"),
+				},
+			},
+			wantedBuild: DockerBuildArgs{
+				Dockerfile: aws.String(filepath.Join(mockWsRoot, "Dockerfile")),
+				Context:    aws.String(mockWsRoot),
+				Secrets: map[string]BuildSecret{
+					"npmrc":   {File: aws.String(filepath.Join(mockWsRoot, ".npmrc"))},
+					"netrc":   {File: aws.String("~/.netrc")},
+					"license": {File: aws.String("/etc/license")},
+					"token":   {Env: aws.String("TOKEN")},
+				},
+				Network: aws.String("none"),
+			},
+		},
+		"resolves the relative paths of ssh keys": {
+			inBuild: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					SSH: []string{
+						"default",
+						"github=keys/id_rsa,~/.ssh/id_ed25519",
+						"gitlab=/etc/ssh/id_rsa",
+					},
+				},
+			},
+			wantedBuild: DockerBuildArgs{
+				Dockerfile: aws.String(filepath.Join(mockWsRoot, "Dockerfile")),
+				Context:    aws.String(mockWsRoot),
+				SSH: []string{
+					"default",
+					fmt.Sprintf("github=%s,~/.ssh/id_ed25519", filepath.Join(mockWsRoot, "keys/id_rsa")),
+					"gitlab=/etc/ssh/id_rsa",
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,458 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/docker/docker/builder/dockerignore"
+	"github.com/docker/docker/pkg/fileutils"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	codeBuildImage       = "aws/codebuild/standard:5.0"
+	codeBuildComputeType = "BUILD_GENERAL1_MEDIUM"
+
+	defaultPollInterval = 5 * time.Second
+
+	digestVariable    = "IMAGE_DIGEST"
+	remoteDockerfile  = ".copilot.Dockerfile" // Name of the Dockerfile in the archive when it's outside of the build context.
+	gitDirectory      = ".git"
+	dockerignoreFile  = ".dockerignore"
+	maxProjectNameLen = 255
+)
+
+var safeShellArg = regexp.MustCompile(`^[A-Za-z0-9_./:=@,+-]+$`)
+
+type projectBuilder interface {
+	CreateProject(in *codebuild.CreateProjectInput) error
+	DeleteProject(name string) error
+	StartBuild(project string) (string, error)
+	Build(id string) (*codebuild.Build, error)
+}
+
+type artifactStore interface {
+	PutArtifact(bucket, fileName string, data io.Reader) (string, error)
+	DeleteObject(bucket, key string) error
+}
+
+type logEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// CodeBuild builds and pushes images with an ephemeral AWS CodeBuild project in the account of an environment
+// instead of a local docker daemon.
+type CodeBuild struct {
+	app     string
+	env     string
+	bucket  string
+	roleARN string
+
+	builds   projectBuilder
+	uploader artifactStore
+	logs     logEventsGetter
+	out      io.Writer
+
+	// Override in unit tests.
+	now          func() time.Time
+	pollInterval time.Duration
+
+	// Set after the image is built.
+	digest string
+}
+
+// CodeBuildConfig holds the fields required to build images with CodeBuild.
+type CodeBuildConfig struct {
+	App            string
+	Env            string
+	EnvSession     *session.Session // Session of the environment manager role, used to run the builds.
+	ArtifactBucket string           // Bucket in the region of the environment that holds the build context.
+	BucketSession  *session.Session // Session that can upload to the artifact bucket.
+	RoleARN        string           // Service role of the builds.
+	Out            io.Writer        // Writer for the logs of the builds.
+}
+
+// NewCodeBuild returns a CodeBuild that builds images in the environment.
+func NewCodeBuild(cfg CodeBuildConfig) *CodeBuild {
+	return &CodeBuild{
+		app:          cfg.App,
+		env:          cfg.Env,
+		bucket:       cfg.ArtifactBucket,
+		roleARN:      cfg.RoleARN,
+		builds:       codebuild.New(cfg.EnvSession),
+		uploader:     s3.New(cfg.BucketSession),
+		logs:         cloudwatchlogs.New(cfg.EnvSession),
+		out:          cfg.Out,
+		now:          time.Now,
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Build uploads the build context to the artifact bucket, then builds and pushes the image with an ephemeral CodeBuild project.
+// The logs of the build are streamed while it runs, and the project and the uploaded build context are deleted once it completes.
+func (cb *CodeBuild) Build(args *exec.BuildArguments) error {
+	if err := validateRemoteBuild(args); err != nil {
+		return err
+	}
+	project := cb.projectName(args.URI)
+	bucket, key, dockerfile, err := cb.uploadContext(project, args)
+	if err != nil {
+		return err
+	}
+	defer cb.uploader.DeleteObject(bucket, key)
+	spec, err := buildspec(args, dockerfile)
+	if err != nil {
+		return err
+	}
+	if err := cb.builds.CreateProject(&codebuild.CreateProjectInput{
+		Name:           project,
+		ServiceRoleARN: cb.roleARN,
+		SourceLocation: path.Join(bucket, key),
+		Buildspec:      spec,
+		Image:          codeBuildImage,
+		ComputeType:    codeBuildComputeType,
+		Privileged:     true,
+		Tags: map[string]string{
+			deploy.AppTagKey: cb.app,
+			deploy.EnvTagKey: cb.env,
+		},
+	}); err != nil {
+		return err
+	}
+	defer cb.builds.DeleteProject(project)
+
+	id, err := cb.builds.StartBuild(project)
+	if err != nil {
+		return err
+	}
+	build, err := cb.wait(id)
+	if err != nil {
+		return err
+	}
+	if build.Status != codebuild.BuildStatusSucceeded {
+		return fmt.Errorf("build %s finished with status %s in phase %s", id, build.Status, build.Phase)
+	}
+	digest := build.ExportedVariables[digestVariable]
+	if digest == "" {
+		return fmt.Errorf("build %s did not export the digest of the image", id)
+	}
+	cb.digest = digest
+	return nil
+}
+
+// Login is a no-op since the build logs in to the repository.
+func (cb *CodeBuild) Login(uri, username, password string) error {
+	return nil
+}
+
+// Push returns the digest of the image pushed by the build.
+func (cb *CodeBuild) Push(uri string, tags ...string) (string, error) {
+	if cb.digest == "" {
+		return "", errors.New("the image must be built before it's pushed")
+	}
+	return cb.digest, nil
+}
+
+// IsEcrCredentialHelperEnabled returns true since the build doesn't need local credentials to push to the repository.
+func (cb *CodeBuild) IsEcrCredentialHelperEnabled(uri string) bool {
+	return true
+}
+
+func validateRemoteBuild(args *exec.BuildArguments) error {
+	var unsupported []string
+	if len(args.Secrets) != 0 {
+		unsupported = append(unsupported, "secrets")
+	}
+	if len(args.SSH) != 0 {
+		unsupported = append(unsupported, "ssh")
+	}
+	if len(args.CacheTo) != 0 {
+		unsupported = append(unsupported, "cache_to")
+	}
+	if len(unsupported) != 0 {
+		return fmt.Errorf("%s not supported when building images with CodeBuild", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// projectName returns a unique name for the project that builds the image of the repository, such as "app-env-api-1629975600".
+func (cb *CodeBuild) projectName(uri string) string {
+	repo := path.Base(uri)
+	if i := strings.Index(repo, ":"); i != -1 {
+		repo = repo[:i]
+	}
+	name := fmt.Sprintf("%s-%s-%s-%d", cb.app, cb.env, repo, cb.now().Unix())
+	if len(name) > maxProjectNameLen {
+		return name[:maxProjectNameLen]
+	}
+	return name
+}
+
+// uploadContext zips the build context, without the files excluded by its .dockerignore file, and uploads it to the artifact bucket.
+// It returns the bucket and key of the archive, and the path of the Dockerfile in the archive.
+func (cb *CodeBuild) uploadContext(project string, args *exec.BuildArguments) (bucket, key, dockerfile string, err error) {
+	contextDir := args.Context
+	if contextDir == "" {
+		contextDir = filepath.Dir(args.Dockerfile)
+	}
+	ignored, err := readDockerignore(contextDir)
+	if err != nil {
+		return "", "", "", err
+	}
+	dockerfile, err = filepath.Rel(contextDir, args.Dockerfile)
+	if err != nil || strings.HasPrefix(dockerfile, "..") {
+		// The Dockerfile is outside of the build context, add it to the root of the archive.
+		dockerfile = remoteDockerfile
+	}
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	if err := addDirToZip(w, contextDir, ignored); err != nil {
+		return "", "", "", fmt.Errorf("archive build context %s: %w", contextDir, err)
+	}
+	if excluded, _ := ignored.Matches(dockerfile); dockerfile == remoteDockerfile || excluded {
+		// The docker CLI always sends the Dockerfile to the daemon, even if it's excluded from the build context.
+		if err := addFileToZip(w, args.Dockerfile, dockerfile); err != nil {
+			return "", "", "", fmt.Errorf("archive Dockerfile %s: %w", args.Dockerfile, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", "", "", fmt.Errorf("archive build context %s: %w", contextDir, err)
+	}
+	url, err := cb.uploader.PutArtifact(cb.bucket, project+".zip", buf)
+	if err != nil {
+		return "", "", "", fmt.Errorf("upload build context: %w", err)
+	}
+	bucket, key, err = s3.ParseURL(url)
+	if err != nil {
+		return "", "", "", err
+	}
+	return bucket, key, filepath.ToSlash(dockerfile), nil
+}
+
+// readDockerignore returns the patterns of the .dockerignore file at the root of the build context.
+func readDockerignore(contextDir string) (*fileutils.PatternMatcher, error) {
+	var patterns []string
+	f, err := os.Open(filepath.Join(contextDir, dockerignoreFile))
+	switch {
+	case err == nil:
+		defer f.Close()
+		patterns, err = dockerignore.ReadAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("read %s in build context %s: %w", dockerignoreFile, contextDir, err)
+		}
+	case !os.IsNotExist(err):
+		return nil, fmt.Errorf("open %s in build context %s: %w", dockerignoreFile, contextDir, err)
+	}
+	ignored, err := fileutils.NewPatternMatcher(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s in build context %s: %w", dockerignoreFile, contextDir, err)
+	}
+	return ignored, nil
+}
+
+func addDirToZip(w *zip.Writer, dir string, ignored *fileutils.PatternMatcher) error {
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+		excluded, err := ignored.Matches(name)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == gitDirectory {
+				return filepath.SkipDir
+			}
+			if excluded && !ignored.Exclusions() {
+				// Files under the directory can only be included back with "!" patterns.
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if excluded || !info.Mode().IsRegular() {
+			return nil
+		}
+		return addFileToZip(w, p, filepath.ToSlash(name))
+	})
+}
+
+func addFileToZip(w *zip.Writer, src, name string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name // Preserve the path of the file in the archive along with its mode.
+	header.Method = zip.Deflate
+	f, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	content, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+	_, err = io.Copy(f, content)
+	return err
+}
+
+type buildspecPhase struct {
+	OnFailure string   `yaml:"on-failure,omitempty"`
+	Commands  []string `yaml:"commands"`
+}
+
+type buildspecConfig struct {
+	Version string `yaml:"version"`
+	Env     struct {
+		ExportedVariables []string `yaml:"exported-variables"`
+	} `yaml:"env"`
+	Phases struct {
+		PreBuild  buildspecPhase `yaml:"pre_build"`
+		Build     buildspecPhase `yaml:"build"`
+		PostBuild buildspecPhase `yaml:"post_build"`
+	} `yaml:"phases"`
+}
+
+// buildspec returns a buildspec that logs in to the repository, builds the image and pushes it with its tags.
+func buildspec(args *exec.BuildArguments, dockerfile string) (string, error) {
+	registry := strings.Split(args.URI, "/")[0]
+	var images []string
+	if !args.SkipLatestTag {
+		images = append(images, args.URI)
+	}
+	for _, tag := range args.Tags {
+		images = append(images, fmt.Sprintf("%s:%s", args.URI, tag))
+	}
+	if len(images) == 0 {
+		return "", errors.New("the image must have a tag if it is not tagged as latest")
+	}
+
+	build := []string{"build"}
+	for _, img := range images {
+		build = append(build, "-t", img)
+	}
+	for _, imageFrom := range args.CacheFrom {
+		build = append(build, "--cache-from", imageFrom)
+	}
+	if args.Target != "" {
+		build = append(build, "--target", args.Target)
+	}
+	for _, k := range sortedKeys(args.Args) {
+		build = append(build, "--build-arg", fmt.Sprintf("%s=%s", k, args.Args[k]))
+	}
+	for _, k := range sortedKeys(args.Labels) {
+		build = append(build, "--label", fmt.Sprintf("%s=%s", k, args.Labels[k]))
+	}
+	if args.Network != "" {
+		build = append(build, "--network", args.Network)
+	}
+	if args.Platform != "" {
+		build = append(build, "--platform", args.Platform)
+	}
+	build = append(build, ".", "-f", dockerfile)
+
+	spec := buildspecConfig{Version: "0.2"}
+	spec.Env.ExportedVariables = []string{digestVariable}
+	spec.Phases.PreBuild.Commands = []string{
+		fmt.Sprintf("aws ecr get-login-password --region $AWS_REGION | docker login --username AWS --password-stdin %s", quoteArgs(registry)),
+	}
+	spec.Phases.Build.OnFailure = "ABORT"
+	spec.Phases.Build.Commands = []string{"docker " + quoteArgs(build...)}
+	for _, img := range images {
+		spec.Phases.PostBuild.Commands = append(spec.Phases.PostBuild.Commands, "docker push "+quoteArgs(img))
+	}
+	spec.Phases.PostBuild.Commands = append(spec.Phases.PostBuild.Commands,
+		fmt.Sprintf(`export %s=$(docker inspect --format '{{index .RepoDigests 0}}' %s | cut -d@ -f2)`, digestVariable, quoteArgs(images[0])))
+
+	out, err := yaml.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("marshal buildspec: %w", err)
+	}
+	return string(out), nil
+}
+
+// wait polls the build until it completes and writes its logs as they're emitted.
+func (cb *CodeBuild) wait(id string) (*codebuild.Build, error) {
+	lastEventTime := make(map[string]int64)
+	for {
+		build, err := cb.builds.Build(id)
+		if err != nil {
+			return nil, err
+		}
+		if build.LogGroup != "" {
+			lastEventTime = cb.writeLogs(build, lastEventTime)
+		}
+		if build.Status != codebuild.BuildStatusInProgress {
+			return build, nil
+		}
+		time.Sleep(cb.pollInterval)
+	}
+}
+
+func (cb *CodeBuild) writeLogs(build *codebuild.Build, lastEventTime map[string]int64) map[string]int64 {
+	logs, err := cb.logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:            build.LogGroup,
+		LogStreams:          []string{build.LogStream},
+		StreamLastEventTime: lastEventTime,
+	})
+	if err != nil {
+		// The log group or stream may not exist until the build container starts.
+		return lastEventTime
+	}
+	for _, event := range logs.Events {
+		fmt.Fprint(cb.out, event.Message)
+	}
+	return logs.StreamLastEventTime
+}
+
+// quoteArgs joins the arguments of a shell command, quoting the ones that contain special characters.
+func quoteArgs(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if safeShellArg.MatchString(arg) {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'"'"'`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,266 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/repository/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type codeBuildMocks struct {
+	builds   *mocks.MockprojectBuilder
+	uploader *mocks.MockartifactStore
+	logs     *mocks.MocklogEventsGetter
+}
+
+func TestCodeBuild_Build(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "codebuild")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "api", ".git"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "api", "Dockerfile"), []byte("FROM nginx"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "api", "entrypoint.sh"), []byte("#!/bin/sh"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "api", ".git", "HEAD"), []byte("ref"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "web", "node_modules", "react"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "web", "Dockerfile"), []byte("FROM node"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "web", ".dockerignore"), []byte("Dockerfile\nnode_modules\n*.log\n!keep.log\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "web", "node_modules", "react", "index.js"), []byte("react"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "web", "debug.log"), []byte("debug"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "web", "keep.log"), []byte("keep"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "web", "index.js"), []byte("app"), 0644))
+
+	const (
+		mockURI     = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"
+		mockProject = "phonetool-test-api-1629975600"
+		mockBuildID = "phonetool-test-api-1629975600:1"
+	)
+	wantedBuildspec := `version: "0.2"
+env:
+    exported-variables:
+        - IMAGE_DIGEST
+phases:
+    pre_build:
+        commands:
+            - aws ecr get-login-password --region $AWS_REGION | docker login --username AWS --password-stdin 123456789012.dkr.ecr.us-west-2.amazonaws.com
+    build:
+        on-failure: ABORT
+        commands:
+            - docker build -t 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api -t 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:g123bfc --build-arg 'GREETING=hello world' . -f Dockerfile
+    post_build:
+        commands:
+            - docker push 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api
+            - docker push 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:g123bfc
+            - export IMAGE_DIGEST=$(docker inspect --format '{{index .RepoDigests 0}}' 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api | cut -d@ -f2)
+`
+	defaultArgs := exec.BuildArguments{
+		URI:        mockURI,
+		Dockerfile: filepath.Join(tmpDir, "api", "Dockerfile"),
+		Tags:       []string{"g123bfc"},
+		Args:       map[string]string{"GREETING": "hello world"},
+	}
+
+	testCases := map[string]struct {
+		in         exec.BuildArguments
+		setupMocks func(m codeBuildMocks)
+
+		wantedLogs   string
+		wantedDigest string
+		wantedError  error
+	}{
+		"should error if the build requires local secrets": {
+			in: exec.BuildArguments{
+				URI:     mockURI,
+				Secrets: []exec.BuildSecret{{ID: "npmrc", File: "~/.npmrc"}},
+				SSH:     []string{"default"},
+			},
+			setupMocks:  func(m codeBuildMocks) {},
+			wantedError: errors.New("secrets, ssh not supported when building images with CodeBuild"),
+		},
+		"should error if the build context cannot be uploaded": {
+			in: defaultArgs,
+			setupMocks: func(m codeBuildMocks) {
+				m.uploader.EXPECT().PutArtifact("mockBucket", mockProject+".zip", gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("upload build context: some error"),
+		},
+		"should push only the tags of the image if the latest tag is skipped": {
+			in: exec.BuildArguments{
+				URI:           mockURI,
+				Dockerfile:    filepath.Join(tmpDir, "api", "Dockerfile"),
+				Tags:          []string{"g123bfc"},
+				SkipLatestTag: true,
+			},
+			setupMocks: func(m codeBuildMocks) {
+				m.uploader.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Return("https://mockBucket.s3-us-west-2.amazonaws.com/manual/1629975600/"+mockProject+".zip", nil)
+				m.uploader.EXPECT().DeleteObject("mockBucket", "manual/1629975600/"+mockProject+".zip").Return(nil)
+				m.builds.EXPECT().CreateProject(gomock.Any()).DoAndReturn(func(in *codebuild.CreateProjectInput) error {
+					require.Contains(t, in.Buildspec, "docker build -t "+mockURI+":g123bfc . -f Dockerfile")
+					require.Contains(t, in.Buildspec, "- docker push "+mockURI+":g123bfc\n")
+					require.NotContains(t, in.Buildspec, "- docker push "+mockURI+"\n")
+					require.Contains(t, in.Buildspec, "{{index .RepoDigests 0}}' "+mockURI+":g123bfc")
+					return errors.New("some error")
+				})
+			},
+			wantedError: errors.New("some error"),
+		},
+		"should not upload the files excluded by the .dockerignore file": {
+			in: exec.BuildArguments{
+				URI:        mockURI,
+				Dockerfile: filepath.Join(tmpDir, "web", "Dockerfile"),
+			},
+			setupMocks: func(m codeBuildMocks) {
+				m.uploader.EXPECT().PutArtifact("mockBucket", mockProject+".zip", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						content, err := ioutil.ReadAll(data)
+						require.NoError(t, err)
+						r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+						require.NoError(t, err)
+						var names []string
+						for _, f := range r.File {
+							names = append(names, f.Name)
+						}
+						require.ElementsMatch(t, []string{".dockerignore", "index.js", "keep.log", "Dockerfile"}, names)
+						return "https://mockBucket.s3-us-west-2.amazonaws.com/manual/1629975600/" + mockProject + ".zip", nil
+					})
+				m.uploader.EXPECT().DeleteObject("mockBucket", "manual/1629975600/"+mockProject+".zip").Return(nil)
+				m.builds.EXPECT().CreateProject(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"should delete the project if the build fails": {
+			in: defaultArgs,
+			setupMocks: func(m codeBuildMocks) {
+				m.uploader.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Return("https://mockBucket.s3-us-west-2.amazonaws.com/manual/1629975600/"+mockProject+".zip", nil)
+				m.uploader.EXPECT().DeleteObject("mockBucket", "manual/1629975600/"+mockProject+".zip").Return(nil)
+				m.builds.EXPECT().CreateProject(gomock.Any()).Return(nil)
+				m.builds.EXPECT().StartBuild(mockProject).Return(mockBuildID, nil)
+				m.builds.EXPECT().Build(mockBuildID).Return(&codebuild.Build{
+					ID:     mockBuildID,
+					Status: "FAILED",
+					Phase:  "BUILD",
+				}, nil)
+				m.builds.EXPECT().DeleteProject(mockProject).Return(nil)
+			},
+			wantedError: errors.New("build phonetool-test-api-1629975600:1 finished with status FAILED in phase BUILD"),
+		},
+		"should stream the logs of the build and return the digest of the image": {
+			in: defaultArgs,
+			setupMocks: func(m codeBuildMocks) {
+				m.uploader.EXPECT().PutArtifact("mockBucket", mockProject+".zip", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						content, err := ioutil.ReadAll(data)
+						require.NoError(t, err)
+						r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+						require.NoError(t, err)
+						modes := make(map[string]os.FileMode)
+						for _, f := range r.File {
+							modes[f.Name] = f.Mode()
+						}
+						require.Equal(t, map[string]os.FileMode{
+							"Dockerfile":    0644,
+							"entrypoint.sh": 0755,
+						}, modes)
+						return "https://mockBucket.s3-us-west-2.amazonaws.com/manual/1629975600/" + mockProject + ".zip", nil
+					})
+				m.builds.EXPECT().CreateProject(&codebuild.CreateProjectInput{
+					Name:           mockProject,
+					ServiceRoleARN: "mockRoleARN",
+					SourceLocation: "mockBucket/manual/1629975600/" + mockProject + ".zip",
+					Buildspec:      wantedBuildspec,
+					Image:          "aws/codebuild/standard:5.0",
+					ComputeType:    "BUILD_GENERAL1_MEDIUM",
+					Privileged:     true,
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				}).Return(nil)
+				m.builds.EXPECT().StartBuild(mockProject).Return(mockBuildID, nil)
+				gomock.InOrder(
+					m.builds.EXPECT().Build(mockBuildID).Return(&codebuild.Build{
+						ID:     mockBuildID,
+						Status: "IN_PROGRESS",
+					}, nil),
+					m.builds.EXPECT().Build(mockBuildID).Return(&codebuild.Build{
+						ID:                mockBuildID,
+						Status:            "SUCCEEDED",
+						LogGroup:          "/aws/codebuild/" + mockProject,
+						LogStream:         "1",
+						ExportedVariables: map[string]string{"IMAGE_DIGEST": "sha256:abc"},
+					}, nil),
+				)
+				m.logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:            "/aws/codebuild/" + mockProject,
+					LogStreams:          []string{"1"},
+					StreamLastEventTime: map[string]int64{},
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{Message: "Step 1/1 : FROM nginx\n"},
+					},
+				}, nil)
+				m.builds.EXPECT().DeleteProject(mockProject).Return(nil)
+				m.uploader.EXPECT().DeleteObject("mockBucket", "manual/1629975600/"+mockProject+".zip").Return(nil)
+			},
+			wantedLogs:   "Step 1/1 : FROM nginx\n",
+			wantedDigest: "sha256:abc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := codeBuildMocks{
+				builds:   mocks.NewMockprojectBuilder(ctrl),
+				uploader: mocks.NewMockartifactStore(ctrl),
+				logs:     mocks.NewMocklogEventsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			out := new(bytes.Buffer)
+			cb := &CodeBuild{
+				app:      "phonetool",
+				env:      "test",
+				bucket:   "mockBucket",
+				roleARN:  "mockRoleARN",
+				builds:   m.builds,
+				uploader: m.uploader,
+				logs:     m.logs,
+				out:      out,
+				now: func() time.Time {
+					return time.Unix(1629975600, 0)
+				},
+			}
+
+			// WHEN
+			in := tc.in
+			err := cb.Build(&in)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLogs, out.String())
+			digest, err := cb.Push(mockURI, in.Tags...)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
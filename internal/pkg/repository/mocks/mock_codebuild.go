@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/repository/codebuild.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	io "io"
+	reflect "reflect"
+
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	codebuild "github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockprojectBuilder is a mock of projectBuilder interface.
+type MockprojectBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectBuilderMockRecorder
+}
+
+// MockprojectBuilderMockRecorder is the mock recorder for MockprojectBuilder.
+type MockprojectBuilderMockRecorder struct {
+	mock *MockprojectBuilder
+}
+
+// NewMockprojectBuilder creates a new mock instance.
+func NewMockprojectBuilder(ctrl *gomock.Controller) *MockprojectBuilder {
+	mock := &MockprojectBuilder{ctrl: ctrl}
+	mock.recorder = &MockprojectBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectBuilder) EXPECT() *MockprojectBuilderMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockprojectBuilder) Build(id string) (*codebuild.Build, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", id)
+	ret0, _ := ret[0].(*codebuild.Build)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockprojectBuilderMockRecorder) Build(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockprojectBuilder)(nil).Build), id)
+}
+
+// CreateProject mocks base method.
+func (m *MockprojectBuilder) CreateProject(in *codebuild.CreateProjectInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockprojectBuilderMockRecorder) CreateProject(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockprojectBuilder)(nil).CreateProject), in)
+}
+
+// DeleteProject mocks base method.
+func (m *MockprojectBuilder) DeleteProject(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockprojectBuilderMockRecorder) DeleteProject(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockprojectBuilder)(nil).DeleteProject), name)
+}
+
+// StartBuild mocks base method.
+func (m *MockprojectBuilder) StartBuild(project string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBuild", project)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBuild indicates an expected call of StartBuild.
+func (mr *MockprojectBuilderMockRecorder) StartBuild(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBuild", reflect.TypeOf((*MockprojectBuilder)(nil).StartBuild), project)
+}
+
+// MockartifactStore is a mock of artifactStore interface.
+type MockartifactStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactStoreMockRecorder
+}
+
+// MockartifactStoreMockRecorder is the mock recorder for MockartifactStore.
+type MockartifactStoreMockRecorder struct {
+	mock *MockartifactStore
+}
+
+// NewMockartifactStore creates a new mock instance.
+func NewMockartifactStore(ctrl *gomock.Controller) *MockartifactStore {
+	mock := &MockartifactStore{ctrl: ctrl}
+	mock.recorder = &MockartifactStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockartifactStore) EXPECT() *MockartifactStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteObject mocks base method.
+func (m *MockartifactStore) DeleteObject(bucket, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", bucket, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockartifactStoreMockRecorder) DeleteObject(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockartifactStore)(nil).DeleteObject), bucket, key)
+}
+
+// PutArtifact mocks base method.
+func (m *MockartifactStore) PutArtifact(bucket, fileName string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutArtifact", bucket, fileName, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutArtifact indicates an expected call of PutArtifact.
+func (mr *MockartifactStoreMockRecorder) PutArtifact(bucket, fileName, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactStore)(nil).PutArtifact), bucket, fileName, data)
+}
+
+// MocklogEventsGetter is a mock of logEventsGetter interface.
+type MocklogEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogEventsGetterMockRecorder
+}
+
+// MocklogEventsGetterMockRecorder is the mock recorder for MocklogEventsGetter.
+type MocklogEventsGetterMockRecorder struct {
+	mock *MocklogEventsGetter
+}
+
+// NewMocklogEventsGetter creates a new mock instance.
+func NewMocklogEventsGetter(ctrl *gomock.Controller) *MocklogEventsGetter {
+	mock := &MocklogEventsGetter{ctrl: ctrl}
+	mock.recorder = &MocklogEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogEventsGetter) EXPECT() *MocklogEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MocklogEventsGetter) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MocklogEventsGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogEventsGetter)(nil).LogEvents), opts)
+}
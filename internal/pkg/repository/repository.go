@@ -5,6 +5,7 @@
 package repository
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
@@ -47,15 +48,16 @@ func New(name string, registry Registry) (*Repository, error) {
 }
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
+// If the latest tag is skipped, the image is pushed only with its tags so that no existing tag is overwritten.
 func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *exec.BuildArguments) (digest string, err error) {
 	if args.URI == "" {
 		args.URI = r.uri
 	}
-	if err := docker.Build(args); err != nil {
-		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
+	if args.SkipLatestTag && len(args.Tags) == 0 {
+		return "", errors.New("the image must have a tag if it is not tagged as latest")
 	}
-
 	// Perform docker login only if credStore attribute value != ecr-login
+	// Log in before building so that the build can export its cache to the repository.
 	if !docker.IsEcrCredentialHelperEnabled(args.URI) {
 		username, password, err := r.registry.Auth()
 		if err != nil {
@@ -67,13 +69,29 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *exec.B
 		}
 	}
 
-	digest, err = docker.Push(args.URI, args.Tags...)
+	if err := docker.Build(args); err != nil {
+		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
+	}
+
+	digest, err = push(docker, args)
 	if err != nil {
 		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
 	}
 	return digest, nil
 }
 
+func push(docker ContainerLoginBuildPusher, args *exec.BuildArguments) (digest string, err error) {
+	if !args.SkipLatestTag {
+		return docker.Push(args.URI, args.Tags...)
+	}
+	for _, tag := range args.Tags {
+		if digest, err = docker.Push(fmt.Sprintf("%s:%s", args.URI, tag)); err != nil {
+			return "", err
+		}
+	}
+	return digest, nil
+}
+
 // URI returns the uri of the repository.
 func (r *Repository) URI() string {
 	return r.uri
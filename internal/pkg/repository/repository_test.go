@@ -44,7 +44,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				m.EXPECT().Auth().Return("", "", errors.New("error getting auth"))
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
-				m.EXPECT().Build(gomock.Any()).AnyTimes()
+				m.EXPECT().Build(gomock.Any()).Times(0)
 				m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(false)
 				m.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				m.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
@@ -56,8 +56,9 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				m.EXPECT().Auth().Return("", "", nil).AnyTimes()
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
-				m.EXPECT().Build(&defaultDockerArguments).Return(errors.New("error building image"))
+				m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(false)
 				m.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+				m.EXPECT().Build(&defaultDockerArguments).Return(errors.New("error building image"))
 				m.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			},
 			wantedError: fmt.Errorf("build Dockerfile at %s: error building image", inDockerfilePath),
@@ -67,7 +68,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
-				m.EXPECT().Build(gomock.Any()).AnyTimes()
+				m.EXPECT().Build(gomock.Any()).Times(0)
 				m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(false)
 				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(errors.New("error logging in"))
 				m.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
@@ -143,3 +144,59 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_BuildAndPushWithoutLatestTag(t *testing.T) {
+	const mockRepoURI = "mockRepoURI"
+
+	t.Run("should error if the image has no tags", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		repo := &Repository{
+			name:     "my-repo",
+			registry: mocks.NewMockRegistry(ctrl),
+			uri:      mockRepoURI,
+		}
+
+		_, err := repo.BuildAndPush(mocks.NewMockContainerLoginBuildPusher(ctrl), &exec.BuildArguments{
+			Dockerfile:    "path/to/dockerfile",
+			SkipLatestTag: true,
+		})
+
+		require.EqualError(t, err, "the image must have a tag if it is not tagged as latest")
+	})
+
+	t.Run("should push only the tags of the image on every deployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+		mockDocker.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(true).Times(2)
+		gomock.InOrder(
+			mockDocker.EXPECT().Build(gomock.Any()).Return(nil),
+			mockDocker.EXPECT().Push(mockRepoURI+":g123bfc").Return("sha256:abc", nil),
+			mockDocker.EXPECT().Build(gomock.Any()).Return(nil),
+			mockDocker.EXPECT().Push(mockRepoURI+":g456cde").Return("sha256:def", nil),
+		)
+		repo := &Repository{
+			name:     "my-repo",
+			registry: mocks.NewMockRegistry(ctrl),
+			uri:      mockRepoURI,
+		}
+
+		for _, deployment := range []struct {
+			tag          string
+			wantedDigest string
+		}{
+			{tag: "g123bfc", wantedDigest: "sha256:abc"},
+			{tag: "g456cde", wantedDigest: "sha256:def"},
+		} {
+			digest, err := repo.BuildAndPush(mockDocker, &exec.BuildArguments{
+				Dockerfile:    "path/to/dockerfile",
+				Tags:          []string{deployment.tag},
+				SkipLatestTag: true,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, deployment.wantedDigest, digest)
+		}
+	})
+}
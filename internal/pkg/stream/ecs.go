@@ -161,7 +161,7 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 		if _, ok := s.pastEventIDs[id]; ok {
 			break
 		}
-		if msg := aws.StringValue(event.Message); isFailureServiceEvent(msg) {
+		if msg := aws.StringValue(event.Message); IsFailureServiceEvent(msg) {
 			failureMsgs = append(failureMsgs, msg)
 		}
 		s.pastEventIDs[id] = true
@@ -214,7 +214,9 @@ func parseRevisionFromTaskDefARN(arn string) string {
 	return strings.Split(familyName, ":")[1]
 }
 
-func isFailureServiceEvent(msg string) bool {
+// IsFailureServiceEvent returns true if the message of an ECS service event describes a failure,
+// such as a task failing its health checks or the service being unable to place a task.
+func IsFailureServiceEvent(msg string) bool {
 	for _, kw := range ecsEventFailureKeywords {
 		if strings.Contains(msg, kw) {
 			return true
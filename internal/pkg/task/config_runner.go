@@ -29,6 +29,13 @@ type ConfigRunner struct {
 	Subnets        []string
 	SecurityGroups []string
 
+	// Capacity configuration.
+	Spot bool   // Run the tasks on Fargate Spot capacity.
+	OS   string // Operating system family of the task definition. Defaults to Linux.
+
+	// Optional. ARN of the IAM identity that starts the tasks, recorded in the "copilot-started-by" tag of the tasks.
+	Caller string
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	ClusterGetter DefaultClusterGetter
 	Starter       Runner
@@ -41,6 +48,25 @@ type ConfigRunner struct {
 // If subnets are not provided, it uses the default subnets.
 // If cluster is not provided, it uses the default cluster.
 func (r *ConfigRunner) Run() ([]*Task, error) {
+	input, err := r.RunTaskInput()
+	if err != nil {
+		return nil, err
+	}
+	ecsTasks, err := r.Starter.RunTask(*input)
+	if err != nil {
+		return nil, &errRunTask{
+			groupName: r.GroupName,
+			parentErr: err,
+		}
+	}
+
+	return convertECSTasks(ecsTasks), nil
+}
+
+// RunTaskInput returns the cluster and network configuration to run the tasks with.
+// If subnets are not provided, it uses the default subnets.
+// If cluster is not provided, it uses the default cluster.
+func (r *ConfigRunner) RunTaskInput() (*ecs.RunTaskInput, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
@@ -66,22 +92,17 @@ func (r *ConfigRunner) Run() ([]*Task, error) {
 		r.Subnets = subnets
 	}
 
-	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
-		Cluster:        r.Cluster,
-		Count:          r.Count,
-		Subnets:        r.Subnets,
-		SecurityGroups: r.SecurityGroups,
-		TaskFamilyName: taskFamilyName(r.GroupName),
-		StartedBy:      startedBy,
-	})
-	if err != nil {
-		return nil, &errRunTask{
-			groupName: r.GroupName,
-			parentErr: err,
-		}
-	}
-
-	return convertECSTasks(ecsTasks), nil
+	return &ecs.RunTaskInput{
+		Cluster:         r.Cluster,
+		Count:           r.Count,
+		Subnets:         r.Subnets,
+		SecurityGroups:  r.SecurityGroups,
+		TaskFamilyName:  taskFamilyName(r.GroupName),
+		StartedBy:       startedBy,
+		Spot:            r.Spot,
+		PlatformVersion: platformVersion(r.OS),
+		Tags:            startedByTags(r.Caller),
+	}, nil
 }
 
 func (r *ConfigRunner) validateDependencies() error {
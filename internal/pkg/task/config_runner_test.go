@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,9 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 		subnets        []string
 		securityGroups []string
 
+		spot bool
+		os   string
+
 		mockClusterGetter func(m *mocks.MockDefaultClusterGetter)
 		mockStarter       func(m *mocks.MockRunner)
 		MockVPCGetter     func(m *mocks.MockVPCGetter)
@@ -236,6 +240,76 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				}).Return([]*ecs.Task{&taskWithENI}, nil)
 			},
 
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+					ENI:     "eni-1",
+				},
+			},
+		},
+		"successfully kick off Windows tasks": {
+			count:     1,
+			groupName: "my-task",
+
+			cluster:        "special-cluster",
+			subnets:        []string{"subnet-1", "subnet-2"},
+			securityGroups: []string{"sg-1", "sg-2"},
+
+			os: deploy.TaskOSWindows,
+
+			mockClusterGetter: func(m *mocks.MockDefaultClusterGetter) {
+				m.EXPECT().DefaultCluster().Times(0)
+			},
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SubnetIDs([]ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Times(0)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "special-cluster",
+					Count:           1,
+					Subnets:         []string{"subnet-1", "subnet-2"},
+					SecurityGroups:  []string{"sg-1", "sg-2"},
+					TaskFamilyName:  taskFamilyName("my-task"),
+					StartedBy:       startedBy,
+					PlatformVersion: windowsPlatformVersion,
+				}).Return([]*ecs.Task{&taskWithENI}, nil)
+			},
+
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+					ENI:     "eni-1",
+				},
+			},
+		},
+		"successfully kick off tasks on Fargate Spot": {
+			count:     1,
+			groupName: "my-task",
+
+			cluster:        "special-cluster",
+			subnets:        []string{"subnet-1", "subnet-2"},
+			securityGroups: []string{"sg-1", "sg-2"},
+
+			spot: true,
+
+			mockClusterGetter: func(m *mocks.MockDefaultClusterGetter) {
+				m.EXPECT().DefaultCluster().Times(0)
+			},
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SubnetIDs([]ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Times(0)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:        "special-cluster",
+					Count:          1,
+					Subnets:        []string{"subnet-1", "subnet-2"},
+					SecurityGroups: []string{"sg-1", "sg-2"},
+					TaskFamilyName: taskFamilyName("my-task"),
+					StartedBy:      startedBy,
+					Spot:           true,
+				}).Return([]*ecs.Task{&taskWithENI}, nil)
+			},
+
 			wantedTasks: []*Task{
 				{
 					TaskARN: "task-1",
@@ -266,6 +340,9 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				Subnets:        tc.subnets,
 				SecurityGroups: tc.securityGroups,
 
+				Spot: tc.spot,
+				OS:   tc.os,
+
 				VPCGetter:     MockVPCGetter,
 				ClusterGetter: mockClusterGetter,
 				Starter:       mockStarter,
@@ -36,6 +36,17 @@ type EnvRunner struct {
 	App string
 	Env string
 
+	// Network configuration. By default, tasks run in the public subnets with the environment security group.
+	PrivateSubnets bool     // Run the tasks in the private subnets of the environment without a public IP.
+	SecurityGroups []string // Additional security groups to attach to the tasks.
+
+	// Capacity configuration.
+	Spot bool   // Run the tasks on Fargate Spot capacity.
+	OS   string // Operating system family of the task definition. Defaults to Linux.
+
+	// Optional. ARN of the IAM identity that starts the tasks, recorded in the "copilot-started-by" tag of the tasks.
+	Caller string
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	VPCGetter            VPCGetter
 	ClusterGetter        ClusterGetter
@@ -45,6 +56,22 @@ type EnvRunner struct {
 
 // Run runs tasks in the environment of the application, and returns the tasks.
 func (r *EnvRunner) Run() ([]*Task, error) {
+	input, err := r.RunTaskInput()
+	if err != nil {
+		return nil, err
+	}
+	ecsTasks, err := r.Starter.RunTask(*input)
+	if err != nil {
+		return nil, &errRunTask{
+			groupName: r.GroupName,
+			parentErr: err,
+		}
+	}
+	return convertECSTasks(ecsTasks), nil
+}
+
+// RunTaskInput returns the cluster and network configuration to run the tasks with in the environment.
+func (r *EnvRunner) RunTaskInput() (*ecs.RunTaskInput, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
@@ -58,12 +85,14 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 	if err != nil {
 		return nil, fmt.Errorf(fmtErrDescribeEnvironment, r.Env, err)
 	}
-	if len(description.EnvironmentVPC.PublicSubnetIDs) == 0 {
+	subnets := description.EnvironmentVPC.PublicSubnetIDs
+	if r.PrivateSubnets {
+		subnets = description.EnvironmentVPC.PrivateSubnetIDs
+	}
+	if len(subnets) == 0 {
 		return nil, errNoSubnetFound
 	}
 
-	subnets := description.EnvironmentVPC.PublicSubnetIDs
-
 	filters := r.filtersForVPCFromAppEnv()
 	// Use only environment security group https://github.com/aws/copilot-cli/issues/1882.
 	securityGroups, err := r.VPCGetter.SecurityGroups(append(filters, ec2.Filter{
@@ -74,21 +103,18 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		return nil, fmt.Errorf(fmtErrSecurityGroupsFromEnv, r.Env, err)
 	}
 
-	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
-		Cluster:        cluster,
-		Count:          r.Count,
-		Subnets:        subnets,
-		SecurityGroups: securityGroups,
-		TaskFamilyName: taskFamilyName(r.GroupName),
-		StartedBy:      startedBy,
-	})
-	if err != nil {
-		return nil, &errRunTask{
-			groupName: r.GroupName,
-			parentErr: err,
-		}
-	}
-	return convertECSTasks(ecsTasks), nil
+	return &ecs.RunTaskInput{
+		Cluster:         cluster,
+		Count:           r.Count,
+		Subnets:         subnets,
+		SecurityGroups:  append(securityGroups, r.SecurityGroups...),
+		TaskFamilyName:  taskFamilyName(r.GroupName),
+		StartedBy:       startedBy,
+		DisablePublicIP: r.PrivateSubnets,
+		Spot:            r.Spot,
+		PlatformVersion: platformVersion(r.OS),
+		Tags:            startedByTags(r.Caller),
+	}, nil
 }
 
 func (r *EnvRunner) filtersForVPCFromAppEnv() []ec2.Filter {
@@ -80,8 +80,11 @@ func TestEnvRunner_Run(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		count     int
-		groupName string
+		count          int
+		groupName      string
+		privateSubnets bool
+		securityGroups []string
+		caller         string
 
 		MockVPCGetter            func(m *mocks.MockVPCGetter)
 		MockClusterGetter        func(m *mocks.MockClusterGetter)
@@ -188,6 +191,64 @@ func TestEnvRunner_Run(t *testing.T) {
 				},
 			},
 		},
+		"run with the identity of the caller in a tag": {
+			count:     1,
+			groupName: "my-task",
+			caller:    "arn:aws:sts::123456789012:assumed-role/Admin/alice@example.com",
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1", "sg-2"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:        "cluster-1",
+					Count:          1,
+					Subnets:        []string{"subnet-0789ab", "subnet-0123cd"},
+					SecurityGroups: []string{"sg-1", "sg-2"},
+					TaskFamilyName: taskFamilyName("my-task"),
+					StartedBy:      startedBy,
+					Tags: map[string]string{
+						"copilot-started-by": "arn:aws:sts::123456789012:assumed-role/Admin/alice@example.com",
+					},
+				}).Return([]*ecs.Task{&taskWithENI}, nil)
+			},
+			mockEnvironmentDescriber: mockEnvironmentDescriberValid,
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+					ENI:     "eni-1",
+				},
+			},
+		},
+		"run in the private subnets with additional security groups": {
+			count:          1,
+			groupName:      "my-task",
+			privateSubnets: true,
+			securityGroups: []string{"sg-3"},
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1", "sg-2"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "cluster-1",
+					Count:           1,
+					Subnets:         []string{"subnet-023ff", "subnet-04af"},
+					SecurityGroups:  []string{"sg-1", "sg-2", "sg-3"},
+					TaskFamilyName:  taskFamilyName("my-task"),
+					StartedBy:       startedBy,
+					DisablePublicIP: true,
+				}).Return([]*ecs.Task{&taskWithNoENI}, nil)
+			},
+			mockEnvironmentDescriber: mockEnvironmentDescriberValid,
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-2",
+				},
+			},
+		},
 		"eni information not found for several tasks": {
 			count:     1,
 			groupName: "my-task",
@@ -247,6 +308,11 @@ func TestEnvRunner_Run(t *testing.T) {
 				App: inApp,
 				Env: inEnv,
 
+				PrivateSubnets: tc.privateSubnets,
+				SecurityGroups: tc.securityGroups,
+
+				Caller: tc.caller,
+
 				VPCGetter:            MockVPCGetter,
 				ClusterGetter:        MockClusterGetter,
 				Starter:              mockStarter,
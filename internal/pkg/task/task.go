@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 )
 
@@ -49,6 +50,9 @@ type Task struct {
 
 const (
 	startedBy = "copilot-task"
+
+	// Fargate platform version of Windows tasks. Linux tasks use the default platform version.
+	windowsPlatformVersion = "1.0.0"
 )
 
 var (
@@ -59,6 +63,24 @@ func taskFamilyName(groupName string) string {
 	return fmt.Sprintf(fmtTaskFamilyName, groupName)
 }
 
+// startedByTags returns the tags that record the identity of the caller on the tasks.
+// The "startedBy" parameter of RunTask doesn't accept the characters of an ARN, so the caller is stored in a tag instead.
+func startedByTags(caller string) map[string]string {
+	if caller == "" {
+		return nil
+	}
+	return map[string]string{
+		deploy.StartedByTagKey: caller,
+	}
+}
+
+func platformVersion(os string) string {
+	if os == deploy.TaskOSWindows {
+		return windowsPlatformVersion
+	}
+	return ""
+}
+
 func newTaskFromECS(ecsTask *ecs.Task) *Task {
 	taskARN := aws.StringValue(ecsTask.TaskArn)
 	eni, _ := ecsTask.ENI() //  Best-effort parse the ENI. If we can't find an IP address, we won't show it to the customers instead of erroring.
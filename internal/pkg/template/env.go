@@ -22,9 +22,12 @@ var (
 		"custom-resources",
 		"custom-resources-role",
 		"environment-manager-role",
+		"image-builder-role",
 		"lambdas",
 		"vpc-resources",
 		"nat-gateways",
+		"vpc-endpoints",
+		"dashboard",
 	}
 )
 
@@ -39,8 +42,11 @@ type EnvOpts struct {
 	CustomDomainLambda        string
 	ScriptBucketName          string
 
-	ImportVPC *config.ImportVPC
-	VPCConfig *config.AdjustVPC
+	ImportVPC      *config.ImportVPC
+	VPCConfig      *config.AdjustVPC
+	ImportCertARNs []string
+	VPCEndpoints   bool
+	Telemetry      *config.Telemetry
 
 	LatestVersion string
 }
@@ -6,8 +6,10 @@ package template
 import (
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/gobuffalo/packd"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestTemplate_ParseEnv(t *testing.T) {
@@ -35,9 +37,12 @@ func TestTemplate_ParseEnv(t *testing.T) {
 			tpl.box.AddString("environment/partials/custom-resources.yml", "custom-resources")
 			tpl.box.AddString("environment/partials/custom-resources-role.yml", "custom-resources-role")
 			tpl.box.AddString("environment/partials/environment-manager-role.yml", "environment-manager-role")
+			tpl.box.AddString("environment/partials/image-builder-role.yml", "image-builder-role")
 			tpl.box.AddString("environment/partials/lambdas.yml", "lambdas")
 			tpl.box.AddString("environment/partials/vpc-resources.yml", "vpc-resources")
 			tpl.box.AddString("environment/partials/nat-gateways.yml", "nat-gateways")
+			tpl.box.AddString("environment/partials/vpc-endpoints.yml", "vpc-endpoints")
+			tpl.box.AddString("environment/partials/dashboard.yml", "dashboard")
 
 			// WHEN
 			c, err := tpl.ParseEnv(&EnvOpts{})
@@ -51,3 +56,193 @@ func TestTemplate_ParseEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseVPCEndpoints(t *testing.T) {
+	type cfn struct {
+		Resources map[string]struct {
+			Type       string                 `yaml:"Type"`
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		inImportVPC *config.ImportVPC
+
+		wantedRouteTableIDs []interface{}
+	}{
+		"should route the default VPC's private subnets to the S3 gateway endpoint": {
+			wantedRouteTableIDs: []interface{}{"PrivateRouteTable"},
+		},
+		"should route the imported private route tables to the S3 gateway endpoint": {
+			inImportVPC: &config.ImportVPC{
+				ID:                   "vpc-1234",
+				PrivateSubnetIDs:     []string{"subnet-1", "subnet-2"},
+				PrivateRouteTableIDs: []string{"rtb-1", "rtb-2"},
+			},
+			wantedRouteTableIDs: []interface{}{"rtb-1", "rtb-2"},
+		},
+		"should not render the S3 gateway endpoint without imported private route tables": {
+			inImportVPC: &config.ImportVPC{
+				ID:               "vpc-1234",
+				PrivateSubnetIDs: []string{"subnet-1", "subnet-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			opts := &EnvOpts{
+				ImportVPC:    tc.inImportVPC,
+				VPCEndpoints: true,
+			}
+			if tc.inImportVPC == nil {
+				opts.VPCConfig = &config.AdjustVPC{
+					CIDR:               "10.0.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				}
+			}
+
+			// WHEN
+			content, err := tpl.ParseEnv(opts, WithFuncs(map[string]interface{}{
+				"inc": IncFunc,
+			}))
+
+			// THEN
+			require.NoError(t, err, "parse environment template")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			endpoint, ok := actual.Resources["S3GatewayEndpoint"]
+			if tc.wantedRouteTableIDs == nil {
+				require.False(t, ok, "S3 gateway endpoint should not be rendered")
+				return
+			}
+			require.True(t, ok, "S3 gateway endpoint should be rendered")
+			require.Equal(t, tc.wantedRouteTableIDs, endpoint.Properties["RouteTableIds"])
+		})
+	}
+}
+
+func TestTemplate_ParseInternalLoadBalancerSecurityGroup(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			InternalLoadBalancerSecurityGroup struct {
+				Properties struct {
+					SecurityGroupIngress []struct {
+						CidrIp string `yaml:"CidrIp"`
+					} `yaml:"SecurityGroupIngress"`
+				} `yaml:"Properties"`
+			} `yaml:"InternalLoadBalancerSecurityGroup"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		inImportVPC *config.ImportVPC
+
+		wantedCIDRs []string
+	}{
+		"should allow ingress from the CIDR block of the default VPC": {
+			wantedCIDRs: []string{"VPC.CidrBlock"},
+		},
+		"should allow ingress from the CIDR block of the imported VPC": {
+			inImportVPC: &config.ImportVPC{
+				ID:               "vpc-1234",
+				PrivateSubnetIDs: []string{"subnet-1", "subnet-2"},
+				CIDR:             "10.1.0.0/16",
+			},
+			wantedCIDRs: []string{"10.1.0.0/16"},
+		},
+		"should not allow ingress from the imported VPC if its CIDR block is unknown": {
+			inImportVPC: &config.ImportVPC{
+				ID:               "vpc-1234",
+				PrivateSubnetIDs: []string{"subnet-1", "subnet-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			opts := &EnvOpts{
+				ImportVPC: tc.inImportVPC,
+			}
+			if tc.inImportVPC == nil {
+				opts.VPCConfig = &config.AdjustVPC{
+					CIDR:               "10.0.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				}
+			}
+
+			// WHEN
+			content, err := tpl.ParseEnv(opts, WithFuncs(map[string]interface{}{
+				"inc": IncFunc,
+			}))
+
+			// THEN
+			require.NoError(t, err, "parse environment template")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			var cidrs []string
+			for _, ingress := range actual.Resources.InternalLoadBalancerSecurityGroup.Properties.SecurityGroupIngress {
+				cidrs = append(cidrs, ingress.CidrIp)
+			}
+			require.Equal(t, tc.wantedCIDRs, cidrs)
+		})
+	}
+}
+
+func TestTemplate_ParseManageAliases(t *testing.T) {
+	type cfn struct {
+		Conditions struct {
+			ManageAliases []string `yaml:"ManageAliases"`
+		} `yaml:"Conditions"`
+		Resources struct {
+			CustomDomainAction struct {
+				Condition string `yaml:"Condition"`
+			} `yaml:"CustomDomainAction"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		inImportCertARNs []string
+	}{
+		"should manage the aliases of an app with a domain": {},
+		"should manage the aliases of an app with a domain with imported certificates": {
+			inImportCertARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			opts := &EnvOpts{
+				ImportCertARNs: tc.inImportCertARNs,
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.0.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				},
+			}
+
+			// WHEN
+			content, err := tpl.ParseEnv(opts, WithFuncs(map[string]interface{}{
+				"inc": IncFunc,
+			}))
+
+			// THEN
+			require.NoError(t, err, "parse environment template")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.Equal(t, []string{"DelegateDNS", "HasAliases"}, actual.Conditions.ManageAliases)
+			require.Equal(t, "ManageAliases", actual.Resources.CustomDomainAction.Condition)
+		})
+	}
+}
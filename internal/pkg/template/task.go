@@ -0,0 +1,48 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+const (
+	taskCFTemplatePath = "task/cf.yml"
+)
+
+// TaskOpts holds data that can be provided to render the CloudFormation template of a one-off task.
+type TaskOpts struct {
+	EnvVars  map[string]string
+	Secrets  map[string]string
+	Storage  *StorageOpts
+	OS       string
+	Arch     string
+	Schedule *TaskScheduleOpts
+
+	// Set if the task mounts the Copilot-managed EFS file system of its environment.
+	EnvControllerLambda string
+}
+
+// TaskScheduleOpts holds the configuration of the EventBridge rules that run a task on a schedule.
+type TaskScheduleOpts struct {
+	Cluster         string // Name or ARN of the cluster to run the tasks in.
+	ClusterName     string // Set if the cluster is referred to by its short name instead of its ARN.
+	Count           int
+	Subnets         []string
+	SecurityGroups  []string
+	AssignPublicIP  bool
+	Spot            bool
+	PlatformVersion string
+	Expiry          string // One-time cron expression at which the schedule is disabled, if any.
+}
+
+// EnvControllerOpts returns the options to render the env controller, which registers the task
+// as an EFS workload so that the environment creates its managed file system.
+func (o TaskOpts) EnvControllerOpts() WorkloadOpts {
+	return WorkloadOpts{
+		Storage:             o.Storage,
+		EnvControllerLambda: o.EnvControllerLambda,
+	}
+}
+
+// ParseTask parses a task's CloudFormation template with the specified data object and returns its content.
+func (t *Template) ParseTask(data TaskOpts) (*Content, error) {
+	return t.parseWithWkldPartials(taskCFTemplatePath, data, withSvcParsingFuncs())
+}
@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestTemplate_ParseTaskManagedVolume(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			AccessPoint *struct {
+				Properties struct {
+					RootDirectory struct {
+						Path string `yaml:"Path"`
+					} `yaml:"RootDirectory"`
+				} `yaml:"Properties"`
+			} `yaml:"AccessPoint"`
+			EnvControllerAction *struct {
+				Properties struct {
+					Parameters []string `yaml:"Parameters"`
+				} `yaml:"Properties"`
+			} `yaml:"EnvControllerAction"`
+			DefaultTaskRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyName string `yaml:"PolicyName"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"DefaultTaskRole"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input TaskOpts
+
+		wantedEnvController bool
+		wantedPolicies      []string
+	}{
+		"should not register the task with the env controller without a managed volume": {
+			input:          TaskOpts{},
+			wantedPolicies: []string{"ExecuteCommand"},
+		},
+		"should render the env controller and an access point to mount a managed volume": {
+			input: TaskOpts{
+				Storage: &StorageOpts{
+					ManagedVolumeInfo: &ManagedVolumeCreationInfo{
+						Name:    aws.String("data"),
+						DirName: aws.String("task-my-task"),
+						UID:     aws.Uint32(1000),
+						GID:     aws.Uint32(1000),
+					},
+				},
+				EnvControllerLambda: "lambda",
+			},
+			wantedEnvController: true,
+			wantedPolicies:      []string{"ExecuteCommand", "GrantAccessCopilotManagedEFS"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseTask(tc.input)
+
+			// THEN
+			require.NoError(t, err, "parse task")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual config")
+			var policies []string
+			for _, policy := range actual.Resources.DefaultTaskRole.Properties.Policies {
+				policies = append(policies, policy.PolicyName)
+			}
+			require.Equal(t, tc.wantedPolicies, policies)
+			if !tc.wantedEnvController {
+				require.Nil(t, actual.Resources.AccessPoint)
+				require.Nil(t, actual.Resources.EnvControllerAction)
+				return
+			}
+			require.Equal(t, "/task-my-task", actual.Resources.AccessPoint.Properties.RootDirectory.Path)
+			require.Equal(t, []string{"EFSWorkloads"}, actual.Resources.EnvControllerAction.Properties.Parameters)
+		})
+	}
+}
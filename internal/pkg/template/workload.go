@@ -6,10 +6,12 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/google/uuid"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
@@ -60,11 +62,16 @@ var (
 		"state-machine-definition.json",
 		"efs-access-point",
 		"env-controller",
+		"managed-efs-policy",
 		"mount-points",
 		"volumes",
 		"image-overrides",
 		"instancerole",
 		"accessrole",
+		"apprunner-runtime-env",
+		"service-connect",
+		"service-mesh",
+		"envoy-container",
 	}
 )
 
@@ -200,6 +207,23 @@ type AutoscalingOpts struct {
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
+// ObservabilityOpts holds configuration for collecting telemetry from a workload.
+type ObservabilityOpts struct {
+	Tracing string // The name of the tracing vendor, for example "AWSXRAY".
+}
+
+// ConnectOpts holds configuration for restricting the callers of a service to the workloads that are allowed to call it.
+type ConnectOpts struct {
+	Services []string  // Names of the services that the workload is allowed to call.
+	Mesh     *MeshOpts // If set, the traffic of the workload is routed through the environment's service mesh.
+}
+
+// MeshOpts holds the retry and timeout policies applied by the service mesh to the calls made to a service.
+type MeshOpts struct {
+	Retries        int
+	TimeoutSeconds int
+}
+
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
@@ -246,7 +270,9 @@ type WorkloadOpts struct {
 	DesiredCountOnSpot       *int
 	Storage                  *StorageOpts
 	Network                  *NetworkOpts
+	Connect                  *ConnectOpts
 	ExecuteCommand           *ExecuteCommandOpts
+	Observability            *ObservabilityOpts
 	EntryPoint               []string
 	Command                  []string
 	DomainAlias              string
@@ -261,6 +287,7 @@ type WorkloadOpts struct {
 	HTTPHealthCheck      HTTPHealthCheckOpts
 	DeregistrationDelay  *int64
 	AllowedSourceIps     []string
+	InternalALB          bool
 	RulePriorityLambda   string
 	DesiredCountLambda   string
 	EnvControllerLambda  string
@@ -275,8 +302,12 @@ type WorkloadOpts struct {
 type ParseRequestDrivenWebServiceInput struct {
 	Variables           map[string]string
 	Tags                map[string]string        // Used by App Runner workloads to tag App Runner service resources
+	Secrets             map[string]string        // SSM parameter names or ARNs, and Secrets Manager secret ARNs.
 	NestedStack         *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	EnableHealthCheck   bool
+	EnableVPCConnector  bool                 // Route outbound traffic through the environment's private subnets.
+	Source              *AppRunnerSourceOpts // Build the service from a source code repository instead of an image.
+	Autoscaling         *AppRunnerAutoscalingOpts
 	EnvControllerLambda string
 
 	// Input needed for the custom resource that adds a custom domain to the service.
@@ -288,6 +319,43 @@ type ParseRequestDrivenWebServiceInput struct {
 	AppDNSName           string
 }
 
+// AppRunnerAutoscalingOpts holds the scaling configuration for an App Runner service.
+type AppRunnerAutoscalingOpts struct {
+	MaxConcurrency *int
+	MinSize        *int
+	MaxSize        *int
+}
+
+// AppRunnerSourceOpts holds the configuration to build an App Runner service from a source code repository.
+type AppRunnerSourceOpts struct {
+	ConnectionARN string
+	RepositoryURL string
+	Branch        string
+	Runtime       string
+	BuildCommand  string
+	StartCommand  string
+}
+
+// HasSecrets returns true if the service needs to retrieve any secret at runtime.
+func (in ParseRequestDrivenWebServiceInput) HasSecrets() bool {
+	if len(in.Secrets) > 0 {
+		return true
+	}
+	return in.NestedStack != nil && len(in.NestedStack.SecretOutputs) > 0
+}
+
+// EnvControllerOpts returns the options to render the env controller, which registers the service's
+// VPC connector as a NAT workload so that the environment creates NAT gateways for its private subnets.
+func (in ParseRequestDrivenWebServiceInput) EnvControllerOpts() WorkloadOpts {
+	return WorkloadOpts{
+		WorkloadType: "Request-Driven Web Service",
+		Network: &NetworkOpts{
+			SubnetsType: PrivateSubnetsPlacement,
+		},
+		EnvControllerLambda: in.EnvControllerLambda,
+	}
+}
+
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
 // with the specified data object and returns its content.
 func (t *Template) ParseLoadBalancedWebService(data WorkloadOpts) (*Content, error) {
@@ -330,7 +398,13 @@ func (t *Template) parseJob(name string, data interface{}, options ...ParseOptio
 }
 
 func (t *Template) parseWkld(name, wkldDirName string, data interface{}, options ...ParseOption) (*Content, error) {
-	tpl, err := t.parse("base", fmt.Sprintf(fmtWkldCFTemplatePath, wkldDirName, name), options...)
+	return t.parseWithWkldPartials(fmt.Sprintf(fmtWkldCFTemplatePath, wkldDirName, name), data, options...)
+}
+
+// parseWithWkldPartials parses the template under "/templates/{path}" along with the workload partials
+// with the specified data object and returns its content.
+func (t *Template) parseWithWkldPartials(path string, data interface{}, options ...ParseOption) (*Content, error) {
+	tpl, err := t.parse("base", path, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +420,7 @@ func (t *Template) parseWkld(name, wkldDirName string, data interface{}, options
 	}
 	buf := &bytes.Buffer{}
 	if err := tpl.Execute(buf, data); err != nil {
-		return nil, fmt.Errorf("execute template %s with data %v: %w", name, data, err)
+		return nil, fmt.Errorf("execute template %s with data %v: %w", path, data, err)
 	}
 	return &Content{buf}, nil
 }
@@ -362,6 +436,9 @@ func withSvcParsingFuncs() ParseOption {
 			"jsonMountPoints":     generateMountPointJSON,
 			"jsonPublishers":      generatePublishJSON,
 			"envControllerParams": envControllerParameters,
+			"isARN":               arn.IsARN,
+			"logicalIDSafe":       ReplaceDashesFunc,
+			"join":                strings.Join,
 		})
 	}
 }
@@ -388,13 +465,20 @@ func randomUUIDFunc() (string, error) {
 func envControllerParameters(o WorkloadOpts) []string {
 	parameters := []string{}
 	if o.WorkloadType == "Load Balanced Web Service" {
-		parameters = append(parameters, []string{"ALBWorkloads,", "Aliases,"}...) // YAML needs the comma separator; resolved in EnvContr.
+		if o.InternalALB {
+			parameters = append(parameters, "InternalALBWorkloads,") // YAML needs the comma separator; resolved in EnvContr.
+		} else {
+			parameters = append(parameters, []string{"ALBWorkloads,", "Aliases,"}...) // YAML needs the comma separator; resolved in EnvContr.
+		}
 	}
-	if o.Network.SubnetsType == PrivateSubnetsPlacement {
+	if o.Network != nil && o.Network.SubnetsType == PrivateSubnetsPlacement {
 		parameters = append(parameters, "NATWorkloads,") // YAML needs the comma separator; resolved in EnvContr.
 	}
 	if o.Storage != nil && o.Storage.requiresEFSCreation() {
 		parameters = append(parameters, "EFSWorkloads,")
 	}
+	if o.Connect != nil && o.Connect.Mesh != nil {
+		parameters = append(parameters, "MeshWorkloads,")
+	}
 	return parameters
 }
@@ -47,11 +47,16 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("workloads/partials/cf/state-machine.yml", "state-machine")
 				mockBox.AddString("workloads/partials/cf/efs-access-point.yml", "efs-access-point")
 				mockBox.AddString("workloads/partials/cf/env-controller.yml", "env-controller")
+				mockBox.AddString("workloads/partials/cf/managed-efs-policy.yml", "managed-efs-policy")
 				mockBox.AddString("workloads/partials/cf/mount-points.yml", "mount-points")
 				mockBox.AddString("workloads/partials/cf/volumes.yml", "volumes")
 				mockBox.AddString("workloads/partials/cf/image-overrides.yml", "image-overrides")
 				mockBox.AddString("workloads/partials/cf/instancerole.yml", "instancerole")
 				mockBox.AddString("workloads/partials/cf/accessrole.yml", "accessrole")
+				mockBox.AddString("workloads/partials/cf/apprunner-runtime-env.yml", "apprunner-runtime-env")
+				mockBox.AddString("workloads/partials/cf/service-connect.yml", "service-connect")
+				mockBox.AddString("workloads/partials/cf/service-mesh.yml", "service-mesh")
+				mockBox.AddString("workloads/partials/cf/envoy-container.yml", "envoy-container")
 
 				t.box = mockBox
 			},
@@ -73,11 +78,16 @@ func TestTemplate_ParseSvc(t *testing.T) {
   state-machine-definition
   efs-access-point
   env-controller
+  managed-efs-policy
   mount-points
   volumes
   image-overrides
   instancerole
   accessrole
+  apprunner-runtime-env
+  service-connect
+  service-mesh
+  envoy-container
 `,
 		},
 	}
@@ -232,3 +242,272 @@ func TestTemplate_ParseNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseTaskRole(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyName string `yaml:"PolicyName"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskRole"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input *ObservabilityOpts
+
+		wantedXRayPolicy bool
+	}{
+		"should not render the X-Ray policy without observability": {
+			input: nil,
+		},
+		"should not render the X-Ray policy without tracing": {
+			input: &ObservabilityOpts{},
+		},
+		"should render the X-Ray policy with AWS X-Ray tracing": {
+			input: &ObservabilityOpts{
+				Tracing: "AWSXRAY",
+			},
+			wantedXRayPolicy: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				Observability: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse load balanced web service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual config")
+			var hasXRayPolicy bool
+			for _, policy := range actual.Resources.TaskRole.Properties.Policies {
+				if policy.PolicyName == "XRayTracing" {
+					hasXRayPolicy = true
+				}
+			}
+			require.Equal(t, tc.wantedXRayPolicy, hasXRayPolicy)
+		})
+	}
+}
+
+func TestTemplate_ParseRequestDrivenWebServiceVPCConnector(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			VpcConnector *struct {
+				DependsOn string `yaml:"DependsOn"`
+			} `yaml:"VpcConnector"`
+			EnvControllerAction *struct {
+				Properties struct {
+					Parameters []string `yaml:"Parameters"`
+				} `yaml:"Properties"`
+			} `yaml:"EnvControllerAction"`
+		} `yaml:"Resources"`
+	}
+
+	t.Run("should not render the env controller without a vpc connector", func(t *testing.T) {
+		// GIVEN
+		tpl := New()
+
+		// WHEN
+		content, err := tpl.ParseRequestDrivenWebService(ParseRequestDrivenWebServiceInput{})
+
+		// THEN
+		require.NoError(t, err, "parse request-driven web service")
+		var actual cfn
+		err = yaml.Unmarshal(content.Bytes(), &actual)
+		require.NoError(t, err, "unmarshal actual config")
+		require.Nil(t, actual.Resources.VpcConnector)
+		require.Nil(t, actual.Resources.EnvControllerAction)
+	})
+	t.Run("should register the vpc connector as a NAT workload", func(t *testing.T) {
+		// GIVEN
+		tpl := New()
+
+		// WHEN
+		content, err := tpl.ParseRequestDrivenWebService(ParseRequestDrivenWebServiceInput{
+			EnableVPCConnector:  true,
+			EnvControllerLambda: "lambda",
+		})
+
+		// THEN
+		require.NoError(t, err, "parse request-driven web service")
+		var actual cfn
+		err = yaml.Unmarshal(content.Bytes(), &actual)
+		require.NoError(t, err, "unmarshal actual config")
+		require.NotNil(t, actual.Resources.VpcConnector)
+		require.Equal(t, "EnvControllerAction", actual.Resources.VpcConnector.DependsOn)
+		require.NotNil(t, actual.Resources.EnvControllerAction)
+		require.Equal(t, []string{"NATWorkloads"}, actual.Resources.EnvControllerAction.Properties.Parameters)
+	})
+}
+
+func TestTemplate_ParseRequestDrivenWebServiceAutoDeployments(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					SourceConfiguration struct {
+						AutoDeploymentsEnabled bool `yaml:"AutoDeploymentsEnabled"`
+						CodeRepository         *struct {
+							RepositoryURL string `yaml:"RepositoryUrl"`
+						} `yaml:"CodeRepository"`
+					} `yaml:"SourceConfiguration"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input ParseRequestDrivenWebServiceInput
+
+		wantedCodeRepository  bool
+		wantedAutoDeployments bool
+	}{
+		"should not deploy images automatically": {
+			input: ParseRequestDrivenWebServiceInput{},
+		},
+		"should deploy new commits automatically when building from source": {
+			input: ParseRequestDrivenWebServiceInput{
+				Source: &AppRunnerSourceOpts{
+					ConnectionARN: "arn:aws:apprunner:us-west-2:123456789012:connection/github/abc123",
+					RepositoryURL: "https://github.com/user/repo",
+					Branch:        "main",
+					Runtime:       "PYTHON_3",
+					StartCommand:  "python app.py",
+				},
+			},
+			wantedCodeRepository:  true,
+			wantedAutoDeployments: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseRequestDrivenWebService(tc.input)
+
+			// THEN
+			require.NoError(t, err, "parse request-driven web service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual config")
+			sourceConfig := actual.Resources.Service.Properties.SourceConfiguration
+			require.Equal(t, tc.wantedCodeRepository, sourceConfig.CodeRepository != nil)
+			require.Equal(t, tc.wantedAutoDeployments, sourceConfig.AutoDeploymentsEnabled)
+		})
+	}
+}
+
+func TestTemplate_ParseServiceConnect(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			ServiceSecurityGroupIngressToAPI *struct {
+				Properties struct {
+					GroupID struct {
+						ImportValue string `yaml:"Fn::ImportValue"`
+					} `yaml:"GroupId"`
+					SourceSecurityGroupID string `yaml:"SourceSecurityGroupId"`
+				} `yaml:"Properties"`
+			} `yaml:"ServiceSecurityGroupIngressToapi"`
+			VirtualNode *struct {
+				Properties struct {
+					Spec struct {
+						Backends []struct {
+							VirtualService struct {
+								VirtualServiceName string `yaml:"VirtualServiceName"`
+							} `yaml:"VirtualService"`
+						} `yaml:"Backends"`
+					} `yaml:"Spec"`
+				} `yaml:"Properties"`
+			} `yaml:"VirtualNode"`
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []struct {
+						Name  string `yaml:"Name"`
+						Image string `yaml:"Image"`
+						User  string `yaml:"User"`
+					} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+	containerNames := func(c cfn) []string {
+		var names []string
+		for _, def := range c.Resources.TaskDefinition.Properties.ContainerDefinitions {
+			names = append(names, def.Name)
+		}
+		return names
+	}
+
+	t.Run("should allow the service to call its callees without a mesh", func(t *testing.T) {
+		// GIVEN
+		tpl := New()
+
+		// WHEN
+		content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+			ServiceDiscoveryEndpoint: "test.phonetool.local",
+			Connect: &ConnectOpts{
+				Services: []string{"api"},
+			},
+		})
+
+		// THEN
+		require.NoError(t, err, "parse load balanced web service")
+		var actual cfn
+		err = yaml.Unmarshal(content.Bytes(), &actual)
+		require.NoError(t, err, "unmarshal actual config")
+		require.NotNil(t, actual.Resources.ServiceSecurityGroupIngressToAPI)
+		require.Equal(t, "${AppName}-${EnvName}-api-SecurityGroup", actual.Resources.ServiceSecurityGroupIngressToAPI.Properties.GroupID.ImportValue)
+		require.Equal(t, "ServiceSecurityGroup", actual.Resources.ServiceSecurityGroupIngressToAPI.Properties.SourceSecurityGroupID)
+		require.Nil(t, actual.Resources.VirtualNode)
+		require.NotContains(t, containerNames(actual), "envoy")
+	})
+	t.Run("should route the calls to the callees through the service mesh", func(t *testing.T) {
+		// GIVEN
+		tpl := New()
+
+		// WHEN
+		content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+			ServiceDiscoveryEndpoint: "test.phonetool.local",
+			Connect: &ConnectOpts{
+				Services: []string{"api"},
+				Mesh: &MeshOpts{
+					TimeoutSeconds: 15,
+				},
+			},
+		})
+
+		// THEN
+		require.NoError(t, err, "parse load balanced web service")
+		var actual cfn
+		err = yaml.Unmarshal(content.Bytes(), &actual)
+		require.NoError(t, err, "unmarshal actual config")
+		require.NotNil(t, actual.Resources.ServiceSecurityGroupIngressToAPI)
+		require.NotNil(t, actual.Resources.VirtualNode)
+		require.Len(t, actual.Resources.VirtualNode.Properties.Spec.Backends, 1)
+		require.Equal(t, "api.test.phonetool.local", actual.Resources.VirtualNode.Properties.Spec.Backends[0].VirtualService.VirtualServiceName)
+		var envoy bool
+		for _, def := range actual.Resources.TaskDefinition.Properties.ContainerDefinitions {
+			if def.Name != "envoy" {
+				continue
+			}
+			envoy = true
+			require.Equal(t, "public.ecr.aws/appmesh/aws-appmesh-envoy:v1.19.1.0-prod", def.Image)
+			require.Equal(t, "1337", def.User)
+		}
+		require.True(t, envoy, "envoy container should be defined")
+	})
+}
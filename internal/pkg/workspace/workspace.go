@@ -9,6 +9,9 @@
 //  │   ├── .workspace                 (workspace summary)
 //  │   └── my-service
 //  │   │   └── manifest.yml           (service manifest)
+//  │   ├── tasks
+//  │   │   └── my-task
+//  │   │       └── manifest.yml       (task manifest)
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
 //  │   └── pipeline.yml               (pipeline manifest)
 //  └── my-service-src                 (customer service code)
@@ -35,6 +38,7 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	tasksDirName              = "tasks"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
@@ -203,10 +207,28 @@ func (ws *Workspace) ReadJobManifest(name string) ([]byte, error) {
 	return mf, nil
 }
 
+// ReadWorkloadManifest returns the contents of the service or job's manifest under copilot/{name}/manifest.yml.
+func (ws *Workspace) ReadWorkloadManifest(name string) ([]byte, error) {
+	mf, err := ws.readWorkloadManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read workload %s manifest file: %w", name, err)
+	}
+	return mf, nil
+}
+
 func (ws *Workspace) readWorkloadManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
 
+// ReadTaskManifest returns the contents of the task's manifest under copilot/tasks/{name}/manifest.yml.
+func (ws *Workspace) ReadTaskManifest(name string) ([]byte, error) {
+	mf, err := ws.read(tasksDirName, name, manifestFileName)
+	if err != nil {
+		return nil, fmt.Errorf("read task %s manifest file: %w", name, err)
+	}
+	return mf, nil
+}
+
 // ReadPipelineManifest returns the contents of the pipeline manifest under copilot/pipeline.yml.
 func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
 	pmPath, err := ws.pipelineManifestPath()
@@ -242,6 +264,15 @@ func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name s
 	return ws.write(data, name, manifestFileName)
 }
 
+// WriteTaskManifest writes the task's manifest under the copilot/tasks/{name}/ directory.
+func (ws *Workspace) WriteTaskManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal task %s manifest to binary: %w", name, err)
+	}
+	return ws.write(data, tasksDirName, name, manifestFileName)
+}
+
 // WritePipelineBuildspec writes the pipeline buildspec under the copilot/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
 func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
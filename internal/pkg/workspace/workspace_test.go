@@ -278,6 +278,10 @@ func TestWorkspace_ServiceNames(t *testing.T) {
 
 				// Missing manifest.yml.
 				fs.Mkdir("/copilot/inventory", 0755)
+
+				// Task manifests.
+				fs.MkdirAll("/copilot/tasks/db-migrate", 0755)
+				fs.Create("/copilot/tasks/db-migrate/manifest.yml")
 				return fs
 			},
 
@@ -721,6 +725,95 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+func TestWorkspace_WriteTaskManifest(t *testing.T) {
+	testCases := map[string]struct {
+		marshaler mockBinaryMarshaler
+		task      string
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"writes the task manifest under the tasks directory": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("name: db-migrate"),
+			},
+			task: "db-migrate",
+
+			wantedPath: "/copilot/tasks/db-migrate/manifest.yml",
+		},
+		"wraps error if cannot marshal to binary": {
+			marshaler: mockBinaryMarshaler{
+				err: errors.New("some error"),
+			},
+			task: "db-migrate",
+
+			wantedErr: errors.New("marshal task db-migrate manifest to binary: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			utils := &afero.Afero{
+				Fs: afero.NewMemMapFs(),
+			}
+			utils.MkdirAll("/copilot", 0755)
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fsUtils:    utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.WriteTaskManifest(tc.marshaler, tc.task)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, tc.wantedPath, actualPath)
+			out, err := ws.ReadTaskManifest(tc.task)
+			require.NoError(t, err)
+			require.Equal(t, tc.marshaler.content, out)
+		})
+	}
+}
+
+func TestWorkspace_ReadTaskManifest(t *testing.T) {
+	ws := &Workspace{
+		workingDir: "/",
+		copilotDir: "/copilot",
+		fsUtils: &afero.Afero{
+			Fs: afero.NewMemMapFs(),
+		},
+	}
+
+	_, err := ws.ReadTaskManifest("db-migrate")
+
+	require.EqualError(t, err, "read task db-migrate manifest file: open /copilot/tasks/db-migrate/manifest.yml: file does not exist")
+}
+
+func TestWorkspace_ReadWorkloadManifest(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/copilot/frontend/manifest.yml", []byte("name: frontend"), 0644))
+	ws := &Workspace{
+		workingDir: "/",
+		copilotDir: "/copilot",
+		fsUtils: &afero.Afero{
+			Fs: fs,
+		},
+	}
+
+	content, err := ws.ReadWorkloadManifest("frontend")
+	require.NoError(t, err)
+	require.Equal(t, "name: frontend", string(content))
+
+	_, err = ws.ReadWorkloadManifest("backend")
+	require.EqualError(t, err, "read workload backend manifest file: open /copilot/backend/manifest.yml: file does not exist")
+}
+
 func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	copilotDir := "/copilot"
 	testCases := map[string]struct {